@@ -0,0 +1,297 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Brandmeister.
+//
+// Brandmeister is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Brandmeister is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Brandmeister.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package brandmeister retrieves a user's own hotspots and repeaters,
+// and their static talkgroups, from the Brandmeister network's API.
+package brandmeister
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// DefaultBaseURL is the address of version 2 of the Brandmeister API.
+const DefaultBaseURL = "https://api.brandmeister.network/v2"
+
+// APIKeyEnv names the environment variable from which programs may
+// take the API key when none is given.
+const APIKeyEnv = "BRANDMEISTER_API_KEY"
+
+// A Client talks to the Brandmeister API on behalf of a user.
+type Client struct {
+	// APIKey is the key generated in the user's Brandmeister
+	// profile.  It is sent with every request.
+	APIKey string
+
+	// BaseURL is DefaultBaseURL by default.
+	BaseURL string
+
+	client *http.Client
+}
+
+// NewClient returns a client using the given API key.
+func NewClient(apiKey string) *Client {
+	return &Client{
+		APIKey:  apiKey,
+		BaseURL: DefaultBaseURL,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// A Device is a hotspot or repeater registered with Brandmeister.
+type Device struct {
+	ID        int
+	Callsign  string
+	Hardware  string
+	Firmware  string
+	ColorCode int
+
+	// TxFrequency and RxFrequency are the device's own transmit and
+	// receive frequencies in MHz.  They are equal for a simplex
+	// hotspot.
+	TxFrequency float64
+	RxFrequency float64
+}
+
+// A StaticTalkgroup is a talkgroup permanently linked to a device.
+type StaticTalkgroup struct {
+	ID int
+
+	// Slot is the time slot, 1 or 2, or 0 on a simplex hotspot.
+	Slot int
+}
+
+// number decodes a JSON number that may also be sent as a string.
+type number string
+
+func (n *number) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" {
+		s = ""
+	}
+	*n = number(s)
+
+	return nil
+}
+
+func (n number) int() int {
+	i, _ := strconv.Atoi(string(n))
+	return i
+}
+
+func (n number) float() float64 {
+	f, _ := strconv.ParseFloat(string(n), 64)
+	return f
+}
+
+type deviceJSON struct {
+	ID        number
+	Callsign  string
+	Hardware  string
+	Firmware  string
+	ColorCode number
+	Tx        number
+	Rx        number
+}
+
+type talkgroupJSON struct {
+	Talkgroup number
+	Slot      number
+}
+
+func (c *Client) get(path string, v interface{}) error {
+	if c.APIKey == "" {
+		return errors.New("no Brandmeister API key")
+	}
+
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	req, err := http.NewRequest("GET", strings.TrimSuffix(baseURL, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	req.Header.Set("Accept", "application/json")
+
+	client := c.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case 200:
+	case 401, 403:
+		return fmt.Errorf("Brandmeister API key rejected: %s", resp.Status)
+	default:
+		return fmt.Errorf("Brandmeister %s: %s", path, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err != nil {
+		return fmt.Errorf("Brandmeister %s: %s", path, err.Error())
+	}
+
+	return nil
+}
+
+// Devices returns the hotspots and repeaters registered to callsign.
+func (c *Client) Devices(callsign string) ([]Device, error) {
+	var djs []deviceJSON
+	path := "/device/byCall?callsign=" + url.QueryEscape(strings.ToUpper(callsign))
+	err := c.get(path, &djs)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]Device, len(djs))
+	for i, dj := range djs {
+		devices[i] = Device{
+			ID:          dj.ID.int(),
+			Callsign:    dj.Callsign,
+			Hardware:    dj.Hardware,
+			Firmware:    dj.Firmware,
+			ColorCode:   dj.ColorCode.int(),
+			TxFrequency: dj.Tx.float(),
+			RxFrequency: dj.Rx.float(),
+		}
+	}
+
+	return devices, nil
+}
+
+// Device returns the device with the given ID.
+func (c *Client) Device(id int) (Device, error) {
+	var dj deviceJSON
+	err := c.get(fmt.Sprintf("/device/%d", id), &dj)
+	if err != nil {
+		return Device{}, err
+	}
+
+	return Device{
+		ID:          id,
+		Callsign:    dj.Callsign,
+		Hardware:    dj.Hardware,
+		Firmware:    dj.Firmware,
+		ColorCode:   dj.ColorCode.int(),
+		TxFrequency: dj.Tx.float(),
+		RxFrequency: dj.Rx.float(),
+	}, nil
+}
+
+// StaticTalkgroups returns the static talkgroups of the device with
+// the given ID.
+func (c *Client) StaticTalkgroups(id int) ([]StaticTalkgroup, error) {
+	var tjs []talkgroupJSON
+	err := c.get(fmt.Sprintf("/device/%d/talkgroup", id), &tjs)
+	if err != nil {
+		return nil, err
+	}
+
+	tgs := make([]StaticTalkgroup, 0, len(tjs))
+	for _, tj := range tjs {
+		if tj.Talkgroup.int() == 0 {
+			continue
+		}
+		tgs = append(tgs, StaticTalkgroup{
+			ID:   tj.Talkgroup.int(),
+			Slot: tj.Slot.int(),
+		})
+	}
+
+	return tgs, nil
+}
+
+// TalkgroupNames returns the names of all Brandmeister talkgroups,
+// indexed by talkgroup ID.
+func (c *Client) TalkgroupNames() (map[int]string, error) {
+	var m map[string]string
+	err := c.get("/talkgroup", &m)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[int]string, len(m))
+	for id, name := range m {
+		i, err := strconv.Atoi(id)
+		if err != nil {
+			continue
+		}
+		names[i] = strings.TrimSpace(name)
+	}
+
+	return names, nil
+}
+
+// Hotspot returns a codeplug hotspot for the device with the given ID,
+// with a channel for each of its static talkgroups.  The talkgroups
+// are named from names where possible.  A nil names leaves them
+// named "TG id".
+func (c *Client) Hotspot(id int, names map[int]string) (*codeplug.Hotspot, error) {
+	device, err := c.Device(id)
+	if err != nil {
+		return nil, err
+	}
+
+	statics, err := c.StaticTalkgroups(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(statics) == 0 {
+		return nil, fmt.Errorf("device %d has no static talkgroups", id)
+	}
+
+	hotspot := &codeplug.Hotspot{
+		Name:        device.Callsign,
+		RxFrequency: device.TxFrequency,
+		TxFrequency: device.RxFrequency,
+		ColorCode:   device.ColorCode,
+	}
+	for _, s := range statics {
+		tg := codeplug.Talkgroup{
+			ID:   codeplug.DmrID(s.ID),
+			Name: names[s.ID],
+			Slot: s.Slot,
+		}
+		if tg.Name == "" {
+			tg.Name = "TG " + tg.ID.String()
+		}
+		hotspot.Talkgroups = append(hotspot.Talkgroups, tg)
+	}
+
+	return hotspot, nil
+}
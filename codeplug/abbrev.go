@@ -0,0 +1,281 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// Generated names too long for the radio are shortened a word at a
+// time: first by abbreviating words found in the codeplug's
+// abbreviation dictionary, then by dropping vowels, and finally other
+// letters, from the longest words.  Words holding digits, such as
+// channel numbers and frequencies, are shortened last, and only ever
+// lose letters, so names differing only in their numbers stay
+// distinct.
+
+// DefaultAbbreviations is the abbreviation dictionary used unless
+// another is set with SetAbbreviations.  Its keys are lower case.
+var DefaultAbbreviations = map[string]string{
+	"county":        "Co",
+	"east":          "E",
+	"emergency":     "Emerg",
+	"hotspot":       "HS",
+	"international": "Intl",
+	"local":         "Loc",
+	"mount":         "Mt",
+	"mountain":      "Mtn",
+	"national":      "Natl",
+	"north":         "N",
+	"regional":      "Rgnl",
+	"repeater":      "Rpt",
+	"saint":         "St",
+	"simplex":       "Splx",
+	"south":         "S",
+	"statewide":     "SW",
+	"talkgroup":     "TG",
+	"west":          "W",
+	"worldwide":     "WW",
+}
+
+// ReadAbbreviations reads an abbreviation dictionary from CSV rows of
+// the form "word,abbreviation".  Each abbreviation must be shorter
+// than its word.
+func ReadAbbreviations(r io.Reader) (map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	abbrevs := make(map[string]string)
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) == 0 || len(row) == 1 && strings.TrimSpace(row[0]) == "" {
+			continue
+		}
+		if len(row) != 2 {
+			return nil, fmt.Errorf("line %d: want word,abbreviation", line)
+		}
+
+		word := strings.TrimSpace(row[0])
+		abbrev := strings.TrimSpace(row[1])
+		err = checkAbbreviation(word, abbrev)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err.Error())
+		}
+		abbrevs[strings.ToLower(word)] = abbrev
+	}
+
+	return abbrevs, nil
+}
+
+// LoadAbbreviations reads an abbreviation dictionary from the named
+// CSV file.
+func LoadAbbreviations(filename string) (map[string]string, error) {
+	file, err := vfs.OS.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	abbrevs, err := ReadAbbreviations(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return abbrevs, nil
+}
+
+// checkAbbreviation returns an error if abbrev cannot abbreviate word.
+func checkAbbreviation(word string, abbrev string) error {
+	switch {
+	case word == "" || strings.ContainsAny(word, " \t"):
+		return fmt.Errorf("bad word %q", word)
+
+	case utf8.RuneCountInString(abbrev) >= utf8.RuneCountInString(word):
+		return fmt.Errorf("abbreviation %q is not shorter than %q", abbrev, word)
+
+	case strings.ContainsAny(abbrev, " \t"):
+		return fmt.Errorf("bad abbreviation %q", abbrev)
+	}
+
+	return nil
+}
+
+// SetAbbreviations sets the abbreviation dictionary used to shorten
+// the names generated for the codeplug.  A nil abbrevs restores
+// DefaultAbbreviations.
+func (cp *Codeplug) SetAbbreviations(abbrevs map[string]string) error {
+	lower := make(map[string]string, len(abbrevs))
+	for word, abbrev := range abbrevs {
+		err := checkAbbreviation(word, abbrev)
+		if err != nil {
+			return err
+		}
+		lower[strings.ToLower(word)] = abbrev
+	}
+
+	cp.abbreviations = lower
+	if abbrevs == nil {
+		cp.abbreviations = nil
+	}
+
+	return nil
+}
+
+// Abbreviations returns the codeplug's abbreviation dictionary.
+func (cp *Codeplug) Abbreviations() map[string]string {
+	if cp.abbreviations == nil {
+		return DefaultAbbreviations
+	}
+
+	return cp.abbreviations
+}
+
+// ShortenName returns name shortened, as described above, to at most
+// maxLen characters, using the abbreviation dictionary abbrevs.  A name
+// left with only digits, punctuation and single letters can't be
+// shortened further, and may remain longer than maxLen.
+func ShortenName(name string, maxLen int, abbrevs map[string]string) string {
+	value := []rune(strings.Join(strings.Fields(name), " "))
+	for len(value) > maxLen {
+		shorter, ok := shortenOnce(value, abbrevs)
+		if !ok {
+			break
+		}
+		value = shorter
+	}
+
+	return strings.TrimSpace(string(value))
+}
+
+// shortenOnce returns value shortened by at least one character, and
+// whether it could be shortened.
+func shortenOnce(value []rune, abbrevs map[string]string) ([]rune, bool) {
+	abbreviated, ok := abbreviateOnce(value, abbrevs)
+	if ok {
+		return abbreviated, true
+	}
+
+	return dropOnce(value)
+}
+
+// abbreviateOnce returns value with the word saving the most characters
+// abbreviated, and whether any word could be abbreviated.
+func abbreviateOnce(value []rune, abbrevs map[string]string) ([]rune, bool) {
+	words := strings.Fields(string(value))
+	best := -1
+	bestSaving := 0
+	for i, word := range words {
+		abbrev, ok := abbrevs[strings.ToLower(word)]
+		if !ok {
+			continue
+		}
+		saving := utf8.RuneCountInString(word) - utf8.RuneCountInString(abbrev)
+		if saving > bestSaving {
+			best = i
+			bestSaving = saving
+		}
+	}
+	if best < 0 {
+		return value, false
+	}
+
+	abbrev := abbrevs[strings.ToLower(words[best])]
+	if strings.ToUpper(words[best]) == words[best] {
+		abbrev = strings.ToUpper(abbrev)
+	}
+	words[best] = abbrev
+
+	return []rune(strings.Join(words, " ")), true
+}
+
+// dropOnce returns value with a letter dropped from its longest word
+// having one to drop, preferring words without digits, and whether a
+// letter could be dropped.
+func dropOnce(value []rune) ([]rune, bool) {
+	words := strings.Fields(string(value))
+	longest := -1
+	for _, digits := range []bool{false, true} {
+		for i, word := range words {
+			if strings.IndexFunc(word, unicode.IsDigit) >= 0 != digits {
+				continue
+			}
+			if dropLetterIndex(word) < 0 {
+				continue
+			}
+			n := utf8.RuneCountInString(word)
+			if longest < 0 || n > utf8.RuneCountInString(words[longest]) {
+				longest = i
+			}
+		}
+		if longest >= 0 {
+			break
+		}
+	}
+	if longest < 0 {
+		return value, false
+	}
+
+	runes := []rune(words[longest])
+	i := dropLetterIndex(words[longest])
+	words[longest] = string(append(runes[:i], runes[i+1:]...))
+
+	return []rune(strings.Join(words, " ")), true
+}
+
+// dropLetterIndex returns the index of the rune to drop from word: its
+// last vowel after the first rune, or else its last letter after the
+// first rune.  It returns -1 if word has no such letter.  Digits and
+// punctuation are never dropped, so numbers such as frequencies are
+// kept whole.
+func dropLetterIndex(word string) int {
+	runes := []rune(word)
+	for i := len(runes) - 1; i > 0; i-- {
+		if strings.ContainsRune("aeiouAEIOU", runes[i]) {
+			return i
+		}
+	}
+	for i := len(runes) - 1; i > 0; i-- {
+		if unicode.IsLetter(runes[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,178 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ChannelProblem is a likely data-entry error in a channel.
+type ChannelProblem struct {
+	Channel string
+	Problem string
+}
+
+// String returns the problem as "channel: problem".
+func (p ChannelProblem) String() string {
+	return fmt.Sprintf("%s: %s", p.Channel, p.Problem)
+}
+
+// repeaterBand is an amateur band and the repeater splits, in MHz,
+// used on it.
+type repeaterBand struct {
+	name   string
+	min    float64
+	max    float64
+	splits []float64
+}
+
+var repeaterBands = []repeaterBand{
+	{"6m", 50, 54, []float64{0.5, 0.6, 1.0, 1.7}},
+	{"2m", 144, 148, []float64{0.6}},
+	{"1.25m", 219, 225, []float64{1.6}},
+	{"70cm", 420, 450, []float64{1.6, 5.0, 7.6, 9.0}},
+	{"33cm", 902, 928, []float64{12, 25}},
+	{"23cm", 1240, 1300, []float64{6, 12, 20, 28}},
+}
+
+// findRepeaterBand returns the amateur band containing the frequency,
+// or nil if there is none.
+func findRepeaterBand(frequency float64) *repeaterBand {
+	for i := range repeaterBands {
+		band := &repeaterBands[i]
+		if frequency >= band.min && frequency <= band.max {
+			return band
+		}
+	}
+	return nil
+}
+
+// AnalyzeAnalogChannels returns the likely data-entry errors in the
+// codeplug's analog channels: transmit offsets that aren't a standard
+// repeater split for the band, invalid or mismatched CTCSS/DCS tones,
+// and channels on the same frequency with different bandwidths.
+func (cp *Codeplug) AnalyzeAnalogChannels() []ChannelProblem {
+	var problems []ChannelProblem
+	problem := func(ch *Record, format string, v ...interface{}) {
+		problems = append(problems, ChannelProblem{
+			Channel: ch.Name(),
+			Problem: fmt.Sprintf(format, v...),
+		})
+	}
+
+	var frequencies []string
+	bandwidths := make(map[string][]*Record)
+
+	for _, ch := range cp.records(RtChannels_md380) {
+		if ch.Field(FtCiChannelMode).String() != "Analog" {
+			continue
+		}
+
+		rxString := ch.Field(FtCiRxFrequency).String()
+		if bandwidths[rxString] == nil {
+			frequencies = append(frequencies, rxString)
+		}
+		bandwidths[rxString] = append(bandwidths[rxString], ch)
+
+		rx, err := strconv.ParseFloat(rxString, 64)
+		if err != nil {
+			continue
+		}
+		tx, err := strconv.ParseFloat(ch.Field(FtCiTxFrequency).String(), 64)
+		if err != nil || ch.Field(FtCiRxOnly).String() == "On" {
+			tx = rx
+		}
+
+		if band := findRepeaterBand(rx); band != nil {
+			offset := tx - rx
+			switch {
+			case math.Abs(offset) < 0.0005:
+
+			case findRepeaterBand(tx) != band:
+				problem(ch, "transmit frequency %s is outside the %s band",
+					frequencyToString(tx), band.name)
+
+			case !standardSplit(band, offset):
+				var splits []string
+				for _, split := range band.splits {
+					splits = append(splits, fmt.Sprintf("%g", split))
+				}
+				problem(ch, "offset %+.4f MHz is not a %s repeater split (%s MHz)",
+					offset, band.name, strings.Join(splits, ", "))
+			}
+		}
+
+		encode := ch.Field(FtCiCtcssEncode)
+		decode := ch.Field(FtCiCtcssDecode)
+		for _, f := range []*Field{encode, decode} {
+			if !f.IsValid() {
+				problem(ch, "%s is not a valid CTCSS/DCS tone", f.TypeName())
+			}
+		}
+		if !encode.IsValid() || !decode.IsValid() {
+			continue
+		}
+
+		encodeTone := encode.String()
+		decodeTone := decode.String()
+		switch {
+		case encodeTone == "None" && decodeTone != "None":
+			problem(ch, "decodes tone %s but encodes none", decodeTone)
+
+		case encodeTone != "None" && decodeTone != "None" &&
+			strings.HasPrefix(encodeTone, "D") != strings.HasPrefix(decodeTone, "D"):
+			problem(ch, "encodes %s but decodes %s", encodeTone, decodeTone)
+		}
+	}
+
+	for _, frequency := range frequencies {
+		channels := bandwidths[frequency]
+		first := channels[0].Field(FtCiBandwidth).String()
+		for _, ch := range channels[1:] {
+			bandwidth := ch.Field(FtCiBandwidth).String()
+			if bandwidth != first {
+				problem(ch, "bandwidth %s kHz differs from the %s kHz of %s on the same frequency",
+					bandwidth, first, channels[0].Name())
+			}
+		}
+	}
+
+	return problems
+}
+
+// standardSplit returns true if the offset, in either direction, is one
+// of the band's repeater splits.
+func standardSplit(band *repeaterBand, offset float64) bool {
+	for _, split := range band.splits {
+		if math.Abs(math.Abs(offset)-split) < 0.0005 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"time"
+)
+
+// Modification records are not stored in the radio's codeplug.  Like
+// locks, they are saved in, and read from, text and JSON files as the
+// pseudo-fields named below.
+const (
+	ModifiedByFieldName   = "ModifiedBy"
+	ModifiedWithFieldName = "ModifiedWith"
+	ModifiedAtFieldName   = "ModifiedAt"
+)
+
+// A Modification describes the last change made to a record: who made
+// it, with which tool, and when.
+type Modification struct {
+	Author string
+	Tool   string
+	Time   time.Time
+}
+
+// Modification returns the record's last modification, and false if
+// none is recorded.
+func (r *Record) Modification() (Modification, bool) {
+	if r.modification == nil {
+		return Modification{}, false
+	}
+
+	return *r.modification, true
+}
+
+// SetModification sets the record's last modification.
+func (r *Record) SetModification(m Modification) {
+	r.modification = &m
+}
+
+// ClearModification removes the record's modification record.
+func (r *Record) ClearModification() {
+	r.modification = nil
+}
+
+// SetAuditor makes each later change to a record record author and
+// tool, with the time of the change, as the record's modification.
+// An empty author and tool stop the recording.
+func (cp *Codeplug) SetAuditor(author string, tool string) {
+	cp.auditAuthor = author
+	cp.auditTool = tool
+}
+
+// audit records a change to the record, if an auditor is set.
+func (r *Record) audit() {
+	cp := r.codeplug
+	if !cp.loaded || cp.auditAuthor == "" && cp.auditTool == "" {
+		return
+	}
+
+	r.modification = &Modification{
+		Author: cp.auditAuthor,
+		Tool:   cp.auditTool,
+		Time:   time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// isAuditFieldName returns true if name names a modification
+// pseudo-field.
+func isAuditFieldName(name string) bool {
+	switch name {
+	case ModifiedByFieldName, ModifiedWithFieldName, ModifiedAtFieldName:
+		return true
+	}
+
+	return false
+}
+
+// setAuditField sets part of the record's modification from the value
+// of a modification pseudo-field.
+func (r *Record) setAuditField(name string, value string) error {
+	m, _ := r.Modification()
+
+	switch name {
+	case ModifiedByFieldName:
+		m.Author = value
+
+	case ModifiedWithFieldName:
+		m.Tool = value
+
+	case ModifiedAtFieldName:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("bad modification time: %s", value)
+		}
+		m.Time = t
+	}
+
+	r.SetModification(m)
+
+	return nil
+}
+
+// auditFields returns the names and values of the record's
+// modification pseudo-fields, if it has a modification record.
+func (r *Record) auditFields() (names []string, values []string) {
+	m, ok := r.Modification()
+	if !ok {
+		return nil, nil
+	}
+
+	if m.Author != "" {
+		names = append(names, ModifiedByFieldName)
+		values = append(values, m.Author)
+	}
+	if m.Tool != "" {
+		names = append(names, ModifiedWithFieldName)
+		values = append(values, m.Tool)
+	}
+	if !m.Time.IsZero() {
+		names = append(names, ModifiedAtFieldName)
+		values = append(values, m.Time.Format(time.RFC3339))
+	}
+
+	return names, values
+}
@@ -0,0 +1,198 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// radioBackupPrefix begins the names of the files written by
+// backupRadio, so that pruning removes no others.
+const radioBackupPrefix = "radio-"
+
+// DefaultRadioBackupKeep is the number of radio backups kept by default.
+const DefaultRadioBackupKeep = 10
+
+// radioBackupMutex serializes the naming and pruning of backups of
+// radios written at the same time.
+var radioBackupMutex sync.Mutex
+
+// DefaultRadioBackupDir returns the directory in the user's
+// configuration directory that holds radio backups.
+func DefaultRadioBackupDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "codeplug", "backups"), nil
+}
+
+// SetRadioBackup arranges for WriteRadio, WriteRadioRecords and
+// WriteRadios to read the codeplug in each radio and save it as a
+// timestamped .rdt file in dir before overwriting it with the codeplug.
+// If the backup cannot be made, the radio is not written.  Only the
+// keep most recent backups in dir are retained, or all of them if keep
+// is 0, though all the backups made by one call of WriteRadios are
+// retained.  The backups are written to the codeplug's file system.
+// An empty dir, the default, disables backups.
+func (cp *Codeplug) SetRadioBackup(dir string, keep int) error {
+	if keep < 0 {
+		return fmt.Errorf("invalid number of backups to keep: %d", keep)
+	}
+
+	cp.radioBackupDir = dir
+	cp.radioBackupKeep = keep
+
+	return nil
+}
+
+// RadioBackupDir returns the directory set by SetRadioBackup, or the
+// empty string if backups are disabled.
+func (cp *Codeplug) RadioBackupDir() string {
+	return cp.radioBackupDir
+}
+
+// backupRadio reads the codeplug in the radio connected by t and saves
+// it in the backup directory, if backups are enabled, returning the
+// backup's filename.  The suffix, if not empty, distinguishes the
+// backups of radios written at the same time.  Old backups are not
+// pruned; see pruneRadioBackups.
+func (cp *Codeplug) backupRadio(t Transport, suffix string) (string, error) {
+	dir := cp.radioBackupDir
+	if dir == "" {
+		return "", nil
+	}
+
+	cpi := cp.codeplugInfo
+	bytes := make([]byte, cpi.RdtSize)
+	copy(bytes, cp.bytes[:cpi.RdtSize])
+
+	binBytes := bytes[cpi.BinOffset : cpi.BinOffset+cpi.BinSize]
+	err := t.ReadCodeplugRegion(binBytes, 0, len(binBytes))
+	if err != nil {
+		return "", fmt.Errorf("backup of radio failed: %s", err.Error())
+	}
+	fixRdt(bytes, cpi.BinSize)
+
+	radioBackupMutex.Lock()
+	defer radioBackupMutex.Unlock()
+
+	err = vfs.MkdirAll(cp.fsys, dir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("backup of radio failed: %s", err.Error())
+	}
+
+	base := radioBackupPrefix + time.Now().Format("20060102-150405.000") + suffix
+	filename := filepath.Join(dir, base+".rdt")
+	for i := 2; ; i++ {
+		_, err := fs.Stat(cp.fsys, filename)
+		if errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		filename = filepath.Join(dir, fmt.Sprintf("%s-%d.rdt", base, i))
+	}
+
+	err = vfs.WriteFile(cp.fsys, filename, bytes, 0644)
+	if err != nil {
+		return "", fmt.Errorf("backup of radio failed: %s", err.Error())
+	}
+
+	return filename, nil
+}
+
+// RadioBackups returns the filenames of the backups in dir of fsys,
+// oldest first.
+func RadioBackups(fsys vfs.FS, dir string) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var filenames []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, radioBackupPrefix) ||
+			filepath.Ext(name) != ".rdt" {
+			continue
+		}
+		filenames = append(filenames, filepath.Join(dir, name))
+	}
+	sort.Strings(filenames)
+
+	return filenames, nil
+}
+
+// pruneRadioBackups removes all but the most recent backups in the
+// backup directory, keeping as many as set by SetRadioBackup, or all of
+// them if that is 0.  The backups in batch, just made of radios about
+// to be written, are never removed, even if there are more of them
+// than are to be kept.
+func (cp *Codeplug) pruneRadioBackups(batch ...string) error {
+	dir := cp.radioBackupDir
+	keep := cp.radioBackupKeep
+	if dir == "" || keep == 0 {
+		return nil
+	}
+
+	radioBackupMutex.Lock()
+	defer radioBackupMutex.Unlock()
+
+	filenames, err := RadioBackups(cp.fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	excess := len(filenames) - keep
+	for _, filename := range filenames {
+		if excess <= 0 {
+			break
+		}
+		if containsString(batch, filename) {
+			continue
+		}
+		err := vfs.Remove(cp.fsys, filename)
+		if err != nil {
+			return err
+		}
+		excess--
+	}
+
+	return nil
+}
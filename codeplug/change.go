@@ -105,7 +105,7 @@ func (change *Change) refStrings() []string {
 			return strings
 		}
 		f := fields[0]
-		allFields := (*f.record.fDesc)[f.fType].fields
+		allFields := f.record.fDescs()[f.fType].fields
 		for i, f := range fields {
 			strings[i] = ""
 			if f.fIndex > 0 && f.fIndex <= len(allFields) {
@@ -164,6 +164,7 @@ const (
 	InsertFieldsChange  ChangeType = "InsertFieldsChange"
 	RemoveFieldsChange  ChangeType = "RemoveFieldsChange"
 	ListIndexChange     ChangeType = "ListIndexChange"
+	RenameRecordsChange ChangeType = "RenameRecordsChange"
 )
 
 func fieldChange(f *Field, previousValue string) *Change {
@@ -198,6 +199,37 @@ func recordsChange(t ChangeType, records []*Record) *Change {
 	return &change
 }
 
+// renameRecordsChange returns a change renaming records, whose names
+// were previously previousNames.
+func renameRecordsChange(records []*Record, previousNames []string) *Change {
+	fields := make([]*Field, len(records))
+	for i, r := range records {
+		fields[i] = r.NameField()
+	}
+
+	change := Change{
+		cType:   RenameRecordsChange,
+		records: records,
+		fields:  fields,
+		strings: previousNames,
+	}
+
+	return &change
+}
+
+// swapFieldStrings sets each of the change's fields to its saved
+// string, saving the field's current string in its place.
+func (change *Change) swapFieldStrings() {
+	for i, f := range change.fields {
+		s := f.String()
+		err := f.setString(change.strings[i])
+		if err != nil {
+			logFatal("swapFieldStrings: error ", err.Error())
+		}
+		change.strings[i] = s
+	}
+}
+
 func listIndexChange(r *Record, fields []*Field) *Change {
 	change := Change{
 		cType:   ListIndexChange,
@@ -329,7 +361,7 @@ func (cp *Codeplug) updateListIndexChanges(changes []*Change) []*Change {
 			}
 		}
 		if len(r.Fields(fType)) == 0 {
-			fd := (*r.fDesc)[fType]
+			fd := r.fDescs()[fType]
 			indexedStrings := fd.indexedStrings
 			if indexedStrings != nil {
 				iStrs := *indexedStrings
@@ -373,6 +405,9 @@ func (change *Change) Complete() {
 	if change != cp.currentChange() {
 		cp.addChange(change)
 	}
+	for _, r := range change.records {
+		r.audit()
+	}
 	cp.publishChange(change)
 }
 
@@ -423,7 +458,7 @@ func (change *Change) undoReference() string {
 			return "to top"
 		}
 
-		allFields := (*r.fDesc)[f.fType].fields
+		allFields := r.fDescs()[f.fType].fields
 		return "after " + allFields[index].String()
 	}
 	return ""
@@ -506,6 +541,10 @@ func (cp *Codeplug) UndoString() string {
 		names := maxNamesString(fieldNames(change.fields), 5)
 		str = fmt.Sprintf("%s.%sdelete %s", rTypeName, rName, names)
 
+	case RenameRecordsChange:
+		names := maxNamesString(recordNames(change.records), 5)
+		str = fmt.Sprintf("%s: rename %s", rTypeName, names)
+
 	default:
 		logFatal("undoString: unexpected change type:", cType)
 	}
@@ -577,6 +616,10 @@ func (cp *Codeplug) RedoString() string {
 		names := maxNamesString(fieldNames(change.fields), 5)
 		str = fmt.Sprintf("%s.%s: delete %s", rTypeName, rName, names)
 
+	case RenameRecordsChange:
+		names := maxNamesString(recordNames(change.records), 5)
+		str = fmt.Sprintf("%s: rename %s", rTypeName, names)
+
 	default:
 		logFatal("undoString: unexpected change type:", cType)
 	}
@@ -711,6 +754,9 @@ func (cp *Codeplug) undoChange(change *Change) *Change {
 		c := change
 		c.strings, c.afterStrings = c.afterStrings, c.strings
 
+	case RenameRecordsChange:
+		change.swapFieldStrings()
+
 	default:
 		logFatal("Undo: unexpected change type:", cType)
 	}
@@ -835,6 +881,9 @@ func (cp *Codeplug) redoChange(change *Change) *Change {
 		c := change
 		c.strings, c.afterStrings = c.afterStrings, c.strings
 
+	case RenameRecordsChange:
+		change.swapFieldStrings()
+
 	default:
 		logFatal("Redo: unexpected change type:", cType)
 	}
@@ -0,0 +1,123 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// CloneRecord adds a copy of r for each of values, with the copy's
+// field of type fType set to the value, as in a channel cloned for
+// each of several contacts.  The copies follow r, in the order of
+// values.  They are named by nameTemplate, in which {name} is r's
+// name, {value} is the copy's value and {n} is its number, from 1;
+// "{name} {value}" is used if nameTemplate is empty.  The codeplug is
+// not changed if any copy can't be made, and the copies are undone as
+// a single change.
+func (cp *Codeplug) CloneRecord(r *Record, fType FieldType, values []string, nameTemplate string) ([]*Record, error) {
+	if nameTemplate == "" {
+		nameTemplate = "{name} {value}"
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no values")
+	}
+	if r.NameField() == nil {
+		return nil, fmt.Errorf("%s records have no names", r.Type())
+	}
+	if fType == r.NameFieldType() {
+		return nil, fmt.Errorf("use the name template to vary names")
+	}
+	if r.fDescs()[fType] == nil {
+		return nil, fmt.Errorf("%s records have no %s field", r.Type(), fType)
+	}
+	if len(cp.records(r.rType))+len(values) > cp.MaxRecords(r.rType) {
+		return nil, fmt.Errorf("too many %s", r.rType)
+	}
+
+	policy, err := cp.NewNamingPolicy(r.rType, nameTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	clones := make([]*Record, len(values))
+	for i, value := range values {
+		name, err := policy.Name(map[string]string{
+			"name":  r.Name(),
+			"value": value,
+			"n":     strconv.Itoa(i + 1),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		clone := r.Copy()
+		err = clone.setFieldValues([]fieldValue{
+			{r.NameFieldType(), name},
+			{fType, value},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err.Error())
+		}
+		clones[i] = clone
+	}
+
+	change := cp.InsertRecordsChange(clones)
+	for i, clone := range clones {
+		clone.SetIndex(r.Index() + 1 + i)
+		err := cp.InsertRecord(clone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	change.Complete()
+
+	cp.changed = true
+
+	return clones, nil
+}
+
+// CloneRecordByName is like CloneRecord, but takes the record and field
+// types as named in text files, and the name of the record to clone.
+func (cp *Codeplug) CloneRecordByName(rTypeName string, name string, fieldTypeName string, values []string, nameTemplate string) ([]*Record, error) {
+	rType, err := cp.nameToRt(rTypeName)
+	if err != nil {
+		return nil, err
+	}
+
+	fType, err := cp.nameToFt(rType, fieldTypeName)
+	if err != nil {
+		return nil, err
+	}
+
+	r := cp.FindRecordByName(rType, name)
+	if r == nil {
+		return nil, fmt.Errorf("no %s record named '%s'", rType, name)
+	}
+
+	return cp.CloneRecord(r, fType, values, nameTemplate)
+}
@@ -23,6 +23,17 @@
 
 // Package codeplug implements access to MD380-style codeplug files.
 // It can read/update/write both .rdt files and .bin files.
+//
+// Different codeplugs may be created, loaded and used by different
+// goroutines at once.  A single loaded codeplug may be read by several
+// goroutines at once, by methods and functions that do not change it,
+// such as Records, Fields, String, Diff and the Write and Export
+// methods; the state those build lazily is guarded by the codeplug.
+// A codeplug that is being changed must not be used by any other
+// goroutine, and callers provide that exclusion, for example with a
+// sync.RWMutex per codeplug.  Package-level settings, such as those
+// made by AddDefinitions, RegisterFileFormat and SetTransport, are
+// meant to be made before codeplugs are used concurrently.
 package codeplug
 
 import (
@@ -30,20 +41,24 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
 	"github.com/dalefarnsworth/codeplug/dfu"
+	"github.com/dalefarnsworth/codeplug/vfs"
 	"github.com/tealeg/xlsx"
 )
 
@@ -58,6 +73,7 @@ const (
 	FileTypeText
 	FileTypeJSON
 	FileTypeXLSX
+	FileTypeFormat
 )
 
 const (
@@ -70,6 +86,7 @@ type Codeplug struct {
 	filename            string
 	importFilename      string
 	fileType            FileType
+	fileFormat          *FileFormat
 	rdtSize             int
 	fileSize            int
 	fileOffset          int
@@ -88,6 +105,28 @@ type Codeplug struct {
 	cachedNameToRt      map[string]RecordType
 	cachedNameToFt      map[RecordType]map[string]FieldType
 	deferredValueFields []*Field
+	provenance          *Provenance
+	trustedKeys         []ed25519.PublicKey
+	importData          []byte
+	loadedUnknownBytes  []byte
+	lockOverride        bool
+	auditAuthor         string
+	auditTool           string
+	fsys                vfs.FS
+	parseMode           ParseMode
+	parseWarnings       []ParseWarning
+	policies            []*Policy
+	rules               []*Rule
+	flashDump           bool
+	talkgroupNames      map[DmrID]string
+	abbreviations       map[string]string
+	defaultBytes        []byte
+	rebootTimeout       time.Duration
+	radioBackupDir      string
+	radioBackupKeep     int
+	ignoreModelMismatch bool
+	cacheMutex          sync.Mutex
+	recordsMutex        sync.Mutex
 }
 
 type CodeplugInfo struct {
@@ -102,8 +141,15 @@ type CodeplugInfo struct {
 
 // NewCodeplug returns a Codeplug, given a filename and codeplug type.
 func NewCodeplug(fType FileType, filename string) (*Codeplug, error) {
+	return NewCodeplugFS(vfs.OS, fType, filename)
+}
+
+// NewCodeplugFS returns a Codeplug whose files are read from and
+// written to fsys, given a filename and codeplug type.
+func NewCodeplugFS(fsys vfs.FS, fType FileType, filename string) (*Codeplug, error) {
 	cp := new(Codeplug)
 	cp.fileType = fType
+	cp.fsys = fsys
 
 	switch fType {
 	case FileTypeNone:
@@ -119,7 +165,7 @@ func NewCodeplug(fType FileType, filename string) (*Codeplug, error) {
 			}
 		}
 
-	case FileTypeText, FileTypeJSON, FileTypeXLSX:
+	case FileTypeText, FileTypeJSON, FileTypeXLSX, FileTypeFormat:
 		cp.importFilename = filename
 		fallthrough
 
@@ -129,7 +175,7 @@ func NewCodeplug(fType FileType, filename string) (*Codeplug, error) {
 			filename = fmt.Sprintf("%s%d", baseName, i)
 
 			found := false
-			for _, cp := range codeplugs {
+			for _, cp := range Codeplugs() {
 				if strings.HasPrefix(cp.filename, filename) {
 					found = true
 					break
@@ -190,7 +236,7 @@ findCodeplugInfo:
 	}
 
 	switch cp.fileType {
-	case FileTypeNew, FileTypeBin, FileTypeText, FileTypeJSON, FileTypeXLSX:
+	case FileTypeNew, FileTypeBin, FileTypeText, FileTypeJSON, FileTypeXLSX, FileTypeFormat:
 		var filename string
 		for i, v := range cp.frequencyRanges() {
 			if v == frequencyRange {
@@ -209,10 +255,19 @@ findCodeplugInfo:
 
 	switch cp.fileType {
 	case FileTypeRdt, FileTypeBin:
+		if cp.flashDump {
+			cp.setFlashDumpRegion()
+		}
 		err := cp.read(cp.filename)
 		if err != nil {
 			return err
 		}
+		if cp.flashDump {
+			err = cp.checkFlashDumpRegion()
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	err := cp.Revert(ignoreWarnings)
@@ -221,7 +276,7 @@ findCodeplugInfo:
 	}
 
 	switch cp.fileType {
-	case FileTypeText, FileTypeJSON, FileTypeXLSX:
+	case FileTypeText, FileTypeJSON, FileTypeXLSX, FileTypeFormat:
 		for _, rType := range cp.RecordTypes() {
 			if cp.MaxRecords(rType) == 1 {
 				continue
@@ -240,8 +295,10 @@ findCodeplugInfo:
 			err = cp.importJSON(cp.importFilename)
 		case FileTypeXLSX:
 			err = cp.importXLSX(cp.importFilename)
+		case FileTypeFormat:
+			err = cp.importFormat(cp.importFilename, ignoreWarnings)
 		}
-		if _, warning := err.(Warning); warning && ignoreWarnings {
+		if _, warning := err.(Warning); warning && (ignoreWarnings || cp.parseMode == ParseLenient) {
 			err = nil
 		}
 
@@ -250,7 +307,9 @@ findCodeplugInfo:
 		}
 	}
 
+	codeplugsMutex.Lock()
 	codeplugs = append(codeplugs, cp)
+	codeplugsMutex.Unlock()
 	cp.loaded = true
 
 	return nil
@@ -309,7 +368,7 @@ func (cp *Codeplug) ModelsFrequencyRanges() (models []string, frequencyRanges ma
 	switch cp.fileType {
 	case FileTypeRdt:
 
-	case FileTypeText, FileTypeJSON, FileTypeXLSX:
+	case FileTypeText, FileTypeJSON, FileTypeXLSX, FileTypeFormat:
 		model, frequencyRange = cp.parseModelFrequencyRange()
 		fallthrough
 	default:
@@ -355,13 +414,13 @@ func (cp *Codeplug) ModelsFrequencyRanges() (models []string, frequencyRanges ma
 }
 
 func (cp *Codeplug) Model() string {
-	fDescs := cp.rDesc[RtBasicInformation_md380].records[0].fDesc
-	return (*fDescs)[FtBiModel].fields[0].String()
+	fDescs := cp.rDesc[RtBasicInformation_md380].records[0].fDescs()
+	return fDescs[FtBiModel].fields[0].String()
 }
 
 func (cp *Codeplug) FrequencyRange() string {
-	fDescs := cp.rDesc[RtBasicInformation_md380].records[0].fDesc
-	return (*fDescs)[FtBiFrequencyRange_md380].fields[0].String()
+	fDescs := cp.rDesc[RtBasicInformation_md380].records[0].fDescs()
+	return fDescs[FtBiFrequencyRange_md380].fields[0].String()
 }
 
 func (cp *Codeplug) frequencyRanges() []string {
@@ -396,11 +455,17 @@ func (cp *Codeplug) Type() string {
 
 // Codeplugs returns a slice containing all currently open codeplugs.
 func Codeplugs() []*Codeplug {
-	return codeplugs
+	codeplugsMutex.Lock()
+	defer codeplugsMutex.Unlock()
+
+	return append([]*Codeplug(nil), codeplugs...)
 }
 
 // Free frees a codeplug
 func (cp *Codeplug) Free() {
+	codeplugsMutex.Lock()
+	defer codeplugsMutex.Unlock()
+
 	for i, codeplug := range codeplugs {
 		if cp == codeplug {
 			codeplugs = append(codeplugs[:i], codeplugs[i+1:]...)
@@ -413,6 +478,18 @@ func (cp *Codeplug) Free() {
 }
 
 func (cp *Codeplug) readNew(filename string) error {
+	bytes, err := newFileBytes(filename)
+	if err != nil {
+		return err
+	}
+
+	cp.bytes = bytes
+
+	return nil
+}
+
+// newFileBytes returns the contents of the named blank codeplug file.
+func newFileBytes(filename string) ([]byte, error) {
 	gzipped := bytes.NewReader(new_tgz)
 
 	archive, err := gzip.NewReader(gzipped)
@@ -442,28 +519,35 @@ func (cp *Codeplug) readNew(filename string) error {
 	}
 
 	if len(bytes) == 0 {
-		return fmt.Errorf("file %s not found", filename)
+		return nil, fmt.Errorf("file %s not found", filename)
 	}
 
-	cp.bytes = bytes
-
-	return nil
+	return bytes, nil
 }
 
 // read opens a file and reads its contents into cp.bytes.
 func (cp *Codeplug) read(filename string) error {
-	file, err := os.Open(filename)
-	if err != nil {
-		return err
+	var file io.Reader
+	if cp.importData != nil {
+		file = bytes.NewReader(cp.importData)
+	} else {
+		f, err := cp.fsys.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		file = f
 	}
-	defer file.Close()
 
 	if cp.bytes == nil {
 		cp.bytes = make([]byte, cp.fileOffset+cp.fileSize)
 	}
 	bytes := cp.bytes[cp.fileOffset : cp.fileOffset+cp.fileSize]
 
-	bytesRead, err := file.Read(bytes)
+	bytesRead, err := io.ReadFull(file, bytes)
+	if err == io.ErrUnexpectedEOF {
+		err = nil
+	}
 	if err != nil {
 		return err
 	}
@@ -473,19 +557,31 @@ func (cp *Codeplug) read(filename string) error {
 		return err
 	}
 
+	if cp.fileType == FileTypeRdt {
+		if err := checkRdt(bytes); err != nil {
+			return fmt.Errorf("%s: %s", filename, err.Error())
+		}
+	}
+
 	return nil
 }
 
 // Revert reverts the codeplug to its state after the most recent open or
 // save operation.  An error is returned if the new codeplug state is
-// invalid.
+// invalid, unless ignoreWarnings is true, in which case the records'
+// fields are parsed and checked only when they are first used.
 func (cp *Codeplug) Revert(ignoreWarnings bool) error {
 	cp.clearCachedListNames()
 
 	cp.load()
+	cp.loadedUnknownBytes = cp.unknownBytes()
 
-	if err := cp.valid(); err != nil && !ignoreWarnings {
-		return err
+	// Checking the fields parses every record, so it is done only
+	// when the warnings are wanted.
+	if !ignoreWarnings {
+		if err := cp.valid(); err != nil {
+			return err
+		}
 	}
 
 	cp.changed = false
@@ -507,12 +603,17 @@ func (cp *Codeplug) Save(ignoreWarnings bool) error {
 // An error will be returned if the codeplug state is invalid.
 // The named file becomes the current file associated with the codeplug.
 func (cp *Codeplug) SaveAs(filename string, ignoreWarnings bool) error {
+	if cp.flashDump && filename == cp.filename {
+		return fmt.Errorf("%s: a flash dump cannot be overwritten; save as another file", filename)
+	}
+
 	err := cp.SaveToFile(filename, ignoreWarnings)
 	if err != nil {
 		return err
 	}
 
 	cp.filename = filename
+	cp.flashDump = false
 	cp.changed = false
 	cp.hash = sha256.Sum256(cp.bytes)
 
@@ -524,55 +625,59 @@ func (cp *Codeplug) SaveAs(filename string, ignoreWarnings bool) error {
 // The state of the codeplug is not changed, so this
 // is useful for use by an autosave function.
 func (cp *Codeplug) SaveToFile(filename string, ignoreWarnings bool) (err error) {
-	if err = cp.valid(); err != nil {
-		_, warning := err.(Warning)
-		if !warning || !ignoreWarnings {
-			return err
-		}
+	bytes, err := cp.Bytes(ignoreWarnings)
+	if err != nil {
+		return err
 	}
 
-	cp.setLastProgrammedTime(time.Now())
-
-	cp.store()
-
-	dir, base := filepath.Split(filename)
-	tmpFile, err := ioutil.TempFile(dir, base)
+	file, err := cp.fsys.Create(filename)
 	if err != nil {
 		return err
 	}
-	tmpFilename := tmpFile.Name()
-
 	defer func() {
-		closeErr := tmpFile.Close()
+		fErr := file.Close()
 		if err == nil {
-			err = closeErr
-		}
-
-		if err != nil {
-			os.Remove(tmpFilename)
-			return
+			err = fErr
 		}
-
-		err = os.Rename(tmpFilename, filename)
 	}()
 
-	cpi := cp.codeplugInfo
-	fileSize := cpi.RdtSize
-	fileOffset := 0
-
-	bytes := cp.bytes[fileOffset : fileOffset+fileSize]
-	bytesWritten, err := tmpFile.Write(bytes)
+	bytesWritten, err := file.Write(bytes)
 	if err != nil {
 		return err
 	}
 
-	if bytesWritten != fileSize {
+	if bytesWritten != len(bytes) {
 		return fmt.Errorf("write to %s failed", cp.filename)
 	}
 
 	return err
 }
 
+// Bytes stores the codeplug's records and returns its .rdt file image,
+// as written by SaveToFile.  The returned slice must not be modified.
+func (cp *Codeplug) Bytes(ignoreWarnings bool) ([]byte, error) {
+	if err := cp.valid(); err != nil {
+		_, warning := err.(Warning)
+		if !warning || !ignoreWarnings {
+			return nil, err
+		}
+	}
+
+	cp.setLastProgrammedTime(time.Now())
+
+	cp.store()
+
+	if err := cp.checkUnknownBytes(); err != nil {
+		return nil, err
+	}
+
+	cpi := cp.codeplugInfo
+	bytes := cp.bytes[0:cpi.RdtSize]
+	fixRdt(bytes, cpi.BinSize)
+
+	return bytes, nil
+}
+
 func (cp *Codeplug) setLastProgrammedTime(t time.Time) {
 	r := cp.rDesc[RtBasicInformation_md380].records[0]
 	f := r.Field(FtBiLastProgrammedTime)
@@ -591,6 +696,18 @@ func (cp *Codeplug) Filename() string {
 	return cp.filename
 }
 
+// SetFileSystem sets the file system to which the codeplug's Save and
+// Export methods, and its radio backups, write.
+func (cp *Codeplug) SetFileSystem(fsys vfs.FS) {
+	cp.fsys = fsys
+}
+
+// FileSystem returns the file system from which the codeplug is read
+// and to which it is written.
+func (cp *Codeplug) FileSystem() vfs.FS {
+	return cp.fsys
+}
+
 // CurrentHash returns a cryptographic hash of the current (modified) codeplug
 func (cp *Codeplug) CurrentHash() [sha256.Size]byte {
 	if !cp.changed {
@@ -631,6 +748,9 @@ func (cp *Codeplug) FileType() FileType {
 
 // Records returns all of a codeplug's records of the given RecordType.
 func (cp *Codeplug) Records(rType RecordType) []*Record {
+	cp.recordsMutex.Lock()
+	defer cp.recordsMutex.Unlock()
+
 	records := cp.rDesc[rType].records
 	if len(records) == 0 {
 		rIndex := 0
@@ -769,7 +889,6 @@ func (cp *Codeplug) ConnectChange(fn func(*Change)) {
 func (cp *Codeplug) loadHeader() {
 	cp.clearCachedListNames()
 	ri := cp.codeplugInfo.RecordInfos[0]
-	ri.max = 1
 
 	rd := &rDesc{recordInfo: ri}
 	cp.rDesc[ri.rType] = rd
@@ -780,12 +899,7 @@ func (cp *Codeplug) loadHeader() {
 // load loads all the records into the codeplug from its file.
 func (cp *Codeplug) load() {
 	cp.clearCachedListNames()
-	for i, ri := range cp.codeplugInfo.RecordInfos {
-		ri.index = i
-		if ri.max == 0 {
-			ri.max = 1
-		}
-
+	for _, ri := range cp.codeplugInfo.RecordInfos {
 		rd := &rDesc{recordInfo: ri}
 		cp.rDesc[ri.rType] = rd
 		rd.codeplug = cp
@@ -793,17 +907,96 @@ func (cp *Codeplug) load() {
 	}
 }
 
+// loadFields parses the fields of every record whose fields have not
+// yet been parsed.
+func (cp *Codeplug) loadFields() {
+	for _, rd := range cp.rDesc {
+		for _, r := range rd.records {
+			r.fDescs()
+		}
+	}
+}
+
 // newRecord creates and returns the address of a new record of the given type.
 func (cp *Codeplug) newRecord(rType RecordType, rIndex int) *Record {
 	r := new(Record)
 	r.rDesc = cp.rDesc[rType]
 	r.rIndex = rIndex
-	m := make(map[FieldType]*fDesc)
+	m := make(map[FieldType]*fDesc, len(r.fieldInfos))
 	r.fDesc = &m
 
 	return r
 }
 
+// newDefaultRecord returns a new record of the given type, not yet in
+// the codeplug, holding the values of the first record of the radio's
+// blank codeplug, with the default values of its definition's fields
+// applied.  It has none of the fields that may occur more than once in
+// a record, such as the members of a list.
+func (cp *Codeplug) newDefaultRecord(rType RecordType) (*Record, error) {
+	if cp.defaultBytes == nil {
+		var filename string
+		for i, v := range cp.frequencyRanges() {
+			if v == cp.FrequencyRange() {
+				filename = cp.newFilenames()[i]
+				break
+			}
+		}
+		if filename == "" {
+			return nil, fmt.Errorf("no blank codeplug for %s %s", cp.Model(), cp.FrequencyRange())
+		}
+
+		bytes, err := newFileBytes(filename)
+		if err != nil {
+			return nil, err
+		}
+		cpi := cp.codeplugInfo
+		if len(bytes) < cpi.BinOffset+cpi.BinSize {
+			return nil, fmt.Errorf("blank codeplug %s is too small", filename)
+		}
+		cp.defaultBytes = bytes
+	}
+
+	r := cp.newRecord(rType, 0)
+
+	savedBytes := cp.bytes
+	cp.bytes = cp.defaultBytes
+	r.load()
+	cp.bytes = savedBytes
+
+	for _, fi := range r.rDesc.fieldInfos {
+		if fi.max > 1 {
+			for _, f := range append([]*Field{}, r.Fields(fi.fType)...) {
+				r.RemoveField(f)
+			}
+			continue
+		}
+		f := r.Field(fi.fType)
+		if f == nil || fi.defaultValue == "" {
+			continue
+		}
+		err := f.setString(fi.defaultValue)
+		if err != nil {
+			return nil, fmt.Errorf("%s: default value: %s", f.FullTypeName(), err.Error())
+		}
+	}
+	r.SetIndex(len(cp.records(rType)))
+
+	return r, nil
+}
+
+// maxFields returns the maximum number of fields of the given type in
+// a record of the given type.
+func (cp *Codeplug) maxFields(rType RecordType, fType FieldType) int {
+	for _, fi := range cp.rDesc[rType].fieldInfos {
+		if fi.fType == fType {
+			return fi.max
+		}
+	}
+
+	return 0
+}
+
 // valid returns nil if all fields in the codeplug are valid.
 func (cp *Codeplug) valid() error {
 	errStr := ""
@@ -822,6 +1015,9 @@ func (cp *Codeplug) valid() error {
 	}
 	cp.deferredValueFields = nil
 
+	errStr += cp.policiesValid()
+	errStr += cp.rulesValid()
+
 	if errStr != "" {
 		return Warning{fmt.Errorf("%s", errStr)}
 	}
@@ -831,7 +1027,7 @@ func (cp *Codeplug) valid() error {
 
 // findFileType sets the codeplug type based on file size
 func (cp *Codeplug) findFileType(filename string) error {
-	fileInfo, err := os.Stat(filename)
+	fileInfo, err := fs.Stat(cp.fsys, filename)
 	if err != nil {
 		if os.IsNotExist(err) {
 			err = fmt.Errorf("%s: does not exist", filename)
@@ -840,9 +1036,20 @@ func (cp *Codeplug) findFileType(filename string) error {
 		return err
 	}
 
+	err = cp.findFileTypeBySize(fileInfo.Size())
+	if err != nil {
+		return fmt.Errorf("%s %s", filename, err.Error())
+	}
+
+	return nil
+}
+
+// findFileTypeBySize sets the codeplug's file type to rdt or bin, as
+// given by the size of its image.
+func (cp *Codeplug) findFileTypeBySize(size int64) error {
 	for _, cpi := range codeplugInfos {
 		cp.rdtSize = cpi.RdtSize
-		switch fileInfo.Size() {
+		switch size {
 		case int64(cpi.RdtSize):
 			cp.fileType = FileTypeRdt
 			cp.fileSize = cpi.RdtSize
@@ -857,13 +1064,29 @@ func (cp *Codeplug) findFileType(filename string) error {
 		}
 	}
 
+	if isFlashDumpSize(size) {
+		cp.fileType = FileTypeBin
+		cp.flashDump = true
+		return nil
+	}
+
 	cp.fileType = FileTypeNone
-	err = fmt.Errorf("%s is not a known codeplug file type", filename)
-	return err
+	return fmt.Errorf("is not a known codeplug file type")
 }
 
 // store stores all all fields of the codeplug into its byte slice.
 func (cp *Codeplug) store() {
+	// Records whose fields have not been parsed are left in place,
+	// unless they have moved, in which case they are parsed before
+	// anything overwrites them.
+	for _, rd := range cp.rDesc {
+		for i, r := range rd.records {
+			if r.pending() && (r.rIndex != i || r.rIndex != r.lazy.rIndex) {
+				r.fDescs()
+			}
+		}
+	}
+
 	for _, rd := range cp.rDesc {
 		for rIndex := 0; rIndex < rd.max; rIndex++ {
 			if rIndex < len(rd.records) {
@@ -879,10 +1102,10 @@ func (cp *Codeplug) store() {
 // codeplug.
 func (cp *Codeplug) frequencyValid(freq float64) error {
 	if cp.lowFrequency == 0 {
-		fDescs := cp.record(RtBasicInformation_md380).fDesc
-		s := (*fDescs)[FtBiLowFrequency].fields[0].String()
+		fDescs := cp.record(RtBasicInformation_md380).fDescs()
+		s := fDescs[FtBiLowFrequency].fields[0].String()
 		cp.lowFrequency, _ = strconv.ParseFloat(s, 64)
-		s = (*fDescs)[FtBiHighFrequency].fields[0].String()
+		s = fDescs[FtBiHighFrequency].fields[0].String()
 		cp.highFrequency, _ = strconv.ParseFloat(s, 64)
 	}
 
@@ -901,8 +1124,10 @@ func (cp *Codeplug) publishChange(change *Change) {
 	}
 }
 
-// codeplugs contains the list of open codeplugs.
+// codeplugs contains the list of open codeplugs, guarded by
+// codeplugsMutex.
 var codeplugs []*Codeplug
+var codeplugsMutex sync.Mutex
 
 func filterField(rType RecordType, fType FieldType) bool {
 	switch rType {
@@ -945,6 +1170,11 @@ func PrintRecord(w io.Writer, r *Record) {
 			fmt.Fprintf(w, "\t%s%s: %s\n", name, ind, value)
 		}
 	}
+
+	names, values := r.pseudoFields()
+	for i, name := range names {
+		fmt.Fprintf(w, "\t%s: %s\n", name, quoteString(values[i]))
+	}
 }
 
 func PrintRecordWithIndex(w io.Writer, r *Record) {
@@ -1024,6 +1254,9 @@ func printRecord(w io.Writer, r *Record, rFmt string, fFmt string) {
 	}
 }
 func (cp *Codeplug) nameToRt(rTypeName string) (RecordType, error) {
+	cp.cacheMutex.Lock()
+	defer cp.cacheMutex.Unlock()
+
 	if len(cp.cachedNameToRt) == 0 {
 		cp.cachedNameToRt = make(map[string]RecordType)
 		for _, rType := range cp.RecordTypes() {
@@ -1043,6 +1276,9 @@ func (cp *Codeplug) nameToRt(rTypeName string) (RecordType, error) {
 }
 
 func (cp *Codeplug) nameToFt(rType RecordType, fTypeName string) (FieldType, error) {
+	cp.cacheMutex.Lock()
+	defer cp.cacheMutex.Unlock()
+
 	if len(cp.cachedNameToFt) == 0 {
 		cp.cachedNameToFt = make(map[RecordType]map[string]FieldType)
 		for _, rType := range cp.RecordTypes() {
@@ -1179,9 +1415,12 @@ func (rdr *reader) ReadInt() (int, error) {
 	return int(n), nil
 }
 
+// A position is a location in a text file.  Its filename is set only
+// for files included by another.
 type position struct {
-	line   int
-	column int
+	filename string
+	line     int
+	column   int
 }
 
 type PositionError struct {
@@ -1189,6 +1428,13 @@ type PositionError struct {
 	position *position
 }
 
+func (e PositionError) Error() string {
+	if e.position != nil && e.position.filename != "" {
+		return e.position.filename + ": " + e.error.Error()
+	}
+	return e.error.Error()
+}
+
 func (e *PositionError) Line() int {
 	return e.position.line + 1
 }
@@ -1198,20 +1444,34 @@ func (e *PositionError) Column() int {
 }
 
 func (cp *Codeplug) parseModelFrequencyRange() (model string, frequencyRange string) {
-	file, err := os.Open(cp.importFilename)
-	if err != nil {
-		return model, frequencyRange
+	var file io.Reader
+	if cp.importData != nil {
+		file = bytes.NewReader(cp.importData)
+	} else {
+		f, err := cp.fsys.Open(cp.importFilename)
+		if err != nil {
+			return model, frequencyRange
+		}
+		defer f.Close()
+		file = f
 	}
-	defer file.Close()
 
 	var pRecs []*parsedRecord
 
 	switch cp.fileType {
 	case FileTypeText:
-		pRecs = cp.parseTextFile(file)
+		content, err := withoutProvenance(file)
+		if err != nil {
+			return model, frequencyRange
+		}
+		pRecs = cp.parseTextFile(content, cp.importFilename)
 
 	case FileTypeJSON:
-		pRecs = cp.parseJSONFile(file)
+		content, err := withoutProvenance(file)
+		if err != nil {
+			return model, frequencyRange
+		}
+		pRecs = cp.parseJSONFile(content)
 
 	case FileTypeXLSX:
 		pRecs = cp.parseXLSXFile(file)
@@ -1255,14 +1515,35 @@ type parsedRecord struct {
 	pFields []*parsedField
 }
 
-func (cp *Codeplug) parseTextFile(iRdr io.Reader) []*parsedRecord {
+// includeDirective names the text format's pseudo record that is
+// replaced by the records of another text file, as in
+//
+//	Include: "zones/europe.txt"
+//
+// A relative name is relative to the directory of the including file.
+const includeDirective = "Include"
+
+// parseTextFile parses the text format file read from iRdr.  filename
+// is used to find the files it includes, and may be empty if it
+// includes none.
+func (cp *Codeplug) parseTextFile(iRdr io.Reader, filename string) []*parsedRecord {
+	return cp.parseText(iRdr, filename, nil)
+}
+
+// parseText parses a text format file, included by the files named in
+// includers if any.  Lines beginning with '#', and the rest of a line
+// following a '#' after a value, are comments.
+func (cp *Codeplug) parseText(iRdr io.Reader, filename string, includers []string) []*parsedRecord {
 	var index int
 	var err error
 	var pRecords []*parsedRecord
 
 	rdr := NewReader(iRdr)
+	if len(includers) != 0 {
+		rdr.pos.filename = filename
+	}
 
-	rdr.ReadWhile(unicode.IsSpace)
+	skipSpace(rdr)
 
 	for {
 		var pRecord parsedRecord
@@ -1284,6 +1565,20 @@ func (cp *Codeplug) parseTextFile(iRdr io.Reader) []*parsedRecord {
 			pRecord.err = fmt.Errorf("syntax: no record name")
 			break
 		}
+		if pRecord.name == includeDirective {
+			included, err := cp.includeText(rdr, filename, includers)
+			if err != nil {
+				pRecord.err = PositionError{position: pRecord.pos, error: err}
+				break
+			}
+			pRecords = append(pRecords[:len(pRecords)-1], included...)
+			if n := len(included); n != 0 && included[n-1].err != nil {
+				break
+			}
+			continue
+		}
+		skipSpace(rdr)
+
 		var pFields []*parsedField
 		for {
 			if rdr.pos.column == 0 {
@@ -1322,6 +1617,67 @@ func (cp *Codeplug) parseTextFile(iRdr io.Reader) []*parsedRecord {
 	return pRecords
 }
 
+// includeText parses the value of an include directive read from rdr,
+// in the file filename, and returns the records of the file it names.
+func (cp *Codeplug) includeText(rdr *reader, filename string, includers []string) ([]*parsedRecord, error) {
+	name, err := parseValue(rdr)
+	atEOF := false
+	if pErr, ok := err.(PositionError); ok && pErr.error == io.EOF {
+		atEOF = true
+		err = nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("syntax: %s: %s", includeDirective, err.Error())
+	}
+	if !atEOF && rdr.pos.column != 0 {
+		return nil, fmt.Errorf("syntax: %s: expected one file name", includeDirective)
+	}
+
+	path := name
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(filename), path)
+	}
+
+	includers = append(includers, filename)
+	for _, includer := range includers {
+		if includer == path {
+			return nil, fmt.Errorf("%s: includes itself", name)
+		}
+	}
+
+	data, err := fs.ReadFile(cp.fsys, path)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := withoutProvenance(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.parseText(content, path, includers), nil
+}
+
+// skipSpace skips white space and comments, which run from a '#' to
+// the end of the line.
+func skipSpace(rdr *reader) {
+	for {
+		rdr.ReadWhile(unicode.IsSpace)
+
+		r, _, err := rdr.ReadRune()
+		if err != nil {
+			return
+		}
+		if r != '#' {
+			rdr.UnreadRune()
+			return
+		}
+		rdr.ReadUntil(func(r rune) bool {
+			return r == '\n'
+		})
+	}
+}
+
 func parseName(rdr *reader) (string, int, error) {
 	pos := rdr.pos
 	nType := "record"
@@ -1394,8 +1750,10 @@ func parseName(rdr *reader) (string, int, error) {
 	return name, index, nil
 }
 
+// ParseRecords parses records in the text format from rdr.  Files
+// they include are found relative to the current directory.
 func (cp *Codeplug) ParseRecords(rdr io.Reader) ([]*Record, error) {
-	pRecs := cp.parseTextFile(rdr)
+	pRecs := cp.parseTextFile(rdr, "")
 	records, err := cp.parsedFileToRecs(pRecs)
 
 	return records, err
@@ -1407,7 +1765,9 @@ func (cp *Codeplug) parsedFileToRecs(pRecs []*parsedRecord) ([]*Record, error) {
 	var pos *position
 
 	var records []*Record
+	cp.parseWarnings = nil
 	appendWarning := func(pr *parsedRecord, pf *parsedField, err error) {
+		cp.addParseWarning(pf.pos, pr.name, pf.name, err)
 		err = fmt.Errorf("%s.%s: %s", pr.name, pf.name, err.Error())
 		appendWarningMsgs(&warning, pf.pos, err)
 	}
@@ -1436,6 +1796,11 @@ func (cp *Codeplug) parsedFileToRecs(pRecs []*parsedRecord) ([]*Record, error) {
 
 		var r *Record
 		r, err = cp.rNameToRecord(pr.name, pr.index)
+		if err != nil && cp.parseMode == ParseLenient {
+			cp.addParseWarning(pr.pos, pr.name, "", err)
+			appendWarningMsgs(&warning, pr.pos, err)
+			continue
+		}
 		if err != nil {
 			pos = pr.pos
 			return wrapError(err)
@@ -1448,7 +1813,20 @@ func (cp *Codeplug) parsedFileToRecs(pRecs []*parsedRecord) ([]*Record, error) {
 				return wrapError(err)
 			}
 
+			if isPseudoFieldName(pf.name) {
+				err = r.setPseudoField(pf.name, pf.value)
+				if err != nil {
+					appendWarning(pr, pf, err)
+				}
+				continue
+			}
+
 			fType, err := cp.nameToFt(r.rType, pf.name)
+			if err != nil && cp.parseMode == ParseStrict {
+				pos = pf.pos
+				w := newParseWarning(pf.pos, pr.name, pf.name, err)
+				return wrapError(fmt.Errorf("%s", w))
+			}
 			if err != nil {
 				appendWarning(pr, pf, err)
 				continue
@@ -1486,7 +1864,11 @@ func appendWarningMsgs(pWarning *error, ppos *position, warning error) {
 	err := fmt.Errorf("%s%s\n", oldMsg, warning.Error())
 	if ppos != nil {
 		pos := *ppos
-		err = fmt.Errorf("%sline %d:%d: %s\n", oldMsg,
+		var filename string
+		if pos.filename != "" {
+			filename = pos.filename + ": "
+		}
+		err = fmt.Errorf("%s%sline %d:%d: %s\n", oldMsg, filename,
 			pos.line+1, pos.column+1, warning.Error())
 	}
 	*pWarning = Warning{err}
@@ -1522,7 +1904,7 @@ func parseValue(rdr *reader) (string, error) {
 	}
 	rdr.ReadRune()
 
-	rdr.ReadWhile(unicode.IsSpace)
+	skipSpace(rdr)
 
 	return value, nil
 }
@@ -1548,7 +1930,7 @@ func (cp *Codeplug) rNameToRecord(name string, index int) (*Record, error) {
 }
 
 func (cp *Codeplug) ExportText(filename string) (err error) {
-	file, err := os.Create(filename)
+	file, err := cp.fsys.Create(filename)
 	if err != nil {
 		return err
 	}
@@ -1560,7 +1942,13 @@ func (cp *Codeplug) ExportText(filename string) (err error) {
 		return
 	}()
 
-	w := bufio.NewWriter(file)
+	return cp.WriteText(file)
+}
+
+// WriteText writes the codeplug to w in the text format written by
+// ExportText.
+func (cp *Codeplug) WriteText(writer io.Writer) error {
+	w := bufio.NewWriter(writer)
 	for i, rType := range cp.RecordTypes() {
 		for j, r := range cp.records(rType) {
 			if i != 0 || j != 0 {
@@ -1569,21 +1957,27 @@ func (cp *Codeplug) ExportText(filename string) (err error) {
 			PrintRecord(w, r)
 		}
 	}
-	w.Flush()
 
-	return nil
+	return w.Flush()
 }
 
 func (cp *Codeplug) importText(filename string, ignoreWarnings bool) error {
-	file, err := os.Open(filename)
+	file, err := cp.openImportFile(filename)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
-	records, err := cp.ParseRecords(file)
-	if err != nil && !ignoreWarnings {
-		return err
+	pRecs := cp.parseTextFile(file, filename)
+	records, err := cp.parsedFileToRecs(pRecs)
+	if err != nil {
+		_, warning := err.(Warning)
+		switch {
+		case warning && cp.parseMode == ParseLenient:
+		case !warning && cp.parseMode == ParseStrict:
+			return err
+		case !ignoreWarnings:
+			return err
+		}
 	}
 
 	err = cp.storeParsedRecords(records)
@@ -1594,8 +1988,8 @@ func (cp *Codeplug) importText(filename string, ignoreWarnings bool) error {
 	return nil
 }
 
-func (cp *Codeplug) ExportJSON(filename string) error {
-	file, err := os.Create(filename)
+func (cp *Codeplug) ExportJSON(filename string) (err error) {
+	file, err := cp.fsys.Create(filename)
 	if err != nil {
 		return err
 	}
@@ -1607,6 +2001,12 @@ func (cp *Codeplug) ExportJSON(filename string) error {
 		return
 	}()
 
+	return cp.WriteJSON(file)
+}
+
+// WriteJSON writes the codeplug to w in the JSON format written by
+// ExportJSON.
+func (cp *Codeplug) WriteJSON(w io.Writer) error {
 	recordTypes := cp.RecordTypes()
 	recordMap := make(map[string]interface{})
 	for _, rType := range recordTypes {
@@ -1632,6 +2032,10 @@ func (cp *Codeplug) ExportJSON(filename string) error {
 					fieldMap[fTypeString] = fieldSlice[0]
 				}
 			}
+			names, values := r.pseudoFields()
+			for i, name := range names {
+				fieldMap[name] = values[i]
+			}
 			recordSlice[i] = fieldMap
 		}
 		rTypeString := string(rType)
@@ -1641,37 +2045,35 @@ func (cp *Codeplug) ExportJSON(filename string) error {
 		}
 	}
 
-	writer := bufio.NewWriter(file)
+	writer := bufio.NewWriter(w)
 	encoder := json.NewEncoder(writer)
 	encoder.SetIndent("", "\t")
-	err = encoder.Encode(recordMap)
+	err := encoder.Encode(recordMap)
 	if err != nil {
 		return err
 	}
-	writer.Flush()
 
-	return nil
+	return writer.Flush()
 }
 
 func (cp *Codeplug) importJSON(filename string) error {
-	file, err := os.Open(filename)
+	file, err := cp.openImportFile(filename)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
 	pRecs := cp.parseJSONFile(file)
 	records, err := cp.parsedFileToRecs(pRecs)
-	if err != nil {
+	if _, warning := err.(Warning); err != nil && !warning {
 		return err
 	}
 
-	err = cp.storeParsedRecords(records)
-	if err != nil {
-		return err
+	sErr := cp.storeParsedRecords(records)
+	if sErr != nil {
+		return sErr
 	}
 
-	return nil
+	return err
 }
 
 func (cp *Codeplug) parseJSONFile(iRdr io.Reader) []*parsedRecord {
@@ -1799,7 +2201,7 @@ func (cp *Codeplug) ExportXLSX(filename string) error {
 				continue
 			}
 
-			for i := 0; i < (*r.fDesc)[fType].max; i++ {
+			for i := 0; i < r.fDescs()[fType].max; i++ {
 				cell = headerRow.AddCell()
 				cell.Value = string(fType)
 			}
@@ -1820,33 +2222,45 @@ func (cp *Codeplug) ExportXLSX(filename string) error {
 			}
 		}
 	}
-	err = file.Save(filename)
+
+	w, err := cp.fsys.Create(filename)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	err = file.Write(w)
+	if cErr := w.Close(); err == nil {
+		err = cErr
+	}
+
+	return err
 }
 
 func (cp *Codeplug) importXLSX(filename string) error {
-	file, err := os.Open(filename)
-	if err != nil {
-		return err
+	var file io.Reader
+	if cp.importData != nil {
+		file = bytes.NewReader(cp.importData)
+	} else {
+		f, err := cp.fsys.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		file = f
 	}
-	defer file.Close()
 
 	pRecs := cp.parseXLSXFile(file)
 	records, err := cp.parsedFileToRecs(pRecs)
-	if err != nil {
+	if _, warning := err.(Warning); err != nil && !warning {
 		return err
 	}
 
-	err = cp.storeParsedRecords(records)
-	if err != nil {
-		return err
+	sErr := cp.storeParsedRecords(records)
+	if sErr != nil {
+		return sErr
 	}
 
-	return nil
+	return err
 }
 
 func (cp *Codeplug) parseXLSXFile(iRdr io.Reader) []*parsedRecord {
@@ -1980,11 +2394,11 @@ func (cp *Codeplug) resolveDeferredValueFields() error {
 }
 
 func RadioExists() error {
-	dfu, err := dfu.New(nil)
+	t, err := openTransport(nil)
 	if err != nil {
 		return err
 	}
-	dfu.Close()
+	t.Close()
 
 	return nil
 }
@@ -1993,16 +2407,14 @@ func (cp *Codeplug) ReadRadio(progress func(cur int) bool) error {
 	cpi := cp.codeplugInfo
 	binBytes := cp.bytes[cpi.BinOffset : cpi.BinOffset+cpi.BinSize]
 
-	dfu, err := dfu.New(func(cur int) bool {
-		return progress(cur)
-	})
+	t, err := openTransport(progress)
 	if err != nil {
 		return err
 	}
-	defer dfu.Close()
+	defer t.Close()
 
 	bytes := make([]byte, len(binBytes))
-	err = dfu.ReadCodeplug(bytes)
+	err = t.ReadCodeplugRegion(bytes, 0, len(bytes))
 	if err != nil {
 		return err
 	}
@@ -2017,10 +2429,12 @@ func (cp *Codeplug) ReadRadio(progress func(cur int) bool) error {
 	return nil
 }
 
-func (cp *Codeplug) WriteRadio(progress func(cur int) bool) error {
+// radioBinBytes returns the bin image to be written to a radio,
+// stamped with the current time as its last programmed time.
+func (cp *Codeplug) radioBinBytes() ([]byte, error) {
 	savedTime, err := cp.getLastProgrammedTime()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	cp.setLastProgrammedTime(time.Now())
 
@@ -2035,18 +2449,251 @@ func (cp *Codeplug) WriteRadio(progress func(cur int) bool) error {
 	cp.bytes = savedBytes
 	cp.setLastProgrammedTime(savedTime)
 
-	dfu, err := dfu.New(func(cur int) bool {
-		return progress(cur)
-	})
+	return binBytes, nil
+}
+
+func (cp *Codeplug) WriteRadio(progress func(cur int) bool) error {
+	binBytes, err := cp.radioBinBytes()
+	if err != nil {
+		return err
+	}
+
+	t, err := openTransport(progress)
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+
+	err = cp.checkRadioModel(t)
+	if err != nil {
+		return err
+	}
+
+	backup, err := cp.backupRadio(t, "")
+	if err != nil {
+		return err
+	}
+	err = cp.pruneRadioBackups(backup)
+	if err != nil {
+		return err
+	}
+
+	err = t.WriteCodeplugRegion(binBytes, 0, len(binBytes))
+	if err != nil {
+		return err
+	}
+
+	return cp.rebootRadio(t)
+}
+
+// RadioDevices returns the USB port paths of the connected radios, for
+// use with WriteRadios.
+func RadioDevices() ([]string, error) {
+	return dfu.Devices()
+}
+
+// WriteRadios writes the codeplug to each of the radios at the given
+// USB port paths, as returned by RadioDevices, in parallel.  The
+// progress function, if not nil, is called with the index of the
+// device whose progress it reports, and may be called from several
+// goroutines at once.  The returned errors correspond to the devices;
+// the error of each radio written successfully is nil.  If they are
+// returned along with an error, the radios were written but old
+// backups could not be pruned.  Only the default transport can write
+// to several radios.
+func (cp *Codeplug) WriteRadios(devices []string, progress func(index int, cur int) bool) ([]error, error) {
+	if transportName != DefaultTransport {
+		return nil, fmt.Errorf("transport %s cannot write to several radios", transportName)
+	}
+
+	binBytes, err := cp.radioBinBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]error, len(devices))
+	backups := make([]string, len(devices))
+	var wg sync.WaitGroup
+	for i := range devices {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			var devProgress func(cur int) bool
+			if progress != nil {
+				devProgress = func(cur int) bool {
+					return progress(i, cur)
+				}
+			}
+
+			device, err := dfu.NewDevice(devices[i], devProgress)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			df := dfuTransport{device}
+			defer df.Close()
+
+			err = cp.checkRadioModel(df)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			backups[i], err = cp.backupRadio(df, fmt.Sprintf("-%d", i+1))
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			err = df.WriteCodeplugRegion(binBytes, 0, len(binBytes))
+			if err == nil {
+				err = cp.rebootRadio(df)
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	// The backups are pruned once all are made, so that none of
+	// this batch's backups are removed.
+	err = cp.pruneRadioBackups(backups...)
+	if err != nil {
+		return errs, fmt.Errorf("pruning radio backups: %s", err.Error())
+	}
+
+	return errs, nil
+}
+
+// recordTypesRegion returns the offset and size, within the radio's
+// codeplug, of the smallest region containing all records of the given
+// record types.
+func (cp *Codeplug) recordTypesRegion(rTypes []RecordType) (offset, size int, err error) {
+	names := make([]string, len(rTypes))
+	for i, rType := range rTypes {
+		names[i] = string(rType)
+	}
+
+	mi, err := cp.MemoryImage()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	span, err := mi.Span(names)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return span.Offset, span.Size, nil
+}
+
+// ReadRadioRecords reads only the records of the given record types from
+// the radio, leaving the codeplug's other records unchanged.
+func (cp *Codeplug) ReadRadioRecords(rTypes []RecordType, progress func(cur int) bool) error {
+	offset, size, err := cp.recordTypesRegion(rTypes)
+	if err != nil {
+		return err
+	}
+
+	cp.store()
+
+	cpi := cp.codeplugInfo
+	binBytes := cp.bytes[cpi.BinOffset : cpi.BinOffset+cpi.BinSize]
+
+	t, err := openTransport(progress)
 	if err != nil {
 		return err
 	}
-	defer dfu.Close()
+	defer t.Close()
 
-	err = dfu.WriteCodeplug(binBytes)
+	bytes := make([]byte, len(binBytes))
+	copy(bytes, binBytes)
+	err = t.ReadCodeplugRegion(bytes, offset, size)
 	if err != nil {
 		return err
 	}
 
+	copy(binBytes[offset:offset+size], bytes[offset:offset+size])
+
+	ignoreWarnings := true
+	cp.Revert(ignoreWarnings)
+
+	cp.SetChanged()
+
 	return nil
 }
+
+// WriteRadioRecords writes only the records of the given record types
+// to the radio.  Because the radio's flash is erased in 64KB blocks,
+// the blocks containing the records are first read from the radio, so
+// that the other records sharing those blocks are written back
+// unchanged.
+func (cp *Codeplug) WriteRadioRecords(rTypes []RecordType, progress func(cur int) bool) error {
+	offset, size, err := cp.recordTypesRegion(rTypes)
+	if err != nil {
+		return err
+	}
+
+	savedBytes := make([]byte, len(cp.bytes))
+	copy(savedBytes, cp.bytes)
+
+	cp.store()
+
+	cpi := cp.codeplugInfo
+	binBytes := make([]byte, cpi.BinSize)
+	copy(binBytes, cp.bytes[cpi.BinOffset:cpi.BinOffset+cpi.BinSize])
+
+	cp.bytes = savedBytes
+
+	mi, err := NewMemoryImage(binBytes, 0xff, cp.memoryRegions())
+	if err != nil {
+		return err
+	}
+
+	start := offset / dfu.EraseBlockSize * dfu.EraseBlockSize
+	end := (offset + size + dfu.EraseBlockSize - 1) / dfu.EraseBlockSize * dfu.EraseBlockSize
+	if end > len(binBytes) {
+		end = len(binBytes)
+	}
+
+	t, err := openTransport(progress)
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+
+	err = cp.checkRadioModel(t)
+	if err != nil {
+		return err
+	}
+
+	backup, err := cp.backupRadio(t, "")
+	if err != nil {
+		return err
+	}
+	err = cp.pruneRadioBackups(backup)
+	if err != nil {
+		return err
+	}
+
+	radioBytes := make([]byte, len(binBytes))
+	err = t.ReadCodeplugRegion(radioBytes, start, end-start)
+	if err != nil {
+		return err
+	}
+
+	for _, rType := range rTypes {
+		r, err := mi.Region(string(rType))
+		if err != nil {
+			return err
+		}
+		copy(radioBytes[r.Offset:r.End()], binBytes[r.Offset:r.End()])
+	}
+
+	err = t.WriteCodeplugRegion(radioBytes, start, end-start)
+	if err != nil {
+		return err
+	}
+
+	return cp.rebootRadio(t)
+}
@@ -0,0 +1,362 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// dmrIDLabel matches the labels and names of the custom contact
+// fields that hold a DMR ID, such as "DMR ID", "X-DMR-ID" or "dmrid".
+var dmrIDLabel = regexp.MustCompile(`(?i)^(x-)?dmr[ _-]?id$`)
+
+// dmrIDNote matches a DMR ID written in free text, such as a note.
+var dmrIDNote = regexp.MustCompile(`(?i)\bdmr[ _-]?id\b\s*[:=]?\s*(\d{1,8})`)
+
+// ReadVCards returns a private-call Talkgroup for each vCard read from
+// r that has a DMR ID.  The ID is taken from an X-DMR-ID property, from
+// a property labeled "DMR ID" (as phone applications label custom
+// fields), or from a note containing "DMR ID: <id>".
+func ReadVCards(r io.Reader) ([]Talkgroup, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var contacts []Talkgroup
+	var name, id string
+	var labels, values map[string]string
+	for i, line := range lines {
+		colon := strings.Index(line, ":")
+		if colon < 0 {
+			continue
+		}
+		property := line[:colon]
+		value := strings.TrimSpace(line[colon+1:])
+		if semicolon := strings.Index(property, ";"); semicolon >= 0 {
+			property = property[:semicolon]
+		}
+		group := ""
+		if dot := strings.Index(property, "."); dot >= 0 {
+			group = property[:dot]
+			property = property[dot+1:]
+		}
+		property = strings.ToUpper(property)
+
+		switch {
+		case property == "BEGIN" && strings.EqualFold(value, "VCARD"):
+			name, id = "", ""
+			labels = make(map[string]string)
+			values = make(map[string]string)
+
+		case labels == nil:
+
+		case property == "FN":
+			name = unescapeVCard(value)
+
+		case property == "N" && name == "":
+			parts := strings.Split(value, ";")
+			if len(parts) > 1 {
+				name = strings.TrimSpace(parts[1] + " " + parts[0])
+			} else {
+				name = parts[0]
+			}
+			name = unescapeVCard(name)
+
+		case dmrIDLabel.MatchString(property):
+			id = value
+
+		case property == "X-ABLABEL":
+			labels[group] = value
+
+		case property == "NOTE" && id == "":
+			if m := dmrIDNote.FindStringSubmatch(unescapeVCard(value)); m != nil {
+				id = m[1]
+			}
+
+		case property == "END" && strings.EqualFold(value, "VCARD"):
+			for group, label := range labels {
+				label = strings.Trim(label, "_$!<>")
+				if dmrIDLabel.MatchString(label) && values[group] != "" {
+					id = values[group]
+				}
+			}
+			if id != "" {
+				tg, err := privateContact(name, id)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %s", i+1, err.Error())
+				}
+				contacts = append(contacts, tg)
+			}
+			labels = nil
+
+		default:
+			if group != "" {
+				values[group] = value
+			}
+		}
+	}
+
+	return contacts, nil
+}
+
+// unescapeVCard removes the backslash escapes from a vCard value.
+func unescapeVCard(s string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",",
+		`\;`, ";", `\\`, `\`).Replace(s)
+}
+
+// ReadContactsCSV returns a private-call Talkgroup for each row of a
+// contacts CSV file, as exported by phone and mail applications, that
+// has a DMR ID.  The first row names the columns.  The name is taken
+// from a "Name" or "Display Name" column, or from first and last name
+// columns.  The DMR ID is taken from a column named like "DMR ID", or
+// from a custom field whose type or label column says "DMR ID".
+func ReadContactsCSV(r io.Reader) ([]Talkgroup, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	nameColumn, firstColumn, lastColumn, idColumn := -1, -1, -1, -1
+	var labelColumns []int
+	for i, title := range header {
+		title = strings.TrimSpace(strings.TrimPrefix(title, "\ufeff"))
+		lower := strings.ToLower(title)
+		switch {
+		case lower == "name" || lower == "display name" || lower == "full name":
+			nameColumn = i
+		case lower == "first name" || lower == "given name":
+			firstColumn = i
+		case lower == "last name" || lower == "family name":
+			lastColumn = i
+		case dmrIDLabel.MatchString(title):
+			idColumn = i
+		case strings.HasSuffix(lower, " - type") || strings.HasSuffix(lower, " - label"):
+			labelColumns = append(labelColumns, i)
+		}
+	}
+	if nameColumn < 0 && firstColumn < 0 && lastColumn < 0 {
+		return nil, fmt.Errorf("no name column")
+	}
+
+	column := func(row []string, i int) string {
+		if i < 0 || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	var contacts []Talkgroup
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		id := column(row, idColumn)
+		for _, i := range labelColumns {
+			// The value column follows its label column.
+			if id == "" && dmrIDLabel.MatchString(column(row, i)) {
+				id = column(row, i+1)
+			}
+		}
+		if id == "" {
+			continue
+		}
+
+		name := column(row, nameColumn)
+		if name == "" {
+			name = strings.TrimSpace(column(row, firstColumn) + " " + column(row, lastColumn))
+		}
+
+		tg, err := privateContact(name, id)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err.Error())
+		}
+		contacts = append(contacts, tg)
+	}
+
+	return contacts, nil
+}
+
+// ReadContactsFile reads private-call contacts from a vCard file,
+// named with a .vcf or .vcard extension, or otherwise a CSV file.
+func ReadContactsFile(filename string) ([]Talkgroup, error) {
+	file, err := vfs.OS.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".vcf", ".vcard":
+		return ReadVCards(file)
+	}
+
+	return ReadContactsCSV(file)
+}
+
+// ReadTalkgroupsCSV reads talkgroups from CSV rows of the form
+// id,name[,call type], the fields accepted by ParseTalkgroup.  A first
+// row whose ID is not a number is taken as a header and skipped.
+func ReadTalkgroupsCSV(r io.Reader) ([]Talkgroup, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var tgs []Talkgroup
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) == 0 || len(row) == 1 && strings.TrimSpace(row[0]) == "" {
+			continue
+		}
+
+		tg, err := ParseTalkgroup(strings.Join(row, ":"))
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %s", line, err.Error())
+		}
+		tgs = append(tgs, tg)
+	}
+
+	return tgs, nil
+}
+
+// privateContact returns a private-call Talkgroup with the given name
+// and DMR ID.
+func privateContact(name string, id string) (Talkgroup, error) {
+	dmrID, err := ParseDmrID(strings.TrimSpace(id))
+	if err != nil {
+		return Talkgroup{}, fmt.Errorf("bad DMR ID '%s': %s", id, err.Error())
+	}
+	if name == "" {
+		name = dmrID.String()
+	}
+
+	return Talkgroup{ID: dmrID, Name: name, Private: true}, nil
+}
+
+// Contacts returns a Talkgroup for each of the codeplug's digital
+// contacts, in order.  All Call contacts are omitted.
+func (cp *Codeplug) Contacts() []Talkgroup {
+	var tgs []Talkgroup
+	for _, r := range cp.records(RtContacts) {
+		callType := r.Field(FtDcCallType).String()
+		if callType == "All" {
+			continue
+		}
+		id, err := ParseDmrID(r.Field(FtDcCallID).String())
+		if err != nil {
+			continue
+		}
+		tgs = append(tgs, Talkgroup{
+			ID:      id,
+			Name:    r.Field(FtDcName).String(),
+			Private: callType == "Private",
+		})
+	}
+
+	return tgs
+}
+
+// AddContacts adds a contact for each of the talkgroups that the
+// codeplug has no contact for.  Names are shortened to fit and made
+// unique.  It returns the names of the contacts added.
+func (cp *Codeplug) AddContacts(tgs []Talkgroup) ([]string, error) {
+	policy, err := cp.NewNamingPolicy(RtContacts, "{name}")
+	if err != nil {
+		return nil, err
+	}
+
+	var newTgs []Talkgroup
+	seen := make(map[Talkgroup]bool)
+	for _, tg := range tgs {
+		tg = cp.localTalkgroup(tg)
+		key := Talkgroup{ID: tg.ID, Private: tg.Private}
+		if seen[key] || cp.findContact(tg.ID, tg.Private) != nil {
+			continue
+		}
+		seen[key] = true
+		newTgs = append(newTgs, tg)
+	}
+
+	if len(cp.records(RtContacts))+len(newTgs) > cp.MaxRecords(RtContacts) {
+		return nil, fmt.Errorf("too many %s: %d more would exceed %d",
+			RtContacts, len(newTgs), cp.MaxRecords(RtContacts))
+	}
+
+	var names []string
+	for _, tg := range newTgs {
+		name, err := policy.Name(map[string]string{"name": tg.Name})
+		if err != nil {
+			return nil, err
+		}
+		_, err = cp.addContact(name, tg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err.Error())
+		}
+		names = append(names, name)
+	}
+
+	if len(names) != 0 {
+		cp.changed = true
+	}
+
+	return names, nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Codeplug text and JSON files are often sent by email, so they may be
+// gzip-compressed, and a codeplug may be sent in a zip bundle together
+// with the files used with it, such as a users database and a report.
+
+// Names of the files accompanying the codeplug in a bundle written by
+// WriteBundle.
+const (
+	BundleUsersName  = "users.csv"
+	BundleReportName = "report.txt"
+)
+
+// xlsxContentTypes is the name of an entry found in every .xlsx file,
+// telling a spreadsheet from a bundle.
+const xlsxContentTypes = "[Content_Types].xml"
+
+// WriteFile writes the codeplug to w in the format given by the
+// extension of name: an rdt image for ".rdt" or ".bin", the text format
+// for ".txt" or JSON for ".json".  Any of these is gzip-compressed if
+// name ends in ".gz", as in "club.txt.gz".
+func (cp *Codeplug) WriteFile(w io.Writer, name string) error {
+	ext := strings.ToLower(path.Ext(name))
+	switch ext {
+	case ".gz":
+		zw := gzip.NewWriter(w)
+		zw.Name = path.Base(strings.TrimSuffix(name, path.Ext(name)))
+		err := cp.WriteFile(zw, zw.Name)
+		if err != nil {
+			return err
+		}
+		return zw.Close()
+
+	case ".rdt", ".bin":
+		ignoreWarnings := true
+		data, err := cp.Bytes(ignoreWarnings)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+
+	case ".txt":
+		return cp.WriteText(w)
+
+	case ".json":
+		return cp.WriteJSON(w)
+	}
+
+	return fmt.Errorf("%s: unsupported file type", name)
+}
+
+// ExportFile writes the codeplug to filename in the format chosen by
+// WriteFile.
+func (cp *Codeplug) ExportFile(filename string) (err error) {
+	file, err := cp.fsys.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+	}()
+
+	return cp.WriteFile(file, filename)
+}
+
+// WriteBundle writes a zip bundle to w holding the codeplug, written by
+// WriteFile as name, followed by files, a map of the names and contents
+// of the files accompanying it, in order of name.
+func (cp *Codeplug) WriteBundle(w io.Writer, name string, files map[string][]byte) error {
+	zw := zip.NewWriter(w)
+	now := time.Now()
+	create := func(name string) (io.Writer, error) {
+		return zw.CreateHeader(&zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: now,
+		})
+	}
+
+	fw, err := create(path.Base(name))
+	if err != nil {
+		return err
+	}
+	err = cp.WriteFile(fw, name)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fw, err := create(name)
+		if err != nil {
+			return err
+		}
+		_, err = fw.Write(files[name])
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// isBundle returns true if data is a zip bundle rather than a
+// spreadsheet.
+func isBundle(data []byte) bool {
+	if !bytes.HasPrefix(data, zipMagic) {
+		return false
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return false
+	}
+	for _, f := range zr.File {
+		if f.Name == xlsxContentTypes {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isCodeplugName returns true if name has the extension of a codeplug
+// file, possibly gzip-compressed.
+func isCodeplugName(name string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, ".gz"))
+	switch path.Ext(name) {
+	case ".rdt", ".bin", ".txt", ".json", ".xlsx":
+		return true
+	}
+
+	return false
+}
+
+// ReadBundle returns the name and contents of the codeplug in the zip
+// bundle held in data: its first file named as a codeplug file, other
+// than a report.  filename is used in messages.
+func ReadBundle(filename string, data []byte) (name string, contents []byte, err error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	for _, f := range zr.File {
+		base := path.Base(f.Name)
+		if f.FileInfo().IsDir() || base == BundleReportName || !isCodeplugName(base) {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %s: %s", filename, f.Name, err.Error())
+		}
+		contents, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %s: %s", filename, f.Name, err.Error())
+		}
+
+		return base, contents, nil
+	}
+
+	return "", nil, fmt.Errorf("%s: no codeplug found in bundle", filename)
+}
@@ -0,0 +1,204 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// Vendor CPS installers ship sample and default codeplugs.  Those of a
+// zip archive, including a self-extracting one, are found by their
+// size and contents.  Those stored uncompressed in an installer
+// executable or other file are found by scanning for the DfuSe
+// signature that begins each .rdt file.  Codeplugs compressed by the
+// installer itself cannot be found.
+
+// An ArchiveCodeplug is a codeplug found in a vendor CPS installer.
+type ArchiveCodeplug struct {
+	// Name is the codeplug's path within the archive, or its offset
+	// in the installer.
+	Name string
+
+	// Data is the contents of the codeplug's .rdt or .bin file.
+	Data []byte
+}
+
+// maxArchiveDepth limits the nesting of archives searched.
+const maxArchiveDepth = 3
+
+// ReadArchiveCodeplugs returns the codeplugs found in the named
+// vendor CPS installer or archive.
+func ReadArchiveCodeplugs(filename string) ([]ArchiveCodeplug, error) {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	cps := findArchiveCodeplugs("", data, 0)
+	if len(cps) == 0 {
+		return nil, fmt.Errorf("%s: no codeplugs found", filename)
+	}
+
+	return cps, nil
+}
+
+// NewCodeplugFromArchive returns a Codeplug holding a codeplug found in
+// a vendor CPS installer.  The codeplug must then be loaded with Load.
+func NewCodeplugFromArchive(ac ArchiveCodeplug) (*Codeplug, error) {
+	return NewCodeplugFromBytes(FileTypeNone, ac.Name, ac.Data)
+}
+
+// findArchiveCodeplugs returns the codeplugs in data, whose entries
+// are named with the given prefix.
+func findArchiveCodeplugs(prefix string, data []byte, depth int) []ArchiveCodeplug {
+	if isCodeplugImage(data) {
+		return []ArchiveCodeplug{{Name: prefix, Data: data}}
+	}
+
+	if depth < maxArchiveDepth {
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err == nil {
+			return findZipCodeplugs(prefix, zr, depth)
+		}
+	}
+
+	return scanRdtCodeplugs(prefix, data)
+}
+
+// findZipCodeplugs returns the codeplugs in the files of a zip archive,
+// including those in archives it holds.
+func findZipCodeplugs(prefix string, zr *zip.Reader, depth int) []ArchiveCodeplug {
+	var cps []ArchiveCodeplug
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		size := int64(f.UncompressedSize64)
+		ext := strings.ToLower(path.Ext(f.Name))
+		nested := ext == ".zip" || ext == ".exe"
+		if !nested && !isCodeplugSize(size) {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			continue
+		}
+		data, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			continue
+		}
+
+		name := f.Name
+		if prefix != "" {
+			name = prefix + "/" + f.Name
+		}
+		cps = append(cps, findArchiveCodeplugs(name, data, depth+1)...)
+	}
+
+	return cps
+}
+
+// scanRdtCodeplugs returns the .rdt files stored uncompressed in data.
+func scanRdtCodeplugs(prefix string, data []byte) []ArchiveCodeplug {
+	var cps []ArchiveCodeplug
+	offset := 0
+	for {
+		i := bytes.Index(data[offset:], []byte(rdtSignature))
+		if i < 0 {
+			break
+		}
+		offset += i
+
+		found := false
+		for _, cpi := range codeplugInfos {
+			end := offset + cpi.RdtSize
+			if end > len(data) || checkRdt(data[offset:end]) != nil {
+				continue
+			}
+			name := fmt.Sprintf("offset %#x", offset)
+			if prefix != "" {
+				name = prefix + " " + name
+			}
+			cps = append(cps, ArchiveCodeplug{Name: name, Data: data[offset:end]})
+			offset = end
+			found = true
+			break
+		}
+		if !found {
+			offset += len(rdtSignature)
+		}
+	}
+
+	return cps
+}
+
+// isCodeplugSize returns true if a file of the given size may be an
+// .rdt or .bin file.
+func isCodeplugSize(size int64) bool {
+	for _, cpi := range codeplugInfos {
+		if size == int64(cpi.RdtSize) || size == int64(cpi.BinSize) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isCodeplugImage returns true if data is the contents of an .rdt
+// file, or of a .bin file.
+func isCodeplugImage(data []byte) bool {
+	for _, cpi := range codeplugInfos {
+		switch len(data) {
+		case cpi.RdtSize:
+			return checkRdt(data) == nil
+		case cpi.BinSize:
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ext returns the filename extension, "rdt" or "bin", of the
+// codeplug's file type.
+func (ac ArchiveCodeplug) Ext() string {
+	if checkRdt(ac.Data) == nil {
+		return "rdt"
+	}
+
+	return "bin"
+}
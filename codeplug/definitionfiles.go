@@ -0,0 +1,3616 @@
+package codeplug
+
+//go:generate genFileData codeplugs.json
+var codeplugs_json = []byte{
+	0x7b, 0x0a, 0x20, 0x20, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x20, 0x32, 0x30, 0x31,
+	0x37, 0x2d, 0x32, 0x30, 0x31, 0x38, 0x20, 0x44, 0x61, 0x6c, 0x65, 0x20,
+	0x46, 0x61, 0x72, 0x6e, 0x73, 0x77, 0x6f, 0x72, 0x74, 0x68, 0x2e, 0x20,
+	0x41, 0x6c, 0x6c, 0x20, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x20, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x2e, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x44, 0x61, 0x6c, 0x65, 0x20, 0x46, 0x61, 0x72, 0x6e, 0x73, 0x77, 0x6f,
+	0x72, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x31,
+	0x30, 0x30, 0x37, 0x20, 0x57, 0x20, 0x4d, 0x65, 0x6e, 0x64, 0x6f, 0x7a,
+	0x61, 0x20, 0x41, 0x76, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x4d, 0x65, 0x73, 0x61, 0x2c, 0x20, 0x41, 0x5a, 0x20, 0x20, 0x38,
+	0x35, 0x32, 0x31, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x55, 0x53, 0x41, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x61, 0x6c, 0x65, 0x40,
+	0x66, 0x61, 0x72, 0x6e, 0x73, 0x77, 0x6f, 0x72, 0x74, 0x68, 0x2e, 0x6f,
+	0x72, 0x67, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x54, 0x68, 0x69, 0x73, 0x20, 0x66,
+	0x69, 0x6c, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x63,
+	0x6f, 0x64, 0x65, 0x70, 0x6c, 0x75, 0x67, 0x20, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x2e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x54, 0x68, 0x65, 0x20, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x75, 0x73,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x6c, 0x79, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x77,
+	0x61, 0x73, 0x20, 0x6f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x6e,
+	0x74, 0x20, 0x4d, 0x44, 0x33, 0x38, 0x30, 0x20, 0x63, 0x6f, 0x64, 0x65,
+	0x70, 0x6c, 0x75, 0x67, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x68, 0x74, 0x74, 0x70,
+	0x3a, 0x2f, 0x2f, 0x77, 0x77, 0x77, 0x2e, 0x69, 0x7a, 0x32, 0x75, 0x75,
+	0x66, 0x2e, 0x6e, 0x65, 0x74, 0x2f, 0x77, 0x70, 0x2f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x2e, 0x70, 0x68, 0x70, 0x2f, 0x32, 0x30, 0x31, 0x36, 0x2f,
+	0x30, 0x36, 0x2f, 0x30, 0x34, 0x2f, 0x74, 0x79, 0x74, 0x65, 0x72, 0x61,
+	0x2d, 0x64, 0x6d, 0x33, 0x38, 0x30, 0x2d, 0x63, 0x6f, 0x64, 0x65, 0x70,
+	0x6c, 0x75, 0x67, 0x2d, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x2d, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2f, 0x22, 0x0a, 0x20, 0x20, 0x5d, 0x2c,
+	0x0a, 0x20, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x70, 0x6c, 0x75, 0x67,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x44, 0x33, 0x38,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x52, 0x37, 0x38, 0x30,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78, 0x74, 0x22, 0x3a, 0x20,
+	0x22, 0x72, 0x64, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x72, 0x64, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x32, 0x36, 0x32, 0x37, 0x30, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x32, 0x36, 0x32, 0x31, 0x34, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x35, 0x34, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x64,
+	0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30,
+	0x22, 0x2c, 0x0a, 0x09, 0x22, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65,
+	0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x63, 0x61, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x47, 0x50, 0x53, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6d, 0x64, 0x33, 0x39, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0x3a, 0x20,
+	0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x44, 0x33, 0x39, 0x30, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x65, 0x78, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x72,
+	0x64, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x72, 0x64, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x36,
+	0x32, 0x37, 0x30, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32,
+	0x36, 0x32, 0x31, 0x34, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x35, 0x34, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x64, 0x33, 0x39,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c,
+	0x0a, 0x09, 0x22, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x5f,
+	0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x50,
+	0x53, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6d, 0x64,
+	0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x44, 0x4a, 0x2d, 0x4d, 0x44, 0x34, 0x30, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x65, 0x78, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x72, 0x64,
+	0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x72,
+	0x64, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x36, 0x32,
+	0x37, 0x30, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x36,
+	0x32, 0x31, 0x34, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x35, 0x34, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x09, 0x22,
+	0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69,
+	0x73, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x6d, 0x64, 0x34,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x6d,
+	0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f,
+	0x6d, 0x64, 0x34, 0x30, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x5d, 0x2c,
+	0x0a, 0x20, 0x20, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x64, 0x33,
+	0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x38, 0x38, 0x30, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x42, 0x69, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69,
+	0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x4e, 0x65,
+	0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x64,
+	0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x42, 0x69, 0x4c, 0x6f, 0x77, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x48, 0x69, 0x67, 0x68, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x4c, 0x61,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x43, 0x70, 0x73, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x61, 0x73,
+	0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x64, 0x33, 0x39, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x38, 0x30, 0x35, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x42, 0x69, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x64, 0x33, 0x39,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x42, 0x69, 0x4e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x6d, 0x64, 0x33, 0x39, 0x30, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x4c, 0x6f,
+	0x77, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69,
+	0x48, 0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x42, 0x69, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x43,
+	0x70, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38,
+	0x38, 0x30, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x42, 0x69, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x42, 0x69, 0x4e, 0x65, 0x77, 0x46, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x69,
+	0x4c, 0x6f, 0x77, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x42, 0x69, 0x48, 0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x42, 0x69, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42,
+	0x69, 0x43, 0x70, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x20, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x38, 0x38, 0x30, 0x35, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x31, 0x34, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x47, 0x73, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x49, 0x44, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47,
+	0x73, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x47, 0x73, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x49, 0x6e, 0x74, 0x72,
+	0x6f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x32,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x47, 0x73, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x65, 0x61, 0x6d, 0x62,
+	0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x6e, 0x65, 0x73,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x47, 0x73, 0x43, 0x68, 0x46, 0x72, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6e, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73,
+	0x54, 0x61, 0x6c, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x54, 0x6f,
+	0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x54, 0x6f, 0x6e, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x47, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x44, 0x69, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x48, 0x61, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73,
+	0x53, 0x63, 0x61, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x48, 0x61,
+	0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4c, 0x6f, 0x6e, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4c, 0x6f, 0x6e, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x50, 0x77, 0x41, 0x6e,
+	0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47,
+	0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x56, 0x6f, 0x78, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x54,
+	0x78, 0x50, 0x72, 0x65, 0x61, 0x6d, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x52, 0x78, 0x4c, 0x6f, 0x77,
+	0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x47, 0x73, 0x50, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x52, 0x61, 0x64,
+	0x69, 0x6f, 0x50, 0x72, 0x6f, 0x67, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x42, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47,
+	0x73, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x64, 0x4c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x4c, 0x65, 0x64, 0x73, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x61, 0x6e,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x20, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6d, 0x64,
+	0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x38, 0x38, 0x30,
+	0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x34, 0x34, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x52, 0x61, 0x64, 0x69, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x49,
+	0x44, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x47, 0x73, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x49, 0x6e,
+	0x74, 0x72, 0x6f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4c, 0x69, 0x6e,
+	0x65, 0x32, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x65, 0x61,
+	0x6d, 0x62, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x6e,
+	0x65, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x43, 0x68, 0x46, 0x72, 0x65, 0x65, 0x49, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6e, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x47, 0x73, 0x54, 0x61, 0x6c, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74,
+	0x54, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x54, 0x6f, 0x6e, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x47, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x44, 0x69, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x48, 0x61, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x47, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67,
+	0x48, 0x61, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4c, 0x6f,
+	0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4c, 0x6f,
+	0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x50, 0x77,
+	0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x47, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x56, 0x6f, 0x78,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47,
+	0x73, 0x54, 0x78, 0x50, 0x72, 0x65, 0x61, 0x6d, 0x62, 0x6c, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x52, 0x78, 0x4c,
+	0x6f, 0x77, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x50, 0x63, 0x50, 0x72, 0x6f,
+	0x67, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x52,
+	0x61, 0x64, 0x69, 0x6f, 0x50, 0x72, 0x6f, 0x67, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73,
+	0x46, 0x72, 0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d,
+	0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x47, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4d, 0x6f, 0x64,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4c, 0x6f, 0x63,
+	0x6b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x53, 0x65, 0x74,
+	0x4b, 0x65, 0x79, 0x70, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x47, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x6c, 0x6c, 0x4c, 0x65, 0x64, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x67, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x47, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4d, 0x65, 0x6e, 0x75, 0x20, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x38, 0x39, 0x38, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x48, 0x61, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x52, 0x61, 0x64, 0x69,
+	0x6f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69,
+	0x52, 0x61, 0x64, 0x69, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69,
+	0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x61, 0x6c, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69,
+	0x45, 0x64, 0x69, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x4d, 0x69, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x54, 0x6f, 0x6e, 0x65, 0x4f, 0x72,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x54, 0x61, 0x6c, 0x6b, 0x61,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x4f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x4d, 0x69, 0x45, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x4d, 0x69, 0x53, 0x63, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x56, 0x6f, 0x78, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d,
+	0x69, 0x53, 0x71, 0x75, 0x65, 0x6c, 0x63, 0x68, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x4c, 0x65,
+	0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69,
+	0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x6f, 0x63, 0x6b,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x4d, 0x69, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x4d, 0x69, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x4d, 0x69, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d, 0x69, 0x47, 0x70, 0x73,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x4d, 0x69, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x61, 0x64,
+	0x69, 0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x4d, 0x69, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x4d, 0x69, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x54, 0x65, 0x78, 0x74, 0x20, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x65, 0x78,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x39, 0x31, 0x32, 0x35, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x32, 0x38, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x35, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x63,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x30, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20,
+	0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x54,
+	0x6d, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32,
+	0x34, 0x39, 0x39, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x33, 0x36, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a,
+	0x20, 0x31, 0x30, 0x30, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x39, 0x32, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x63,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x44, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x44,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x44, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x63,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x54,
+	0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x58, 0x20,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69,
+	0x73, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x30,
+	0x39, 0x39, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x39, 0x36, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20,
+	0x32, 0x35, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x64, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x3a, 0x20, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x47, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x38, 0x34, 0x39, 0x39,
+	0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x36, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x32, 0x35,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65,
+	0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20,
+	0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x5a, 0x69, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x5a, 0x69,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x33, 0x38,
+	0x30, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65, 0x73,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x5f,
+	0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x38,
+	0x34, 0x39, 0x39, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x36, 0x34, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a,
+	0x20, 0x32, 0x35, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22, 0x3a, 0x20,
+	0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3a, 0x20, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x5a, 0x69, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x5a, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d,
+	0x64, 0x34, 0x30, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x63, 0x61,
+	0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x6d,
+	0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31,
+	0x30, 0x30, 0x39, 0x39, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x34,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78,
+	0x22, 0x3a, 0x20, 0x32, 0x35, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x3a, 0x20, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x53, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x31, 0x5f, 0x6d, 0x64,
+	0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x53, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x32, 0x5f, 0x6d, 0x64,
+	0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x53, 0x6c, 0x54, 0x78, 0x44, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x53, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x33, 0x38,
+	0x30, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x63, 0x61, 0x6e, 0x20,
+	0x4c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53,
+	0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x6d, 0x64, 0x34,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x30, 0x39,
+	0x39, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x34, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20,
+	0x32, 0x35, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x64, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x3a, 0x20, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x53, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x31, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53,
+	0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x32, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c,
+	0x54, 0x78, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x34, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x53, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x53, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d,
+	0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31,
+	0x32, 0x37, 0x30, 0x31, 0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x36, 0x34, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22,
+	0x3a, 0x20, 0x31, 0x30, 0x30, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x35, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52, 0x78, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x54, 0x78, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x42,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x53, 0x63,
+	0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x53, 0x71, 0x75, 0x65, 0x6c, 0x63, 0x68, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52,
+	0x78, 0x52, 0x65, 0x66, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x54, 0x78, 0x52, 0x65, 0x66, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x54, 0x6f, 0x74, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x54, 0x6f, 0x74, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x41, 0x64,
+	0x6d, 0x69, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6e, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52,
+	0x78, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x4c, 0x6f, 0x6e, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x56, 0x6f, 0x78, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x6c, 0x6b, 0x61, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x69, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79,
+	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x41, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x45, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x44, 0x61, 0x74, 0x61, 0x43, 0x61, 0x6c, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x55, 0x64, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x54, 0x54, 0x49, 0x44,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x43, 0x74, 0x63, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x54, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x51,
+	0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x75, 0x72, 0x73, 0x74, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x43, 0x74, 0x63, 0x73, 0x73, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x52, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x32, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x33, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x34, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x35, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x36, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x37, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x38, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x47, 0x50, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x50, 0x53, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x47, 0x50, 0x53, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x49, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x34, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x32, 0x37, 0x30, 0x31,
+	0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x36, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x30,
+	0x30, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64,
+	0x65, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x3a, 0x20, 0x32, 0x35, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x43, 0x69, 0x52, 0x78, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x69, 0x54, 0x78, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x42, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x53, 0x71, 0x75,
+	0x65, 0x6c, 0x63, 0x68, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52, 0x78, 0x52, 0x65, 0x66, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x54, 0x78,
+	0x52, 0x65, 0x66, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x54, 0x6f, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x54, 0x6f, 0x74, 0x52, 0x65,
+	0x6b, 0x65, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x69, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x41, 0x75, 0x74, 0x6f,
+	0x73, 0x63, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52, 0x78, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x4c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x56, 0x6f, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x54, 0x61, 0x6c, 0x6b, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x45, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x61, 0x72, 0x6d,
+	0x41, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x61, 0x74, 0x61, 0x43, 0x61, 0x6c,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x55, 0x64,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x54, 0x54,
+	0x49, 0x44, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x43, 0x69, 0x43, 0x74, 0x63, 0x73, 0x73, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x69, 0x54, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x51, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x75, 0x72, 0x73, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x43, 0x74, 0x63, 0x73, 0x73, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x52, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x32, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x33,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x34, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x35, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x36, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x37,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x38, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x47, 0x50, 0x53, 0x20, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x50, 0x53, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x32, 0x35, 0x37, 0x36, 0x33, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61,
+	0x78, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x50, 0x53, 0x20, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x70, 0x47, 0x50, 0x53,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x47, 0x70, 0x47, 0x50, 0x53, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x47, 0x70, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20,
+	0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x20,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x32, 0x33, 0x34, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x36, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x20, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x42, 0x69, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x34,
+	0x38, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20,
+	0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x31,
+	0x33, 0x36, 0x2d, 0x31, 0x37, 0x34, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x33, 0x35,
+	0x30, 0x2d, 0x34, 0x30, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x34, 0x30, 0x30,
+	0x2d, 0x34, 0x38, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x34, 0x35, 0x30, 0x2d,
+	0x35, 0x32, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x43, 0x6f, 0x64, 0x65, 0x70, 0x6c, 0x75, 0x67, 0x20, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x20, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x4e, 0x65, 0x77, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x64, 0x33, 0x38,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32,
+	0x34, 0x38, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x62, 0x69, 0x46,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x5f, 0x31, 0x33, 0x36, 0x2d,
+	0x31, 0x37, 0x34, 0x2e, 0x72, 0x64, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x64, 0x33, 0x38, 0x30,
+	0x5f, 0x33, 0x35, 0x30, 0x2d, 0x34, 0x30, 0x30, 0x2e, 0x72, 0x64, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6d, 0x64, 0x33, 0x38, 0x30, 0x5f, 0x34, 0x30, 0x30, 0x2d, 0x34, 0x38,
+	0x30, 0x2e, 0x72, 0x64, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x5f, 0x34,
+	0x35, 0x30, 0x2d, 0x35, 0x32, 0x30, 0x2e, 0x72, 0x64, 0x74, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x20, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x42, 0x69, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x64, 0x33, 0x39, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x34, 0x38,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x31, 0x33,
+	0x36, 0x2d, 0x31, 0x37, 0x34, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x33, 0x35, 0x30,
+	0x2d, 0x34, 0x30, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x34, 0x30, 0x30, 0x2d,
+	0x34, 0x38, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x34, 0x35, 0x30, 0x2d, 0x35,
+	0x32, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x43, 0x6f, 0x64, 0x65, 0x70, 0x6c, 0x75, 0x67, 0x20, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x20, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x4e, 0x65, 0x77, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x64, 0x33, 0x39, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x34,
+	0x38, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x62, 0x69, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6d, 0x64, 0x33, 0x39, 0x30, 0x5f, 0x31, 0x33, 0x36, 0x2d, 0x31,
+	0x37, 0x34, 0x2e, 0x72, 0x64, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x64, 0x33, 0x39, 0x30, 0x5f,
+	0x33, 0x35, 0x30, 0x2d, 0x34, 0x30, 0x30, 0x2e, 0x72, 0x64, 0x74, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d,
+	0x64, 0x33, 0x39, 0x30, 0x5f, 0x34, 0x30, 0x30, 0x2d, 0x34, 0x38, 0x30,
+	0x2e, 0x72, 0x64, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6d, 0x64, 0x33, 0x39, 0x30, 0x5f, 0x34, 0x35,
+	0x30, 0x2d, 0x35, 0x32, 0x30, 0x2e, 0x72, 0x64, 0x74, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x20, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x42, 0x69, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x34, 0x38, 0x30, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x34, 0x30, 0x30, 0x2d,
+	0x34, 0x38, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x20, 0x2d, 0x20, 0x4d, 0x44,
+	0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x34, 0x32, 0x30, 0x2d, 0x34, 0x35, 0x30, 0x20, 0x4d, 0x48,
+	0x7a, 0x20, 0x2d, 0x20, 0x4d, 0x44, 0x34, 0x30, 0x48, 0x54, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x34, 0x33,
+	0x30, 0x2d, 0x34, 0x34, 0x30, 0x20, 0x4d, 0x48, 0x7a, 0x20, 0x2d, 0x20,
+	0x4d, 0x44, 0x34, 0x30, 0x48, 0x45, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x34, 0x30, 0x30, 0x2d, 0x34, 0x38,
+	0x30, 0x20, 0x4d, 0x48, 0x7a, 0x20, 0x2d, 0x20, 0x4d, 0x44, 0x34, 0x30,
+	0x54, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f, 0x64, 0x65, 0x70,
+	0x6c, 0x75, 0x67, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x20, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x42, 0x69, 0x4e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x32, 0x34, 0x38, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x62, 0x69, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x64, 0x34, 0x30, 0x5f,
+	0x34, 0x30, 0x30, 0x2d, 0x34, 0x38, 0x30, 0x2e, 0x72, 0x64, 0x74, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d,
+	0x64, 0x34, 0x30, 0x68, 0x74, 0x5f, 0x34, 0x32, 0x30, 0x2d, 0x34, 0x35,
+	0x30, 0x2e, 0x72, 0x64, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x64, 0x34, 0x30, 0x68, 0x65, 0x5f,
+	0x34, 0x33, 0x30, 0x2d, 0x34, 0x34, 0x30, 0x2e, 0x72, 0x64, 0x74, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d,
+	0x64, 0x34, 0x30, 0x74, 0x5f, 0x34, 0x30, 0x30, 0x2d, 0x34, 0x38, 0x30,
+	0x2e, 0x72, 0x64, 0x74, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x6f,
+	0x77, 0x20, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x4c, 0x6f, 0x77, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x30, 0x34, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x62, 0x69, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x48, 0x69, 0x67, 0x68, 0x20, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x48,
+	0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35,
+	0x32, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x62, 0x69, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x64, 0x20, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x4c, 0x61,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x36, 0x39, 0x39, 0x33, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x35, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x50, 0x53,
+	0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x42, 0x69, 0x43, 0x70, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x36, 0x39, 0x39, 0x39, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x33, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x63, 0x70, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x49, 0x6e, 0x74, 0x72,
+	0x6f, 0x20, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x20, 0x4c, 0x69, 0x6e,
+	0x65, 0x20, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x49,
+	0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4c, 0x69,
+	0x6e, 0x65, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x30,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e,
+	0x74, 0x72, 0x6f, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x20, 0x53,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x20, 0x4c, 0x69, 0x6e, 0x65, 0x20, 0x32,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x49, 0x6e, 0x74, 0x72,
+	0x6f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x4c, 0x69, 0x6e, 0x65, 0x32,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x36,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x30, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x6e, 0x74,
+	0x72, 0x6f, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x20,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35, 0x31, 0x35,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x69, 0x6c,
+	0x65, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x4f, 0x70, 0x65, 0x6e, 0x20, 0x53, 0x71, 0x75, 0x65,
+	0x6c, 0x63, 0x68, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x20, 0x41, 0x6c, 0x6c, 0x20, 0x4c, 0x45, 0x44,
+	0x53, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x4c, 0x65, 0x64, 0x73, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35, 0x31, 0x37,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x61, 0x6c,
+	0x6b, 0x20, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x20, 0x54, 0x6f, 0x6e,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x54, 0x61, 0x6c,
+	0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x6e, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35, 0x32, 0x30,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4e, 0x6f, 0x6e,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x41, 0x6e, 0x61, 0x6c,
+	0x6f, 0x67, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x41,
+	0x6e, 0x64, 0x20, 0x4c, 0x6f, 0x63, 0x6b, 0x20, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x50, 0x77,
+	0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35,
+	0x32, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x6e, 0x4f, 0x66,
+	0x66, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4f, 0x6e, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x4f, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20,
+	0x46, 0x72, 0x65, 0x65, 0x20, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x47, 0x73, 0x43, 0x68, 0x46, 0x72, 0x65, 0x65, 0x49, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6e, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35, 0x32,
+	0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x6e, 0x4f, 0x66, 0x66,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x41, 0x6c, 0x6c, 0x20, 0x54, 0x6f,
+	0x6e, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x6e,
+	0x65, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x35, 0x32, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x6e, 0x4f,
+	0x66, 0x66, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x53, 0x61, 0x76, 0x65, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47,
+	0x73, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x35, 0x32, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x53, 0x61, 0x76, 0x65, 0x20, 0x50, 0x72, 0x65, 0x61, 0x6d,
+	0x62, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x53,
+	0x61, 0x76, 0x65, 0x50, 0x72, 0x65, 0x61, 0x6d, 0x62, 0x6c, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35, 0x32, 0x37,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x49, 0x6e, 0x74,
+	0x72, 0x6f, 0x20, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x47, 0x73, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x35, 0x33, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x20,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x6f, 0x63, 0x6b, 0x2f,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x47, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35, 0x33,
+	0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x4c, 0x6f, 0x63, 0x6b, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x46, 0x72, 0x65, 0x71, 0x2f, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x47, 0x73, 0x46, 0x72, 0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x35, 0x34, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x3a,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x47, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x47, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x6f, 0x64, 0x65, 0x20,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x47, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35, 0x34,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x56, 0x46,
+	0x4f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x20, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73,
+	0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x35, 0x34, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x4f, 0x66, 0x66, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x4f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x57, 0x68, 0x69,
+	0x74, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x53, 0x61, 0x6b, 0x75, 0x72, 0x61, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x20, 0x49, 0x44, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x49,
+	0x44, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x35,
+	0x34, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x34, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x61, 0x6c,
+	0x6c, 0x49, 0x44, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x54, 0x78, 0x20, 0x50, 0x72, 0x65, 0x61, 0x6d, 0x62, 0x6c, 0x65,
+	0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28, 0x6d,
+	0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x54, 0x78,
+	0x50, 0x72, 0x65, 0x61, 0x6d, 0x62, 0x6c, 0x65, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x35, 0x37, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a,
+	0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x34, 0x34, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0x3a, 0x20, 0x36, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x43, 0x61, 0x6c, 0x6c, 0x20, 0x48,
+	0x61, 0x6e, 0x67, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x28, 0x6d, 0x53,
+	0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x35, 0x38, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61,
+	0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x30,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d,
+	0x61, 0x78, 0x22, 0x3a, 0x20, 0x37, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x3a, 0x20, 0x31, 0x30, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x22, 0x3a, 0x20, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x20, 0x43, 0x61, 0x6c, 0x6c, 0x20, 0x48, 0x61,
+	0x6e, 0x67, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x28, 0x6d, 0x53, 0x29,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x61, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x35, 0x39, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70,
+	0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x37, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x3a, 0x20, 0x31, 0x30, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x22, 0x3a, 0x20, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x56, 0x4f,
+	0x58, 0x20, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x56, 0x6f, 0x78,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x30, 0x30,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e,
+	0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22,
+	0x3a, 0x20, 0x31, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x78, 0x20,
+	0x4c, 0x6f, 0x77, 0x20, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x20,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x20, 0x28, 0x53, 0x29,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x52, 0x78, 0x4c, 0x6f,
+	0x77, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x36, 0x32, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a,
+	0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x32, 0x37, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x61, 0x6c, 0x6c, 0x20, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x20, 0x54, 0x6f,
+	0x6e, 0x65, 0x20, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x28, 0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x43,
+	0x61, 0x6c, 0x6c, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x6e, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x33, 0x32, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d,
+	0x69, 0x6e, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x32,
+	0x34, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4c, 0x6f, 0x6e, 0x65, 0x20, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x54, 0x69, 0x6d,
+	0x65, 0x20, 0x28, 0x6d, 0x69, 0x6e, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x47, 0x73, 0x4c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x36,
+	0x34, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70,
+	0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61,
+	0x78, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4c, 0x6f, 0x6e, 0x65, 0x20, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x20,
+	0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x20, 0x54, 0x69, 0x6d,
+	0x65, 0x20, 0x28, 0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47,
+	0x73, 0x4c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x34, 0x38,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e,
+	0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22,
+	0x3a, 0x20, 0x32, 0x35, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x63,
+	0x61, 0x6e, 0x20, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x20, 0x48,
+	0x61, 0x6e, 0x67, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x28, 0x6d, 0x53,
+	0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x53, 0x63, 0x61,
+	0x6e, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x48, 0x61, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x36, 0x36, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a,
+	0x20, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x30, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0x3a, 0x20, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x53, 0x63, 0x61, 0x6e, 0x20, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x20,
+	0x48, 0x61, 0x6e, 0x67, 0x20, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x28, 0x6d,
+	0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x53, 0x63,
+	0x61, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x48, 0x61, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x36, 0x37, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a,
+	0x20, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x30, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0x3a, 0x20, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x20, 0x54, 0x69,
+	0x6d, 0x65, 0x20, 0x28, 0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x47, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x36, 0x38, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x41, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x35, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x31, 0x30, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x31, 0x35,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x65, 0x74, 0x20, 0x4b, 0x65,
+	0x79, 0x70, 0x61, 0x64, 0x20, 0x4c, 0x6f, 0x63, 0x6b, 0x20, 0x54, 0x69,
+	0x6d, 0x65, 0x20, 0x28, 0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x47, 0x73, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x64, 0x4c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x38, 0x38, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x3a, 0x20, 0x32, 0x35, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x20, 0x22, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x3a, 0x20, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x20, 0x22, 0x35, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x31,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22,
+	0x31, 0x30, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x35, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x31, 0x35, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x47, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x39, 0x36, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a,
+	0x20, 0x22, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x3a, 0x20, 0x32, 0x35, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x4f, 0x6e, 0x20,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x47, 0x73, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x37, 0x30, 0x34, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x33, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x52, 0x61, 0x64, 0x69, 0x6f, 0x20, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x6d, 0x69, 0x6e, 0x67, 0x20, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x73, 0x52, 0x61,
+	0x64, 0x69, 0x6f, 0x50, 0x72, 0x6f, 0x67, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x37, 0x33, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x33,
+	0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x72,
+	0x61, 0x64, 0x69, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x43,
+	0x20, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x69, 0x6e, 0x67,
+	0x20, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x47, 0x73, 0x50, 0x63, 0x50, 0x72, 0x6f, 0x67, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x37, 0x36, 0x38, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x36, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x70, 0x63, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52,
+	0x61, 0x64, 0x69, 0x6f, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x47, 0x73, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x38, 0x39, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x35,
+	0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x72,
+	0x61, 0x64, 0x69, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x48, 0x61, 0x6e, 0x67, 0x20, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x48,
+	0x61, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x33, 0x30, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x48, 0x61,
+	0x6e, 0x67, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x61, 0x64, 0x69,
+	0x6f, 0x20, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x4d, 0x69, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x20, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x52, 0x61, 0x64,
+	0x69, 0x6f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x20, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4d, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x31, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x52, 0x61, 0x64, 0x69, 0x6f, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x52, 0x61, 0x64, 0x69, 0x6f,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x31, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x20, 0x44, 0x69, 0x61,
+	0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x4d, 0x61, 0x6e,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x45, 0x64, 0x69, 0x74, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x4d, 0x69, 0x45, 0x64, 0x69, 0x74, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x33, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x43,
+	0x61, 0x6c, 0x6c, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x34, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x65, 0x78, 0x74, 0x20, 0x4d, 0x65,
+	0x73, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4d, 0x69, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31,
+	0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x6f,
+	0x6e, 0x65, 0x20, 0x4f, 0x72, 0x20, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x54, 0x6f, 0x6e, 0x65, 0x4f,
+	0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x54, 0x61, 0x6c, 0x6b, 0x61, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x54, 0x61, 0x6c,
+	0x6b, 0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x20, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4d, 0x69, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x52, 0x61,
+	0x64, 0x69, 0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x31, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x4d, 0x69, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x39,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x4d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x32, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x45, 0x64, 0x69, 0x74, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x45, 0x64, 0x69, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x32, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x53, 0x63, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69,
+	0x53, 0x63, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x32, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x20, 0x4b, 0x65, 0x79,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x32, 0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x56, 0x4f, 0x58, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4d, 0x69, 0x56, 0x6f, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x32, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x53, 0x71, 0x75, 0x65, 0x6c, 0x63, 0x68, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x4d, 0x69, 0x53, 0x71, 0x75, 0x65, 0x6c, 0x63, 0x68,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x45, 0x44,
+	0x20, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x4c, 0x65, 0x64, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x32, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x20,
+	0x4c, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69,
+	0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x6f, 0x63, 0x6b,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x38,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x49, 0x6e, 0x74,
+	0x72, 0x6f, 0x20, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x4d, 0x69, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x32, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x33, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d,
+	0x69, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x33, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x47, 0x50, 0x53, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4d, 0x69, 0x47, 0x70, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x33, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x6e, 0x4f, 0x66, 0x66, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x20, 0x52, 0x61,
+	0x64, 0x69, 0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d, 0x69, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x37, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x4d, 0x69, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x33, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x41, 0x6e, 0x64,
+	0x20, 0x4c, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4d,
+	0x69, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x6e, 0x64,
+	0x4c, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x33, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x54, 0x6d, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x33, 0x30,
+	0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x74,
+	0x65, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x61, 0x6c, 0x6c,
+	0x20, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x63, 0x43,
+	0x61, 0x6c, 0x6c, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32,
+	0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x63,
+	0x61, 0x6c, 0x6c, 0x49, 0x44, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x43, 0x61, 0x6c, 0x6c, 0x20, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x20, 0x54, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x44, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x54, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x4e, 0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x59, 0x65, 0x73, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x43, 0x61, 0x6c, 0x6c, 0x20, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x63, 0x43, 0x61, 0x6c, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x33, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x61,
+	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x41, 0x6c, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x44, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x32, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x58, 0x20, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x6c, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x32, 0x35, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d,
+	0x61, 0x78, 0x22, 0x3a, 0x20, 0x33, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x5a, 0x6f, 0x6e, 0x65, 0x20, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x5a, 0x69, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x5a, 0x69, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20,
+	0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x5a, 0x69, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20,
+	0x36, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x30, 0x31, 0x32, 0x35, 0x33,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x32, 0x34, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x63, 0x61, 0x6e, 0x20, 0x4c, 0x69,
+	0x73, 0x74, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x53, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x32, 0x35, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x20, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x20, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x6c,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x31, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20,
+	0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x36, 0x35, 0x35,
+	0x33, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20,
+	0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x32, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x20, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x53, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x31, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20,
+	0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x36, 0x35, 0x35,
+	0x33, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20,
+	0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x53, 0x6c, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x32, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x20, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x20, 0x32, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53,
+	0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x32, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x37, 0x32,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d,
+	0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a,
+	0x20, 0x22, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x3a, 0x20, 0x36, 0x35, 0x35, 0x33, 0x35, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x20, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x32, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x53, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x32, 0x5f, 0x6d,
+	0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x32, 0x37, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x36, 0x35, 0x35, 0x33, 0x35,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x4e,
+	0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x78, 0x20, 0x44, 0x65,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x6c,
+	0x54, 0x78, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x33, 0x38,
+	0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32,
+	0x38, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x20, 0x22, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x36, 0x35, 0x35, 0x33, 0x35, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x61,
+	0x73, 0x74, 0x20, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x78, 0x20,
+	0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x53, 0x6c, 0x54, 0x78, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64,
+	0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x32, 0x38, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x20, 0x22, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x36, 0x35, 0x35, 0x33, 0x35, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x61,
+	0x73, 0x74, 0x20, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x48, 0x6f, 0x6c, 0x64,
+	0x20, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x28, 0x6d, 0x53, 0x29, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x53, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x31,
+	0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61,
+	0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x32, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78,
+	0x22, 0x3a, 0x20, 0x32, 0x35, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x3a,
+	0x20, 0x32, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x20, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x20,
+	0x54, 0x69, 0x6d, 0x65, 0x20, 0x28, 0x6d, 0x53, 0x29, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x53, 0x6c, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x32, 0x30,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e,
+	0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x33, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22,
+	0x3a, 0x20, 0x33, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x3a, 0x20, 0x32,
+	0x35, 0x30, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x6c, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x33,
+	0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a,
+	0x20, 0x33, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x64, 0x34, 0x30,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x33,
+	0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a,
+	0x20, 0x33, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4c, 0x6f, 0x6e, 0x65, 0x20,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x43, 0x69, 0x4c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x71, 0x75, 0x65,
+	0x6c, 0x63, 0x68, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x53,
+	0x71, 0x75, 0x65, 0x6c, 0x63, 0x68, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x54, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x41, 0x75, 0x74, 0x6f, 0x73,
+	0x63, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x41,
+	0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x42, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x31, 0x32, 0x2e, 0x35, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x32, 0x35, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x4d, 0x6f, 0x64,
+	0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x20, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x41, 0x6e, 0x61, 0x6c, 0x6f, 0x67, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x69,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x69, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41,
+	0x6c, 0x61, 0x72, 0x6d, 0x41, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x45,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x61, 0x74, 0x61, 0x43, 0x61,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x55, 0x64, 0x70, 0x44, 0x61, 0x74, 0x61, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x52,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x43, 0x74,
+	0x63, 0x73, 0x73, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x52, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x54, 0x54, 0x49, 0x44, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x43, 0x74, 0x63, 0x73, 0x73, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x54, 0x78, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x20, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x35, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20,
+	0x53, 0x6c, 0x6f, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x32,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x32, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x78, 0x20, 0x4f,
+	0x6e, 0x6c, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x52,
+	0x78, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x31, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x20, 0x54, 0x61, 0x6c, 0x6b,
+	0x61, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x43, 0x69, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x6c, 0x6b, 0x61,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x31, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x44, 0x61, 0x74, 0x61, 0x20, 0x43, 0x61, 0x6c, 0x6c, 0x20,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x43, 0x69, 0x44, 0x61, 0x74, 0x61, 0x43, 0x61, 0x6c,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x20, 0x43, 0x61, 0x6c, 0x6c, 0x20, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x6d, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x37, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x31, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x69, 0x6e,
+	0x67, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x20, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x30, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x30, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20,
+	0x31, 0x35, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x20, 0x50, 0x54, 0x54, 0x20, 0x49, 0x44, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x43, 0x69, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x50, 0x54, 0x54, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x32, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f,
+	0x6e, 0x4f, 0x66, 0x66, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x20, 0x55, 0x44, 0x50, 0x20, 0x44, 0x61, 0x74, 0x61, 0x20, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x55, 0x64,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x45, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x20,
+	0x41, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x45,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x6c, 0x61, 0x72,
+	0x6d, 0x41, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x32, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x52, 0x78, 0x20, 0x52, 0x65, 0x66, 0x20, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x69, 0x52, 0x78, 0x52, 0x65, 0x66, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a,
+	0x20, 0x33, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x4c, 0x6f, 0x77, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x48, 0x69, 0x67,
+	0x68, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x41, 0x64, 0x6d, 0x69, 0x74,
+	0x20, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x43, 0x69, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x33, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x69, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x41, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x66, 0x72, 0x65, 0x65, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x54, 0x43,
+	0x53, 0x53, 0x2f, 0x44, 0x43, 0x53, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x20,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x33, 0x34, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x4c, 0x6f, 0x77, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x48, 0x69, 0x67, 0x68, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x56, 0x4f, 0x58, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x69, 0x56, 0x6f, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x33, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66,
+	0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x51, 0x54, 0x20, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x51, 0x74, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x33, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x31, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x31, 0x32, 0x30, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x20, 0x42, 0x75, 0x72,
+	0x73, 0x74, 0x2f, 0x54, 0x75, 0x72, 0x6e, 0x20, 0x4f, 0x66, 0x66, 0x20,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x75, 0x72, 0x73, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x37,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x78, 0x20,
+	0x52, 0x65, 0x66, 0x20, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x54, 0x78, 0x52,
+	0x65, 0x66, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x38, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x69, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4c, 0x6f, 0x77, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4d,
+	0x65, 0x64, 0x69, 0x75, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x48, 0x69, 0x67, 0x68, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x49, 0x6e, 0x20, 0x43, 0x61, 0x6c, 0x6c, 0x20, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x43, 0x69, 0x49, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x34, 0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x41, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x74, 0x20, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x3a, 0x20, 0x34, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x54, 0x4f, 0x54, 0x20, 0x28, 0x53, 0x29, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x54, 0x6f, 0x74, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x36, 0x36, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20,
+	0x36, 0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x35, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69,
+	0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x49,
+	0x6e, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x54, 0x4f, 0x54, 0x20, 0x52, 0x65, 0x6b, 0x65, 0x79, 0x20, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x20, 0x28, 0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x69, 0x54, 0x6f, 0x74, 0x52, 0x65, 0x6b, 0x65, 0x79,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x37, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a,
+	0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x35, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x53, 0x63, 0x61, 0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x53, 0x63, 0x61, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x64, 0x33, 0x38, 0x30, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x38, 0x38, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c,
+	0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53,
+	0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x6d, 0x64, 0x33,
+	0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22,
+	0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x63, 0x61, 0x6e,
+	0x20, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x69, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x64,
+	0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x38, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x53, 0x63, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x73,
+	0x5f, 0x6d, 0x64, 0x34, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22,
+	0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52,
+	0x58, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x39, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f,
+	0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65,
+	0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x47, 0x50, 0x53, 0x20, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x47, 0x50,
+	0x53, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x30, 0x37, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x3a, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x69,
+	0x6e, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78, 0x22, 0x3a, 0x20, 0x31, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d,
+	0x69, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22,
+	0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x45, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x45, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x38,
+	0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x73, 0x70, 0x61, 0x6e, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61,
+	0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78,
+	0x22, 0x3a, 0x20, 0x33, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x31, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x31, 0x31, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x32, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x31, 0x33,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x20, 0x33, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x33, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x31, 0x34, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x20, 0x34, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x34, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x31, 0x31, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x35, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x35, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20,
+	0x31, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66,
+	0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x36, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x36, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x31, 0x37,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x20, 0x37, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x37, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x31, 0x38, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x20, 0x38, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x38, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x31, 0x31, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x6f, 0x66, 0x66, 0x4f, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x52, 0x78, 0x20, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x20, 0x28, 0x4d, 0x48, 0x7a, 0x29, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x43, 0x69, 0x52, 0x78, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x31, 0x32, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x33, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x78, 0x20, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x20, 0x28, 0x4d, 0x48, 0x7a,
+	0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x54, 0x78, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x30, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x33, 0x32, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x54, 0x43, 0x53, 0x53, 0x2f, 0x44, 0x43, 0x53, 0x20, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x43,
+	0x74, 0x63, 0x73, 0x73, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x39, 0x32, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x63, 0x74, 0x63, 0x73, 0x73, 0x44,
+	0x63, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x54, 0x43, 0x53, 0x53, 0x2f,
+	0x44, 0x43, 0x53, 0x20, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x43, 0x74, 0x63, 0x73, 0x73, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x32, 0x30, 0x38, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x63, 0x74, 0x63, 0x73, 0x73, 0x44, 0x63, 0x73, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4e,
+	0x6f, 0x6e, 0x65, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x75, 0x72,
+	0x73, 0x74, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x51, 0x74, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x78, 0x20, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x52, 0x78,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x3a, 0x20, 0x32, 0x32, 0x39, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20,
+	0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x69,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4f, 0x66, 0x66,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4f, 0x66, 0x66, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x54,
+	0x4d, 0x46, 0x2d, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x44, 0x54, 0x4d, 0x46, 0x2d, 0x32, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x54,
+	0x4d, 0x46, 0x2d, 0x33, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x44, 0x54, 0x4d, 0x46, 0x2d, 0x34, 0x22, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4f,
+	0x66, 0x66, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x3a,
+	0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x31, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x43, 0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x33, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43,
+	0x69, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x34, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x35, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x36, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x37, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x43, 0x69, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x38, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x78, 0x20, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x54,
+	0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x3a, 0x20, 0x32, 0x33, 0x37, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a,
+	0x20, 0x33, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x69, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4f, 0x66,
+	0x66, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x4f, 0x66, 0x66, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44,
+	0x54, 0x4d, 0x46, 0x2d, 0x31, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x54, 0x4d, 0x46, 0x2d, 0x32, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x44,
+	0x54, 0x4d, 0x46, 0x2d, 0x33, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x44, 0x54, 0x4d, 0x46, 0x2d, 0x34, 0x22,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x20, 0x47, 0x50, 0x53, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x43, 0x69, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x47, 0x50, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x34, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x22, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x53, 0x65, 0x6e, 0x64, 0x20, 0x47, 0x50,
+	0x53, 0x20, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x43, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x50, 0x53, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32,
+	0x35, 0x35, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
+	0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x6f, 0x6e, 0x4f, 0x66,
+	0x66, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x69, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x32, 0x35, 0x36, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x47, 0x50, 0x53, 0x20, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x20, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x47, 0x70, 0x47, 0x50, 0x53, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x67, 0x70, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6d,
+	0x64, 0x33, 0x38, 0x30, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a,
+	0x20, 0x22, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5d,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x50, 0x53,
+	0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x20, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x20, 0x28, 0x53, 0x29, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x70,
+	0x47, 0x50, 0x53, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x31, 0x36,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x38, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x67, 0x70, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x70, 0x61,
+	0x6e, 0x22, 0x3a, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x6d, 0x69, 0x6e, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6d, 0x61, 0x78,
+	0x22, 0x3a, 0x20, 0x32, 0x34, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x3a,
+	0x20, 0x33, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x22, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22,
+	0x3a, 0x20, 0x22, 0x4f, 0x66, 0x66, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x20, 0x22,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x49, 0x44, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x47, 0x70, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x3a, 0x20, 0x33, 0x32, 0x2c,
+	0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x69, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x3a, 0x20, 0x31, 0x36, 0x2c, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x67, 0x70, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65,
+	0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6c, 0x69,
+	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x0a, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x3a, 0x20, 0x30, 0x2c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x22, 0x3a, 0x20, 0x22, 0x4e, 0x6f, 0x6e, 0x65, 0x22, 0x0a, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x5d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20,
+	0x5d, 0x0a, 0x7d, 0x0a,
+}
+
+//go:generate genFileData
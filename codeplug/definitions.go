@@ -0,0 +1,472 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// codeplugInfos describes the codeplugs of all supported radios.  It is
+// loaded from the embedded codeplugs.json, and may be extended by
+// AddDefinitions.
+var codeplugInfos []*CodeplugInfo
+
+func init() {
+	cpis, err := parseDefinitions(codeplugs_json)
+	if err != nil {
+		logFatalf("codeplugs.json: %s", err.Error())
+	}
+	codeplugInfos = cpis
+}
+
+// The following types mirror the JSON format of codeplugs.json.
+
+type defTop struct {
+	Codeplugs []*defCodeplug `json:"codeplugs"`
+	Records   []*defRecord   `json:"records"`
+	Fields    []*defField    `json:"fields"`
+}
+
+type defCodeplug struct {
+	Models      []string `json:"models"`
+	Type        string   `json:"type"`
+	Ext         string   `json:"ext"`
+	RdtSize     int      `json:"rdtSize"`
+	BinSize     int      `json:"binSize"`
+	BinOffset   int      `json:"binOffset"`
+	RecordTypes []string `json:"recordTypes"`
+}
+
+type defRecord struct {
+	TypeName   string       `json:"typeName"`
+	Type       string       `json:"type"`
+	Offset     int          `json:"offset"`
+	Size       int          `json:"size"`
+	Max        int          `json:"max"`
+	DelDescs   []defDelDesc `json:"delDescs"`
+	FieldTypes []string     `json:"fieldTypes"`
+	NamePrefix string       `json:"namePrefix"`
+}
+
+type defDelDesc struct {
+	Offset int `json:"offset"`
+	Size   int `json:"size"`
+	Value  int `json:"value"`
+}
+
+type defField struct {
+	TypeName       string             `json:"typeName"`
+	Type           string             `json:"type"`
+	BitOffset      int                `json:"bitOffset"`
+	BitSize        int                `json:"bitSize"`
+	Max            int                `json:"max"`
+	ValueType      string             `json:"valueType"`
+	DefaultValue   string             `json:"defaultValue"`
+	Strings        []string           `json:"strings"`
+	Span           *defSpan           `json:"span"`
+	IndexedStrings []defIndexedString `json:"indexedStrings"`
+	ExtOffset      int                `json:"extOffset"`
+	ExtSize        int                `json:"extSize"`
+	ExtIndex       int                `json:"extIndex"`
+	ListType       string             `json:"listType"`
+	Enabling       *defEnabling       `json:"enabling"`
+}
+
+type defIndexedString struct {
+	Index  int    `json:"index"`
+	String string `json:"string"`
+}
+
+type defSpan struct {
+	Min       int    `json:"min"`
+	Max       int    `json:"max"`
+	Scale     int    `json:"scale"`
+	Interval  int    `json:"interval"`
+	MinString string `json:"minString"`
+}
+
+type defEnabling struct {
+	Value    string   `json:"value"`
+	Enables  []string `json:"enables"`
+	Disables []string `json:"disables"`
+}
+
+// AddDefinitions reads codeplug definitions, in the format of
+// codeplugs.json, and adds them to the supported codeplugs.  A
+// definition replaces any existing codeplug definition of the same type.
+func AddDefinitions(rdr io.Reader) error {
+	bytes, err := ioutil.ReadAll(rdr)
+	if err != nil {
+		return err
+	}
+
+	cpis, err := parseDefinitions(bytes)
+	if err != nil {
+		return err
+	}
+
+nextCpi:
+	for _, cpi := range cpis {
+		for i, existing := range codeplugInfos {
+			if existing.Type == cpi.Type {
+				codeplugInfos[i] = cpi
+				continue nextCpi
+			}
+		}
+		codeplugInfos = append(codeplugInfos, cpi)
+	}
+
+	return nil
+}
+
+// typeSuffixStripped returns the given definition type name without its
+// model suffix, if any.  "Channels_md380" becomes "Channels".
+func typeSuffixStripped(s string) string {
+	index := strings.LastIndex(s, "_")
+	if index > 0 {
+		s = s[:index]
+	}
+	return s
+}
+
+// defRecordType returns the RecordType of a definition record type name.
+func defRecordType(s string) RecordType {
+	return RecordType(typeSuffixStripped(s))
+}
+
+// defFieldType returns the FieldType of a definition field type name.
+// The two character record prefix is removed: "CiName" becomes "Name".
+func defFieldType(s string) FieldType {
+	s = typeSuffixStripped(s)
+	if len(s) < 2 {
+		return FieldType(s)
+	}
+	return FieldType(s[2:])
+}
+
+// parseDefinitions parses and validates the given codeplug definitions,
+// returning the described codeplugs.
+func parseDefinitions(bytes []byte) ([]*CodeplugInfo, error) {
+	var top defTop
+	err := json.Unmarshal(bytes, &top)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldInfos := make(map[string]*fieldInfo)
+	defFields := make(map[string]*defField)
+	for _, df := range top.Fields {
+		fi, err := newDefFieldInfo(df)
+		if err != nil {
+			return nil, err
+		}
+		if fieldInfos[df.Type] != nil {
+			return nil, fmt.Errorf("duplicate field type: %s", df.Type)
+		}
+		fieldInfos[df.Type] = fi
+		defFields[df.Type] = df
+	}
+
+	for _, df := range top.Fields {
+		if df.ListType == "" {
+			continue
+		}
+		found := false
+		for _, dr := range top.Records {
+			if dr.Type == df.ListType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("field %s: unknown list type: %s", df.Type, df.ListType)
+		}
+	}
+
+	recordInfos := make(map[string]*recordInfo)
+	for _, dr := range top.Records {
+		ri, err := newDefRecordInfo(dr, fieldInfos, defFields)
+		if err != nil {
+			return nil, err
+		}
+		if recordInfos[dr.Type] != nil {
+			return nil, fmt.Errorf("duplicate record type: %s", dr.Type)
+		}
+		recordInfos[dr.Type] = ri
+	}
+
+	cpis := make([]*CodeplugInfo, 0, len(top.Codeplugs))
+	for _, dc := range top.Codeplugs {
+		cpi, err := newDefCodeplugInfo(dc, recordInfos)
+		if err != nil {
+			return nil, err
+		}
+		for _, existing := range cpis {
+			if existing.Type == cpi.Type {
+				return nil, fmt.Errorf("duplicate codeplug type: %s", cpi.Type)
+			}
+		}
+		cpis = append(cpis, cpi)
+	}
+
+	return cpis, nil
+}
+
+// newDefFieldInfo returns the fieldInfo for a field definition.
+func newDefFieldInfo(df *defField) (*fieldInfo, error) {
+	errorf := func(format string, v ...interface{}) error {
+		return fmt.Errorf("field %s: %s", df.Type, fmt.Sprintf(format, v...))
+	}
+
+	if len(typeSuffixStripped(df.Type)) <= 2 {
+		return nil, errorf("bad field type name")
+	}
+
+	vt := ValueType(df.ValueType)
+	if newValue(vt) == nil {
+		return nil, errorf("unknown value type: %s", df.ValueType)
+	}
+
+	if df.BitSize <= 0 {
+		return nil, errorf("bitSize must be positive")
+	}
+	if df.BitSize < 8 {
+		if df.BitOffset/8 != (df.BitOffset+df.BitSize-1)/8 {
+			return nil, errorf("sub-byte field crosses a byte boundary")
+		}
+	} else if df.BitOffset%8 != 0 || df.BitSize%8 != 0 {
+		return nil, errorf("multi-byte field is not byte aligned")
+	}
+
+	max := df.Max
+	if max == 0 {
+		max = 1
+	}
+
+	fi := &fieldInfo{
+		fType:          defFieldType(df.Type),
+		typeName:       df.TypeName,
+		max:            max,
+		bitOffset:      df.BitOffset,
+		bitSize:        df.BitSize,
+		valueType:      vt,
+		defaultValue:   df.DefaultValue,
+		extOffset:      df.ExtOffset,
+		extSize:        df.ExtSize,
+		extIndex:       df.ExtIndex,
+		listRecordType: defRecordType(df.ListType),
+	}
+
+	if df.Strings != nil {
+		strs := append([]string{}, df.Strings...)
+		fi.strings = &strs
+	}
+
+	if df.IndexedStrings != nil {
+		iStrs := make([]IndexedString, len(df.IndexedStrings))
+		for i, is := range df.IndexedStrings {
+			if is.Index < 0 || is.Index > 0xffff {
+				return nil, errorf("bad indexed string index: %d", is.Index)
+			}
+			iStrs[i] = IndexedString{uint16(is.Index), is.String}
+		}
+		fi.indexedStrings = &iStrs
+	}
+
+	if df.Span != nil {
+		s := *df.Span
+		if s.MinString != "" {
+			s.Min = 0
+		}
+		for _, v := range []int{s.Min, s.Max, s.Scale, s.Interval} {
+			if v < 0 || v > 0xff {
+				return nil, errorf("span value out of range: %d", v)
+			}
+		}
+		if s.Min > s.Max {
+			return nil, errorf("span minimum exceeds maximum")
+		}
+		fi.span = &Span{
+			min:       uint8(s.Min),
+			max:       uint8(s.Max),
+			scale:     uint8(s.Scale),
+			interval:  uint8(s.Interval),
+			minString: s.MinString,
+		}
+	}
+
+	return fi, nil
+}
+
+// newDefRecordInfo returns the recordInfo for a record definition,
+// setting the enabling information of its fields.
+func newDefRecordInfo(dr *defRecord, fieldInfos map[string]*fieldInfo, defFields map[string]*defField) (*recordInfo, error) {
+	errorf := func(format string, v ...interface{}) error {
+		return fmt.Errorf("record %s: %s", dr.Type, fmt.Sprintf(format, v...))
+	}
+
+	max := dr.Max
+	if max == 0 {
+		max = 1
+	}
+
+	if dr.Offset < 0 || dr.Size <= 0 {
+		return nil, errorf("bad offset or size")
+	}
+
+	ri := &recordInfo{
+		rType:      defRecordType(dr.Type),
+		typeName:   dr.TypeName,
+		max:        max,
+		offset:     dr.Offset,
+		size:       dr.Size,
+		namePrefix: dr.NamePrefix,
+	}
+
+	for _, dd := range dr.DelDescs {
+		if dd.Offset < 0 || dd.Size <= 0 || dd.Offset+dd.Size > dr.Size ||
+			dd.Offset > 0xff || dd.Size > 0xff || dd.Value < 0 || dd.Value > 0xff {
+			return nil, errorf("bad delDesc")
+		}
+		ri.delDescs = append(ri.delDescs, delDesc{
+			offset: uint8(dd.Offset),
+			size:   uint8(dd.Size),
+			value:  byte(dd.Value),
+		})
+	}
+
+	for _, fType := range dr.FieldTypes {
+		fi := fieldInfos[fType]
+		if fi == nil {
+			return nil, errorf("unknown field type: %s", fType)
+		}
+
+		if fi.extIndex == 0 && fi.bitOffset+fi.bitSize*fi.max > dr.Size*8 {
+			return nil, errorf("field %s extends beyond the record", fType)
+		}
+
+		ri.fieldInfos = append(ri.fieldInfos, fi)
+
+		en := defFields[fType].Enabling
+		if en == nil {
+			continue
+		}
+
+		fi.enablingValue = en.Value
+
+		for _, enType := range en.Enables {
+			enFi := fieldInfos[enType]
+			if enFi == nil {
+				return nil, errorf("field %s enables unknown field type: %s", fType, enType)
+			}
+			enFi.enabler = fi.fType
+		}
+
+		for _, disType := range en.Disables {
+			disFi := fieldInfos[disType]
+			if disFi == nil {
+				return nil, errorf("field %s disables unknown field type: %s", fType, disType)
+			}
+			disFi.disabler = fi.fType
+		}
+	}
+
+	return ri, nil
+}
+
+// newDefCodeplugInfo returns the CodeplugInfo for a codeplug definition.
+func newDefCodeplugInfo(dc *defCodeplug, recordInfos map[string]*recordInfo) (*CodeplugInfo, error) {
+	errorf := func(format string, v ...interface{}) error {
+		return fmt.Errorf("codeplug %s: %s", dc.Type, fmt.Sprintf(format, v...))
+	}
+
+	if dc.Type == "" {
+		return nil, fmt.Errorf("codeplug without a type")
+	}
+
+	if len(dc.Models) == 0 {
+		return nil, errorf("no models")
+	}
+
+	if dc.BinOffset < 0 || dc.BinSize <= 0 || dc.BinOffset+dc.BinSize > dc.RdtSize {
+		return nil, errorf("bad rdtSize, binSize or binOffset")
+	}
+
+	if len(dc.RecordTypes) == 0 {
+		return nil, errorf("no record types")
+	}
+
+	cpi := &CodeplugInfo{
+		Type:      dc.Type,
+		Models:    append([]string{}, dc.Models...),
+		Ext:       dc.Ext,
+		RdtSize:   dc.RdtSize,
+		BinSize:   dc.BinSize,
+		BinOffset: dc.BinOffset,
+	}
+
+	for _, rType := range dc.RecordTypes {
+		ri := recordInfos[rType]
+		if ri == nil {
+			return nil, errorf("unknown record type: %s", rType)
+		}
+		ri = cloneRecordInfo(ri, len(cpi.RecordInfos))
+
+		if ri.offset+ri.size*ri.max > dc.RdtSize {
+			return nil, errorf("record %s extends beyond the codeplug", rType)
+		}
+
+		cpi.RecordInfos = append(cpi.RecordInfos, ri)
+	}
+
+	return cpi, nil
+}
+
+// cloneRecordInfo returns a copy of ri, and of its fieldInfos, for the
+// record type at the given index of a codeplug.  Record and field
+// types may be shared by several codeplug definitions, so each gets
+// its own copies, which are never modified once the definitions are
+// parsed.  Codeplugs of any types may then be loaded concurrently.
+func cloneRecordInfo(ri *recordInfo, index int) *recordInfo {
+	clone := *ri
+	clone.index = index
+	clone.fieldInfos = make([]*fieldInfo, len(ri.fieldInfos))
+	for i, fi := range ri.fieldInfos {
+		fiClone := *fi
+		fiClone.index = i
+		fiClone.recordInfo = &clone
+		if fi.valueType == VtName || fi.valueType == VtUniqueName {
+			clone.nameFieldType = fi.fType
+		}
+		clone.fieldInfos[i] = &fiClone
+	}
+
+	return &clone
+}
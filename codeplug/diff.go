@@ -0,0 +1,186 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Difference describes a record or a field that differs between two
+// codeplugs.
+type Difference struct {
+	// Record names the record, e.g. `Channels "Local 1"` for a named
+	// record or "GeneralSettings 1" for one without a name field.
+	Record string
+
+	// Field names the field that differs, or is empty when the record
+	// is present in only one of the codeplugs.
+	Field string
+
+	// A and B hold the values in the first and second codeplug.  For
+	// a record present in only one codeplug, the other is empty and
+	// the present one is "present".
+	A string
+	B string
+}
+
+func (d Difference) String() string {
+	if d.Field == "" {
+		if d.A == "" {
+			return d.Record + ": added"
+		}
+		return d.Record + ": removed"
+	}
+
+	return fmt.Sprintf("%s: %s: %s became %s",
+		d.Record, d.Field, strconv.Quote(d.A), strconv.Quote(d.B))
+}
+
+// Diff compares two loaded codeplugs record by record and returns their
+// differences.  Named records are matched by name, so reordering
+// channels or contacts is not reported as a difference; other records
+// are matched by index.
+func Diff(a, b *Codeplug) []Difference {
+	var diffs []Difference
+
+	rTypes := a.RecordTypes()
+	seen := make(map[RecordType]bool)
+	for _, rType := range rTypes {
+		seen[rType] = true
+	}
+	for _, rType := range b.RecordTypes() {
+		if !seen[rType] {
+			rTypes = append(rTypes, rType)
+		}
+	}
+
+	for _, rType := range rTypes {
+		aNames, aRecords := diffRecords(a, rType)
+		bNames, bRecords := diffRecords(b, rType)
+
+		for _, name := range aNames {
+			br := bRecords[name]
+			if br == nil {
+				diffs = append(diffs, Difference{
+					Record: name,
+					A:      "present",
+				})
+				continue
+			}
+			diffs = append(diffs, diffFields(name, aRecords[name], br)...)
+		}
+
+		for _, name := range bNames {
+			if aRecords[name] == nil {
+				diffs = append(diffs, Difference{
+					Record: name,
+					B:      "present",
+				})
+			}
+		}
+	}
+
+	return diffs
+}
+
+// diffRecords returns the names identifying the codeplug's records of
+// the given type, in order, and a map from those names to the records.
+func diffRecords(cp *Codeplug, rType RecordType) ([]string, map[string]*Record) {
+	var records []*Record
+	if cp.rDesc[rType] != nil {
+		records = cp.records(rType)
+	}
+
+	names := make([]string, 0, len(records))
+	byName := make(map[string]*Record)
+
+	for i, r := range records {
+		name := fmt.Sprintf("%s %d", r.TypeName(), i+1)
+		if r.NameField() != nil {
+			name = fmt.Sprintf("%s %s", r.TypeName(), strconv.Quote(r.Name()))
+			for n := 2; byName[name] != nil; n++ {
+				name = fmt.Sprintf("%s %s#%d", r.TypeName(), strconv.Quote(r.Name()), n)
+			}
+		}
+		names = append(names, name)
+		byName[name] = r
+	}
+
+	return names, byName
+}
+
+// diffFields returns the differences between the fields of two records
+// of the same type.
+func diffFields(name string, a, b *Record) []Difference {
+	var diffs []Difference
+
+	fTypes := a.FieldTypes()
+	seen := make(map[FieldType]bool)
+	for _, fType := range fTypes {
+		seen[fType] = true
+	}
+	for _, fType := range b.FieldTypes() {
+		if !seen[fType] {
+			fTypes = append(fTypes, fType)
+		}
+	}
+
+	for _, fType := range fTypes {
+		aFields := a.Fields(fType)
+		bFields := b.Fields(fType)
+		n := len(aFields)
+		if len(bFields) > n {
+			n = len(bFields)
+		}
+
+		for i := 0; i < n; i++ {
+			var aValue, bValue, fieldName string
+			if i < len(aFields) {
+				aValue = aFields[i].String()
+				fieldName = aFields[i].TypeName()
+			}
+			if i < len(bFields) {
+				bValue = bFields[i].String()
+				fieldName = bFields[i].TypeName()
+			}
+			if aValue == bValue {
+				continue
+			}
+			if n > 1 {
+				fieldName = fmt.Sprintf("%s[%d]", fieldName, i+1)
+			}
+			diffs = append(diffs, Difference{
+				Record: name,
+				Field:  fieldName,
+				A:      aValue,
+				B:      bValue,
+			})
+		}
+	}
+
+	return diffs
+}
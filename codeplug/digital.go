@@ -0,0 +1,108 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+)
+
+// AnalyzeDigitalChannels returns the likely typos in the codeplug's
+// digital channels.  Channels on the same repeater, that is the same
+// receive and transmit frequencies, are expected to share a color code
+// and to carry each talkgroup on a single slot.
+func (cp *Codeplug) AnalyzeDigitalChannels() []ChannelProblem {
+	var problems []ChannelProblem
+
+	var repeaters []string
+	channels := make(map[string][]*Record)
+	for _, ch := range cp.records(RtChannels_md380) {
+		if ch.Field(FtCiChannelMode).String() != "Digital" {
+			continue
+		}
+		key := ch.Field(FtCiRxFrequency).String() + "/" +
+			ch.Field(FtCiTxFrequency).String()
+		if channels[key] == nil {
+			repeaters = append(repeaters, key)
+		}
+		channels[key] = append(channels[key], ch)
+	}
+
+	for _, key := range repeaters {
+		chs := channels[key]
+
+		colorCode := mostCommonValue(chs, FtCiColorCode)
+		for _, ch := range chs {
+			cc := ch.Field(FtCiColorCode).String()
+			if cc != colorCode {
+				problems = append(problems, ChannelProblem{
+					Channel: ch.Name(),
+					Problem: fmt.Sprintf("color code %s differs from color code %s of the other channels on the repeater", cc, colorCode),
+				})
+			}
+		}
+
+		// The talkgroup is expected on the slot of its first channel.
+		slots := make(map[string]*Record)
+		for _, ch := range chs {
+			name := ch.Field(FtCiContactName).String()
+			contact := cp.FindRecordByName(RtContacts, name)
+			if contact == nil || contact.Field(FtDcCallType).String() != "Group" {
+				continue
+			}
+			talkgroup := contact.Field(FtDcCallID).String()
+			first := slots[talkgroup]
+			if first == nil {
+				slots[talkgroup] = ch
+				continue
+			}
+			slot := ch.Field(FtCiRepeaterSlot).String()
+			firstSlot := first.Field(FtCiRepeaterSlot).String()
+			if slot != firstSlot {
+				problems = append(problems, ChannelProblem{
+					Channel: ch.Name(),
+					Problem: fmt.Sprintf("talkgroup %s is on slot %s, but on slot %s in %s", talkgroup, slot, firstSlot, first.Name()),
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+// mostCommonValue returns the value of the field type that is most
+// common among the records, preferring the earliest on a tie.
+func mostCommonValue(records []*Record, fType FieldType) string {
+	counts := make(map[string]int)
+	value := ""
+	for _, r := range records {
+		s := r.Field(fType).String()
+		counts[s]++
+		if counts[s] > counts[value] {
+			value = s
+		}
+	}
+	return value
+}
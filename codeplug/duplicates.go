@@ -0,0 +1,203 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// channelKey returns a string identifying how the channel behaves on
+// the air: its mode and frequencies, and its color code, slot and
+// talkgroup if digital, or its bandwidth and tones if analog.
+func (cp *Codeplug) channelKey(ch *Record) string {
+	value := func(fType FieldType) string {
+		f := ch.Field(fType)
+		if f == nil {
+			return ""
+		}
+		return f.String()
+	}
+
+	mode := value(FtCiChannelMode)
+	key := []string{
+		mode,
+		value(FtCiRxFrequency),
+		value(FtCiTxFrequency),
+	}
+
+	if mode == "Digital" {
+		talkgroup := value(FtCiContactName)
+		contact := cp.FindRecordByName(RtContacts, talkgroup)
+		if contact != nil {
+			talkgroup = contact.Field(FtDcCallType).String() + " " +
+				contact.Field(FtDcCallID).String()
+		}
+		return strings.Join(append(key,
+			value(FtCiColorCode),
+			value(FtCiRepeaterSlot),
+			talkgroup), "\x00")
+	}
+
+	return strings.Join(append(key,
+		value(FtCiBandwidth),
+		value(FtCiCtcssEncode),
+		value(FtCiCtcssDecode)), "\x00")
+}
+
+// FindDuplicateChannels returns the names of the sets of channels that
+// behave identically on the air but have different names.  Each set is
+// in the order of the channels in the codeplug.
+func (cp *Codeplug) FindDuplicateChannels() [][]string {
+	var keys []string
+	names := make(map[string][]string)
+	for _, ch := range cp.records(RtChannels_md380) {
+		key := cp.channelKey(ch)
+		if names[key] == nil {
+			keys = append(keys, key)
+		}
+		names[key] = append(names[key], ch.Name())
+	}
+
+	var duplicates [][]string
+	for _, key := range keys {
+		if len(names[key]) > 1 {
+			duplicates = append(duplicates, names[key])
+		}
+	}
+
+	return duplicates
+}
+
+// MergeChannels replaces all references to the channels named by
+// others with references to the channel named keep, and then removes
+// the other channels.  A zone or scan list that held more than one of
+// the channels holds only keep, in the place of the first of them.
+// Nothing is changed if any of the other channels, or any field
+// referring to them, is locked.
+func (cp *Codeplug) MergeChannels(keep string, others []string) error {
+	if cp.FindRecordByName(RtChannels_md380, keep) == nil {
+		return fmt.Errorf("no channel named '%s'", keep)
+	}
+
+	merged := make(map[string]bool)
+	var removed []*Record
+	for _, name := range others {
+		if name == keep || merged[name] {
+			continue
+		}
+		ch := cp.FindRecordByName(RtChannels_md380, name)
+		if ch == nil {
+			return fmt.Errorf("no channel named '%s'", name)
+		}
+		merged[name] = true
+		removed = append(removed, ch)
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	for _, r := range removed {
+		err := r.CheckUnlocked()
+		if err != nil {
+			return err
+		}
+	}
+	err := cp.checkReferencesUnlocked(RtChannels_md380, merged)
+	if err != nil {
+		return err
+	}
+
+	// Member list references depend on the lists they refer to, so
+	// they are rewritten after the list references.
+	for _, valueType := range []ValueType{VtListIndex, VtMemberListIndex} {
+		for _, rd := range cp.rDesc {
+			for _, fi := range rd.fieldInfos {
+				if fi.listRecordType != RtChannels_md380 || fi.valueType != valueType {
+					continue
+				}
+				for _, r := range rd.records {
+					err := replaceChannelReferences(r, fi, keep, merged)
+					if err != nil {
+						return fmt.Errorf("%s %s: %s", r.TypeName(), r.Name(), err.Error())
+					}
+				}
+			}
+		}
+	}
+
+	change := cp.RemoveRecordsChange(removed)
+	for _, r := range removed {
+		cp.RemoveRecord(r)
+	}
+	change.Complete()
+
+	cp.changed = true
+
+	return nil
+}
+
+// replaceChannelReferences replaces the record's references to the
+// merged channels by references to keep.  In fields that may occur
+// more than once, only the first reference to any of the channels
+// is kept.
+func replaceChannelReferences(r *Record, fi *fieldInfo, keep string, merged map[string]bool) error {
+	seen := false
+	for _, f := range append([]*Field{}, r.Fields(fi.fType)...) {
+		name := f.String()
+		if name != keep && !merged[name] {
+			continue
+		}
+		if fi.max > 1 && seen {
+			r.RemoveField(f)
+			continue
+		}
+		seen = true
+		if name != keep {
+			err := f.setString(keep)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MergeDuplicateChannels merges each set of channels returned by
+// FindDuplicateChannels into the first channel of the set.  It returns
+// the sets that were merged.
+func (cp *Codeplug) MergeDuplicateChannels() ([][]string, error) {
+	duplicates := cp.FindDuplicateChannels()
+	for _, names := range duplicates {
+		err := cp.MergeChannels(names[0], names[1:])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return duplicates, nil
+}
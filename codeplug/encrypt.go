@@ -0,0 +1,208 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io/fs"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// An encrypted codeplug file starts with encryptedMagic, followed by
+// the PBKDF2 salt, the PBKDF2 iteration count as a big-endian uint32,
+// the AES-GCM nonce and finally the sealed contents.  The header up to
+// the sealed contents is authenticated along with them.
+const (
+	encryptedMagic      = "CODEPLUG-ENCRYPTED-1\n"
+	encryptedSaltSize   = 16
+	encryptedNonceSize  = 12
+	encryptedIterations = 600000
+	encryptedHeaderSize = len(encryptedMagic) + encryptedSaltSize + 4 + encryptedNonceSize
+)
+
+// IsEncrypted returns true if data is the contents of a file written
+// by Encrypt.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(encryptedMagic))
+}
+
+// IsEncryptedFile returns true if the named file was written by
+// ExportEncrypted.
+func IsEncryptedFile(filename string) bool {
+	file, err := vfs.OS.Open(filename)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	magic := make([]byte, len(encryptedMagic))
+	_, err = file.Read(magic)
+	if err != nil {
+		return false
+	}
+
+	return IsEncrypted(magic)
+}
+
+// encryptionCipher returns the AES-256-GCM cipher keyed by password
+// and salt.
+func encryptionCipher(password string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(password), salt, iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Encrypt returns plaintext encrypted with a key derived from password.
+func Encrypt(plaintext []byte, password string) ([]byte, error) {
+	if password == "" {
+		return nil, fmt.Errorf("empty password")
+	}
+
+	header := make([]byte, encryptedHeaderSize)
+	copy(header, encryptedMagic)
+	salt := header[len(encryptedMagic) : len(encryptedMagic)+encryptedSaltSize]
+	iterations := header[len(encryptedMagic)+encryptedSaltSize : len(encryptedMagic)+encryptedSaltSize+4]
+	nonce := header[encryptedHeaderSize-encryptedNonceSize:]
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	binary.BigEndian.PutUint32(iterations, encryptedIterations)
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := encryptionCipher(password, salt, encryptedIterations)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(header, nonce, plaintext, header), nil
+}
+
+// Decrypt returns the plaintext of data written by Encrypt.  An error
+// is returned if the password is wrong or data has been altered.
+func Decrypt(data []byte, password string) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, fmt.Errorf("not an encrypted codeplug")
+	}
+	if len(data) < encryptedHeaderSize {
+		return nil, fmt.Errorf("encrypted codeplug is truncated")
+	}
+
+	header := data[:encryptedHeaderSize]
+	salt := header[len(encryptedMagic) : len(encryptedMagic)+encryptedSaltSize]
+	iterations := binary.BigEndian.Uint32(header[len(encryptedMagic)+encryptedSaltSize:])
+	nonce := header[encryptedHeaderSize-encryptedNonceSize:]
+
+	if iterations == 0 || iterations > 100*encryptedIterations {
+		return nil, fmt.Errorf("bad encrypted codeplug header")
+	}
+
+	aead, err := encryptionCipher(password, salt, int(iterations))
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, nonce, data[encryptedHeaderSize:], header)
+	if err != nil {
+		return nil, fmt.Errorf("wrong password or corrupted file")
+	}
+
+	return plaintext, nil
+}
+
+// ExportEncrypted writes the codeplug as a JSON file encrypted with a
+// key derived from password.  The file may be imported with
+// NewEncryptedCodeplug.
+func (cp *Codeplug) ExportEncrypted(filename string, password string) (err error) {
+	var plaintext bytes.Buffer
+	err = cp.WriteJSON(&plaintext)
+	if err != nil {
+		return err
+	}
+
+	data, err := Encrypt(plaintext.Bytes(), password)
+	if err != nil {
+		return err
+	}
+
+	file, err := vfs.CreatePerm(cp.fsys, filename, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+	}()
+
+	_, err = file.Write(data)
+	return err
+}
+
+// NewEncryptedCodeplug returns a Codeplug to be imported from a file
+// written by ExportEncrypted, given the password used to write it.
+// The decrypted contents may be either a JSON or text codeplug.
+func NewEncryptedCodeplug(filename string, password string) (*Codeplug, error) {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := Decrypt(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	fType := FileTypeText
+	if bytes.HasPrefix(bytes.TrimSpace(plaintext), []byte("{")) {
+		fType = FileTypeJSON
+	}
+
+	cp, err := NewCodeplug(fType, filename)
+	if err != nil {
+		return nil, err
+	}
+	cp.importData = plaintext
+
+	return cp, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"sort"
+	"unicode"
+)
+
+// A record may carry extras: data for radios whose codeplugs hold more
+// than the common model, such as the photo or further details that
+// some Anytone firmwares keep for each contact.  Extras are not stored
+// in the codeplugs of the radios supported here.  They are saved in,
+// and read from, text and JSON files as pseudo-fields whose names are
+// ExtraFieldPrefix followed by the extra's key, as in "ExtraPhoto", so
+// that they are kept for the tools writing those radios' codeplugs.
+const ExtraFieldPrefix = "Extra"
+
+// Extra returns the value of the record's extra of the given key, and
+// whether it has one.
+func (r *Record) Extra(key string) (string, bool) {
+	value, ok := r.extras[key]
+	return value, ok
+}
+
+// ExtraKeys returns the keys of the record's extras, sorted.
+func (r *Record) ExtraKeys() []string {
+	keys := make([]string, 0, len(r.extras))
+	for key := range r.extras {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// SetExtra sets the record's extra of the given key, which is made of
+// letters and digits and begins with a capital letter, as in "Photo".
+func (r *Record) SetExtra(key string, value string) error {
+	if !validExtraKey(key) {
+		return fmt.Errorf("bad extra key: %q", key)
+	}
+
+	if r.extras == nil {
+		r.extras = make(map[string]string)
+	}
+	r.extras[key] = value
+
+	return nil
+}
+
+// RemoveExtra removes the record's extra of the given key, if it has
+// one.
+func (r *Record) RemoveExtra(key string) {
+	delete(r.extras, key)
+}
+
+// validExtraKey returns true if key may name an extra, and so, after
+// ExtraFieldPrefix, a pseudo-field in the text format.
+func validExtraKey(key string) bool {
+	for i, c := range key {
+		if i == 0 && !unicode.IsUpper(c) {
+			return false
+		}
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			return false
+		}
+	}
+
+	return key != ""
+}
+
+// isExtraFieldName returns true if name names an extra pseudo-field.
+func isExtraFieldName(name string) bool {
+	if len(name) <= len(ExtraFieldPrefix) || name[:len(ExtraFieldPrefix)] != ExtraFieldPrefix {
+		return false
+	}
+
+	return validExtraKey(name[len(ExtraFieldPrefix):])
+}
+
+// setExtraField sets one of the record's extras from the value of an
+// extra pseudo-field.
+func (r *Record) setExtraField(name string, value string) error {
+	return r.SetExtra(name[len(ExtraFieldPrefix):], value)
+}
+
+// extraFields returns the names and values of the record's extra
+// pseudo-fields, in order of key.
+func (r *Record) extraFields() (names []string, values []string) {
+	for _, key := range r.ExtraKeys() {
+		names = append(names, ExtraFieldPrefix+key)
+		values = append(values, r.extras[key])
+	}
+
+	return names, values
+}
+
+// copyExtras returns a copy of extras.
+func copyExtras(extras map[string]string) map[string]string {
+	if extras == nil {
+		return nil
+	}
+
+	extrasCopy := make(map[string]string, len(extras))
+	for key, value := range extras {
+		extrasCopy[key] = value
+	}
+
+	return extrasCopy
+}
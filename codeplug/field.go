@@ -31,6 +31,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 )
@@ -142,7 +143,12 @@ func (f *Field) SetString(str string) error {
 		return nil
 	}
 
-	err := f.setString(str)
+	err := f.CheckUnlocked()
+	if err != nil {
+		return err
+	}
+
+	err = f.setString(str)
 	if err == nil {
 		change := f.Change(previousString)
 		change.Complete()
@@ -187,7 +193,7 @@ func (f *Field) memberListNames() []string {
 	r := f.record
 	fieldInfos := r.fieldInfos
 	fieldInfo := fieldInfos[len(fieldInfos)-1]
-	fDesc := (*r.fDesc)[fieldInfo.fType]
+	fDesc := r.fDescs()[fieldInfo.fType]
 	var fields []*Field
 	if fDesc != nil {
 		fields = fDesc.fields
@@ -380,7 +386,7 @@ func (f *Field) ListRecordType() RecordType {
 
 // sibling returns the field's sibling field of the given type.
 func (f *Field) sibling(fType FieldType) *Field {
-	r := (*f.record.fDesc)[fType]
+	r := f.record.fDescs()[fType]
 	if r == nil {
 		return nil
 	}
@@ -960,38 +966,99 @@ func (v *callType) setString(f *Field, s string) error {
 
 	callIdField := f.record.Field(FtDcCallID)
 	if callIdField != nil {
-		callIdField.SetString("16777215")
+		callIdField.SetString(MaxDmrID.String())
+	}
+
+	return nil
+}
+
+// DmrID is a DMR subscriber or talkgroup ID.
+type DmrID uint32
+
+// MaxDmrID is the largest valid DMR ID.  It is also the ID used by
+// contacts with call type All.
+const MaxDmrID DmrID = 16777215
+
+// ParseDmrID returns the DmrID represented by the decimal string s.
+// Surrounding space and a leading "#", as used by some user database
+// sources, are ignored.
+func ParseDmrID(s string) (DmrID, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	val, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("must be a positive integer")
 	}
 
+	if val > uint64(MaxDmrID) {
+		return 0, fmt.Errorf("must be less than %d", MaxDmrID+1)
+	}
+
+	return DmrID(val), nil
+}
+
+// String returns the DmrID as a decimal string.
+func (id DmrID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// MarshalText implements encoding.TextMarshaler, so that a DmrID is
+// encoded as a decimal string.
+func (id DmrID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (id *DmrID) UnmarshalText(text []byte) error {
+	val, err := ParseDmrID(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid DMR ID %q: %s", text, err.Error())
+	}
+	*id = val
+
 	return nil
 }
 
+// DmrID returns the value of a call ID field as a DmrID.
+func (f *Field) DmrID() (DmrID, error) {
+	v, ok := f.value.(*callID)
+	if !ok {
+		return 0, fmt.Errorf("%s is not a call ID", f.FullTypeName())
+	}
+
+	return DmrID(*v), nil
+}
+
+// SetDmrID sets the value of a call ID field, recording a change.
+func (f *Field) SetDmrID(id DmrID) error {
+	if _, ok := f.value.(*callID); !ok {
+		return fmt.Errorf("%s is not a call ID", f.FullTypeName())
+	}
+
+	return f.SetString(id.String())
+}
+
 // callID is a field value representing a DMR Call ID
-type callID int32
+type callID DmrID
 
 // String returns the callID's value as a string.
 func (v *callID) getString(f *Field) string {
-	return fmt.Sprintf("%d", int(*v))
+	return DmrID(*v).String()
 }
 
 // setString sets the callID's value from a string.
 func (v *callID) setString(f *Field, s string) error {
 	callTypeField := f.record.Field(FtDcCallType)
 	if callTypeField != nil && callTypeField.String() == "All" {
-		if s != "16777215" {
-			return fmt.Errorf("call type All requires call ID 16777215")
+		if s != MaxDmrID.String() {
+			return fmt.Errorf("call type All requires call ID %d", MaxDmrID)
 		}
 	}
-	val, err := strconv.ParseUint(s, 10, 64)
+	id, err := ParseDmrID(s)
 	if err != nil {
-		return fmt.Errorf("must be a positive integer")
-	}
-
-	if val >= 16777216 {
-		return fmt.Errorf("must be less than 16777216")
+		return err
 	}
 
-	*v = callID(val)
+	*v = callID(id)
 
 	return nil
 }
@@ -1258,19 +1325,30 @@ type privacyNumber struct {
 	span
 }
 
-// String returns the privacyNumber's value as a string.
-func (v *privacyNumber) getString(f *Field) string {
+// clamped returns the privacyNumber's value, limited to 7 for enhanced
+// privacy.  The value itself is left unchanged, so reading it never
+// modifies the codeplug.
+func (v *privacyNumber) clamped(f *Field) span {
 	ss := f.sibling(FtCiPrivacy).String()
 
-	value := int(v.span)
-	if ss == "Enhanced" && value >= 8 {
-		value = 7
+	if ss == "Enhanced" && int(v.span) >= 8 {
+		return span(7)
 	}
 
-	s := v.span.getString(f)
-	v.span = span(value)
+	return v.span
+}
 
-	return s
+// String returns the privacyNumber's value as a string.
+func (v *privacyNumber) getString(f *Field) string {
+	value := v.clamped(f)
+
+	return value.getString(f)
+}
+
+// store stores the privacyNumber's value into its bits in cp.bytes.
+func (v *privacyNumber) store(f *Field) {
+	value := v.clamped(f)
+	value.store(f)
 }
 
 // setString sets the privacyNumber's value from a string.
@@ -1666,7 +1744,7 @@ func (v *cpsVersion) valid(f *Field) error {
 func (v *cpsVersion) load(f *Field) {
 	s := ""
 	for _, b := range f.bytes() {
-		s += string(int('0') + int(b))
+		s += string(rune(int('0') + int(b)))
 	}
 	*v = cpsVersion(s)
 }
@@ -1699,12 +1777,15 @@ type invalidValue struct {
 }
 
 var cachedCtcssDcsStrings []string
+var ctcssDcsStringsOnce sync.Once
 
 func ctcssDcsStrings() []string {
-	if cachedCtcssDcsStrings != nil {
-		return cachedCtcssDcsStrings
-	}
+	ctcssDcsStringsOnce.Do(buildCtcssDcsStrings)
+
+	return cachedCtcssDcsStrings
+}
 
+func buildCtcssDcsStrings() {
 	count := len(ctcssFrequencies) + 2*len(dcsCodes) + 1
 	cachedCtcssDcsStrings = make([]string, count)
 
@@ -1727,8 +1808,6 @@ func ctcssDcsStrings() []string {
 		cachedCtcssDcsStrings[i] = fmt.Sprintf("D%03dI", c)
 		i++
 	}
-
-	return cachedCtcssDcsStrings
 }
 
 var dcsCodes = [...]int{
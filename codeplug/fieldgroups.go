@@ -0,0 +1,137 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+// A FieldGroup is a named group of related field types of a record
+// type, such as the display settings among the general settings.
+// Field groups only affect how records are presented; they do not
+// change the codeplug's layout.
+type FieldGroup struct {
+	Name       string
+	FieldTypes []FieldType
+}
+
+// OtherFieldGroup names the group holding any fields of a grouped
+// record type that are not in one of its groups.
+const OtherFieldGroup = "Other"
+
+var fieldGroups = map[RecordType][]FieldGroup{
+	RtGeneralSettings_md380: {
+		{"Display", []FieldType{
+			FtGsIntroScreen,
+			FtGsIntroScreenLine1,
+			FtGsIntroScreenLine2,
+			FtGsBacklightColor,
+			FtGsBacklightTime,
+			FtGsDisableAllLeds,
+			FtGsFreqChannelMode,
+			FtGsModeSelect,
+			FtGsMode,
+		}},
+		{"Audio", []FieldType{
+			FtGsDisableAllTones,
+			FtGsChFreeIndicationTone,
+			FtGsTalkPermitTone,
+			FtGsCallAlertToneDuration,
+			FtGsVoxSensitivity,
+			FtGsMonitorType,
+		}},
+		{"Power On", []FieldType{
+			FtGsPwAndLockEnable,
+			FtGsPowerOnPassword,
+			FtGsSavePreamble,
+			FtGsSaveModeReceive,
+			FtGsRxLowBatteryInterval,
+		}},
+		{"DMR Identity", []FieldType{
+			FtGsRadioName,
+			FtGsRadioID,
+		}},
+		{"Calls", []FieldType{
+			FtGsTxPreambleDuration,
+			FtGsGroupCallHangTime,
+			FtGsPrivateCallHangTime,
+		}},
+		{"Scan", []FieldType{
+			FtGsScanDigitalHangTime,
+			FtGsScanAnalogHangTime,
+		}},
+		{"Lone Worker", []FieldType{
+			FtGsLoneWorkerResponseTime,
+			FtGsLoneWorkerReminderTime,
+		}},
+		{"Keypad and Passwords", []FieldType{
+			FtGsLockUnlock,
+			FtGsSetKeypadLockTime,
+			FtGsPcProgPassword,
+			FtGsRadioProgPassword,
+		}},
+	},
+}
+
+// FieldGroups returns the field groups of the given record type,
+// holding only the field types the codeplug's model has.  Fields in
+// no group are returned in a final group named OtherFieldGroup.  nil
+// is returned if the record type is not divided into groups.
+func (cp *Codeplug) FieldGroups(rType RecordType) []FieldGroup {
+	groups := fieldGroups[rType]
+	rd := cp.rDesc[rType]
+	if groups == nil || rd == nil {
+		return nil
+	}
+
+	present := make(map[FieldType]bool)
+	for _, fi := range rd.fieldInfos {
+		present[fi.fType] = true
+	}
+
+	grouped := make(map[FieldType]bool)
+	var fGroups []FieldGroup
+	for _, group := range groups {
+		var fTypes []FieldType
+		for _, fType := range group.FieldTypes {
+			if present[fType] {
+				fTypes = append(fTypes, fType)
+				grouped[fType] = true
+			}
+		}
+		if len(fTypes) != 0 {
+			fGroups = append(fGroups, FieldGroup{group.Name, fTypes})
+		}
+	}
+
+	var others []FieldType
+	for _, fi := range rd.fieldInfos {
+		if !grouped[fi.fType] {
+			others = append(others, fi.fType)
+		}
+	}
+	if len(others) != 0 {
+		fGroups = append(fGroups, FieldGroup{OtherFieldGroup, others})
+	}
+
+	return fGroups
+}
@@ -0,0 +1,287 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// FixOptions adjusts the fixes made by Fix.
+type FixOptions struct {
+	// MaxNameLength, if non-zero, is the length to which longer
+	// record names are shortened, as for radios displaying fewer
+	// characters than they store.
+	MaxNameLength int
+}
+
+// A Fix is a field changed or removed by Fix, or that Fix would have
+// changed but for a lock or a conflict.
+type Fix struct {
+	// Kind is "reference", "tone", "name" or "policy".
+	Kind string `json:"kind"`
+
+	// Field is the full type name of the field.
+	Field string `json:"field"`
+
+	From string `json:"from"`
+	To   string `json:"to,omitempty"`
+
+	// Removed is true if the field, a member of a list, was removed.
+	Removed bool `json:"removed,omitempty"`
+
+	// NotFixed, if non-empty, is why the field was left alone.
+	NotFixed string `json:"notFixed,omitempty"`
+}
+
+// String returns a description of the fix.
+func (fix *Fix) String() string {
+	from := quoteString(fix.From)
+	if fix.From == "" {
+		// Missing records and invalid values have no name.
+		from = "invalid value"
+		if fix.Kind == "reference" {
+			from = "missing record"
+		}
+	}
+
+	change := fmt.Sprintf("%s -> %s", from, quoteString(fix.To))
+	if fix.Removed {
+		change = fmt.Sprintf("removed %s", from)
+	}
+
+	if fix.NotFixed != "" {
+		return fmt.Sprintf("%s: not fixed (%s), %s: %s", fix.Field, fix.NotFixed, fix.Kind, change)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", fix.Field, fix.Kind, change)
+}
+
+// Fix applies the fixes that don't need a person's judgment, returning
+// them along with those it couldn't apply:
+//
+//	references to missing records are set to None, or removed from
+//	lists such as a zone's channels;
+//
+//	invalid CTCSS/DCS tones, and tones on channels not using them,
+//	are set to None;
+//
+//	spaces are trimmed from record names, and names longer than
+//	options.MaxNameLength, if set, are shortened as by ShortenName;
+//
+//	fields violating the codeplug's policies are set to the values
+//	required, as by FixPolicyViolations.
+//
+// Names that would duplicate another record's are given a numeric
+// suffix.  Locked fields are left alone.
+func (cp *Codeplug) Fix(options FixOptions) ([]Fix, error) {
+	var fixes []Fix
+
+	set := func(kind string, f *Field, value string) error {
+		fix := Fix{
+			Kind:  kind,
+			Field: f.FullTypeName(),
+			From:  f.String(),
+			To:    value,
+		}
+		if f.CheckUnlocked() != nil {
+			fix.NotFixed = "locked"
+			fixes = append(fixes, fix)
+			return nil
+		}
+		err := f.setString(value)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fix.Field, err.Error())
+		}
+		cp.changed = true
+		fixes = append(fixes, fix)
+		return nil
+	}
+
+	for _, rType := range cp.RecordTypes() {
+		for _, r := range cp.records(rType) {
+			for _, fType := range r.FieldTypes() {
+				fields := r.Fields(fType)
+				for i := len(fields) - 1; i >= 0; i-- {
+					f := fields[i]
+					var err error
+					switch f.ValueType() {
+					case VtListIndex, VtGpsListIndex, VtMemberListIndex:
+						err = cp.fixReference(f, set, &fixes)
+					case VtCtcssDcs:
+						err = cp.fixTone(f, set)
+					}
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	for _, rType := range cp.RecordTypes() {
+		for _, r := range cp.records(rType) {
+			err := cp.fixName(r, options.MaxNameLength, set, &fixes)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	violations := cp.PolicyViolations()
+	from := make([]string, len(violations))
+	for i, v := range violations {
+		from[i] = v.Field.String()
+	}
+	remaining, err := cp.FixPolicyViolations()
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range violations {
+		fix := Fix{
+			Kind:  "policy",
+			Field: v.Field.FullTypeName(),
+			From:  from[i],
+			To:    v.Required,
+		}
+		for _, r := range remaining {
+			if r.Field == v.Field && r.Policy == v.Policy {
+				fix.NotFixed = "locked or conflicting policies"
+				break
+			}
+		}
+		if fix.NotFixed == "" && v.Field.String() != v.Required {
+			// Fixed for one policy, then changed for another.
+			continue
+		}
+		fixes = append(fixes, fix)
+	}
+
+	return fixes, nil
+}
+
+// fixReference sets the field to None, or removes it from its list, if
+// it refers to a missing record.
+func (cp *Codeplug) fixReference(f *Field, set func(string, *Field, string) error, fixes *[]Fix) error {
+	value := f.String()
+	if value != "" && f.IsValid() {
+		return nil
+	}
+
+	if f.MaxFields() > 1 {
+		fix := Fix{
+			Kind:    "reference",
+			Field:   f.FullTypeName(),
+			From:    value,
+			Removed: true,
+		}
+		if f.CheckUnlocked() != nil {
+			fix.NotFixed = "locked"
+		} else {
+			f.record.RemoveField(f)
+			cp.changed = true
+		}
+		*fixes = append(*fixes, fix)
+		return nil
+	}
+
+	for _, is := range f.IndexedStrings() {
+		if is.Index == 0 {
+			return set("reference", f, is.String)
+		}
+	}
+
+	*fixes = append(*fixes, Fix{
+		Kind:     "reference",
+		Field:    f.FullTypeName(),
+		From:     value,
+		NotFixed: "no None value",
+	})
+
+	return nil
+}
+
+// fixTone sets the field to None if its tone is invalid or the
+// channel's mode doesn't use it.
+func (cp *Codeplug) fixTone(f *Field, set func(string, *Field, string) error) error {
+	if f.IsValid() && f.value.valid(f) == nil && (f.IsEnabled() || f.String() == "None") {
+		return nil
+	}
+
+	return set("tone", f, "None")
+}
+
+// fixName trims spaces from the record's name and shortens it to at
+// most maxLen characters, if maxLen is non-zero.  A numeric suffix is
+// added to a name that would duplicate another record's.
+func (cp *Codeplug) fixName(r *Record, maxLen int, set func(string, *Field, string) error, fixes *[]Fix) error {
+	f := r.NameField()
+	if f == nil {
+		return nil
+	}
+
+	name := f.String()
+	fixed := strings.Join(strings.Fields(name), " ")
+	if maxLen > 0 && utf8.RuneCountInString(fixed) > maxLen {
+		fixed = ShortenName(fixed, maxLen, cp.Abbreviations())
+	}
+	if fixed == name || fixed == "" {
+		return nil
+	}
+
+	notFixed := func(why string) error {
+		*fixes = append(*fixes, Fix{
+			Kind:     "name",
+			Field:    f.FullTypeName(),
+			From:     name,
+			To:       fixed,
+			NotFixed: why,
+		})
+		return nil
+	}
+
+	if maxLen > 0 && utf8.RuneCountInString(fixed) > maxLen {
+		return notFixed("can't be shortened")
+	}
+
+	if cp.FindRecordByName(r.rType, fixed) != nil {
+		policy, err := cp.NewNamingPolicy(r.rType, "{name}")
+		if err != nil {
+			return err
+		}
+		if maxLen > 0 && maxLen < policy.MaxLength {
+			policy.MaxLength = maxLen
+		}
+		unique, err := policy.Name(map[string]string{"name": fixed})
+		if err != nil {
+			return notFixed(err.Error())
+		}
+		fixed = unique
+	}
+
+	return set("name", f, fixed)
+}
@@ -0,0 +1,110 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// A flash dump, as written by md380tools' flashdump or by dmrRadio
+// dumpSPIFlash, is an image of a radio's entire SPI flash.  Its
+// codeplug is at the start, laid out as in a .bin file, so a flash dump
+// is loaded as a .bin file of the chosen model.  Radios with 16MB of
+// flash hold the md380tools users database at UsersFlashOffset.
+
+// UsersFlashOffset is the SPI flash offset of the md380tools users
+// database.
+const UsersFlashOffset = 0x100000
+
+// FlashDumpSizes holds the sizes of the SPI flash parts of the
+// supported radios.
+var FlashDumpSizes = []int{1024 * 1024, 16 * 1024 * 1024}
+
+// isFlashDumpSize returns true if a file of the given size is a flash
+// dump.
+func isFlashDumpSize(size int64) bool {
+	for _, s := range FlashDumpSizes {
+		if size == int64(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFlashDump returns true if the codeplug was loaded from a flash dump.
+func (cp *Codeplug) IsFlashDump() bool {
+	return cp.flashDump
+}
+
+// setFlashDumpRegion sets the region of a flash dump holding the
+// codeplug of the chosen model.
+func (cp *Codeplug) setFlashDumpRegion() {
+	cp.fileSize = cp.codeplugInfo.BinSize
+	cp.fileOffset = cp.codeplugInfo.BinOffset
+	cp.rdtSize = cp.codeplugInfo.RdtSize
+}
+
+// checkFlashDumpRegion returns an error if the codeplug region of a
+// flash dump is erased.
+func (cp *Codeplug) checkFlashDumpRegion() error {
+	region := cp.bytes[cp.fileOffset : cp.fileOffset+cp.fileSize]
+	for _, b := range region {
+		if b != 0xff {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s: flash dump holds no codeplug", cp.filename)
+}
+
+// FlashDumpUsers returns the md380tools users database held in a flash
+// dump, in the format written by userdb's WriteMD380ToolsFile.
+func FlashDumpUsers(dump []byte) ([]byte, error) {
+	if !isFlashDumpSize(int64(len(dump))) {
+		return nil, fmt.Errorf("not a flash dump: %d bytes", len(dump))
+	}
+	if len(dump) <= UsersFlashOffset {
+		return nil, fmt.Errorf("a %d byte flash holds no users database", len(dump))
+	}
+
+	// The database begins with a line giving the length of the
+	// user lines that follow.
+	data := dump[UsersFlashOffset:]
+	noUsers := fmt.Errorf("flash dump holds no users database")
+	i := bytes.IndexByte(data, '\n')
+	if i <= 0 || i > 10 {
+		return nil, noUsers
+	}
+	length, err := strconv.Atoi(string(data[:i]))
+	if err != nil || length <= 0 || i+1+length > len(data) {
+		return nil, noUsers
+	}
+
+	return data[:i+1+length], nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+)
+
+// findContact returns the contact with the given call ID and call type,
+// or nil if there is none.
+func (cp *Codeplug) findContact(id DmrID, private bool) *Record {
+	callType := "Group"
+	if private {
+		callType = "Private"
+	}
+
+	for _, r := range cp.records(RtContacts) {
+		if r.Field(FtDcCallType).String() == callType &&
+			r.Field(FtDcCallID).String() == id.String() {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// addContact adds a contact with the given name for the talkgroup.
+func (cp *Codeplug) addContact(name string, tg Talkgroup) (*Record, error) {
+	callType := "Group"
+	if tg.Private {
+		callType = "Private"
+	}
+
+	return cp.addRecord(RtContacts, []fieldValue{
+		{FtDcName, name},
+		{FtDcCallType, callType},
+		{FtDcCallID, tg.ID.String()},
+		{FtDcCallReceiveTone, "No"},
+	})
+}
+
+// digitalChannel describes a digital channel to be added to a codeplug.
+type digitalChannel struct {
+	name          string
+	rxFrequency   float64
+	txFrequency   float64
+	colorCode     int
+	slot          int
+	admitCriteria string
+	contact       string
+	groupList     string
+}
+
+// addDigitalChannel adds the described channel to the codeplug.
+func (cp *Codeplug) addDigitalChannel(ch digitalChannel) error {
+	_, err := cp.addRecord(RtChannels_md380, []fieldValue{
+		{FtCiName, ch.name},
+		{FtCiChannelMode, "Digital"},
+		{FtCiRxFrequency, frequencyToString(ch.rxFrequency)},
+		{FtCiTxFrequency, frequencyToString(ch.txFrequency)},
+		{FtCiBandwidth, "12.5"},
+		{FtCiPower, "Low"},
+		{FtCiRxOnly, "Off"},
+		{FtCiAutoscan, "Off"},
+		{FtCiScanList_md380, "None"},
+		{FtCiAdmitCriteria, ch.admitCriteria},
+		{FtCiColorCode, fmt.Sprint(ch.colorCode)},
+		{FtCiRepeaterSlot, fmt.Sprint(ch.slot)},
+		{FtCiPrivacy, "None"},
+		{FtCiContactName, ch.contact},
+		{FtCiGroupList, ch.groupList},
+	})
+
+	return err
+}
+
+// addZones adds zones, named by policy, containing the named channels.
+// As many zones as are needed to hold the channels are added, unless
+// they won't all fit, when none are.
+func (cp *Codeplug) addZones(policy *NamingPolicy, vars map[string]string, channelNames []string) error {
+	maxZoneChannels := cp.maxFields(RtZones_md380, FtZiChannel_md380)
+	zones := (len(channelNames) + maxZoneChannels - 1) / maxZoneChannels
+	if len(cp.records(RtZones_md380))+zones > cp.MaxRecords(RtZones_md380) {
+		return fmt.Errorf("too many %s", RtZones_md380)
+	}
+
+	for len(channelNames) > 0 {
+		n := len(channelNames)
+		if n > maxZoneChannels {
+			n = maxZoneChannels
+		}
+
+		name, err := policy.Name(vars)
+		if err != nil {
+			return err
+		}
+		fvs := []fieldValue{{FtZiName, name}}
+		for _, chName := range channelNames[:n] {
+			fvs = append(fvs, fieldValue{FtZiChannel_md380, chName})
+		}
+		_, err = cp.addRecord(RtZones_md380, fvs)
+		if err != nil {
+			return err
+		}
+
+		channelNames = channelNames[n:]
+	}
+
+	return nil
+}
+
+// analogChannel describes an analog channel to be added to a codeplug.
+type analogChannel struct {
+	name        string
+	rxFrequency float64
+	txFrequency float64
+	bandwidth   string
+	ctcssEncode string
+	ctcssDecode string
+}
+
+// addAnalogChannel adds the described channel to the codeplug.
+func (cp *Codeplug) addAnalogChannel(ch analogChannel) error {
+	ctcssEncode := ch.ctcssEncode
+	if ctcssEncode == "" {
+		ctcssEncode = "None"
+	}
+	ctcssDecode := ch.ctcssDecode
+	if ctcssDecode == "" {
+		ctcssDecode = "None"
+	}
+
+	_, err := cp.addRecord(RtChannels_md380, []fieldValue{
+		{FtCiName, ch.name},
+		{FtCiChannelMode, "Analog"},
+		{FtCiRxFrequency, frequencyToString(ch.rxFrequency)},
+		{FtCiTxFrequency, frequencyToString(ch.txFrequency)},
+		{FtCiBandwidth, ch.bandwidth},
+		{FtCiPower, "High"},
+		{FtCiRxOnly, "Off"},
+		{FtCiAutoscan, "Off"},
+		{FtCiScanList_md380, "None"},
+		{FtCiAdmitCriteria, "Channel free"},
+		{FtCiCtcssEncode, ctcssEncode},
+		{FtCiCtcssDecode, ctcssDecode},
+	})
+
+	return err
+}
+
+// fieldValue is a field type and the string value of a field.
+type fieldValue struct {
+	fType FieldType
+	value string
+}
+
+// addRecord adds a record of the given type, holding the radio's
+// default values and the given field values, to the end of the
+// codeplug's records.
+func (cp *Codeplug) addRecord(rType RecordType, fvs []fieldValue) (*Record, error) {
+	r, err := cp.newDefaultRecord(rType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.setFieldValues(fvs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cp.InsertRecord(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// setFieldValues sets the given field values in the record.  The
+// values of a field type that may occur more than once in a record
+// replace all of the record's fields of that type.  They are set
+// before the other values, which may depend on them.
+func (r *Record) setFieldValues(fvs []fieldValue) error {
+	err := r.checkFieldValuesUnlocked(fvs)
+	if err != nil {
+		return err
+	}
+
+	multiple := make(map[FieldType]bool)
+	for _, fi := range r.rDesc.fieldInfos {
+		multiple[fi.fType] = fi.max > 1
+	}
+
+	for _, fv := range fvs {
+		if !multiple[fv.fType] {
+			continue
+		}
+		for _, f := range append([]*Field{}, r.Fields(fv.fType)...) {
+			r.RemoveField(f)
+		}
+	}
+
+	for _, fv := range fvs {
+		if !multiple[fv.fType] {
+			continue
+		}
+		f, err := r.NewFieldWithValue(fv.fType, 0, fv.value)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fv.fType, err.Error())
+		}
+		err = r.addField(f)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, fv := range fvs {
+		if multiple[fv.fType] {
+			continue
+		}
+		if r.Field(fv.fType) == nil {
+			f, err := r.NewFieldWithValue(fv.fType, 0, fv.value)
+			if err != nil {
+				return fmt.Errorf("%s: %s", fv.fType, err.Error())
+			}
+			err = r.addField(f)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := r.Field(fv.fType).setString(fv.value)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fv.fType, err.Error())
+		}
+	}
+
+	r.audit()
+
+	return nil
+}
@@ -0,0 +1,368 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GroupListPlan is a proposed RX group list, holding the talkgroups
+// transmitted on a set of channels.
+type GroupListPlan struct {
+	// Name is the name of the group list.  It names an existing
+	// group list if Existing is true.
+	Name     string
+	Existing bool
+
+	// Contacts holds the names of the talkgroup contacts, in the
+	// order the channels first use them.
+	Contacts []string
+
+	// Channels holds the names of the channels using the group list.
+	Channels []string
+
+	// Splits holds Contacts divided into lists the radio can hold,
+	// if there are too many contacts for one group list.
+	Splits [][]string
+}
+
+// GroupListAnalysis is the result of AnalyzeGroupLists.
+type GroupListAnalysis struct {
+	// MaxContacts is the number of contacts a group list can hold.
+	MaxContacts int
+
+	// Plans holds the proposed group lists.
+	Plans []*GroupListPlan
+
+	// Duplicates holds sets of names of existing group lists
+	// having the same contacts.
+	Duplicates [][]string
+
+	// Unused holds the names of existing group lists that no
+	// channel would use.
+	Unused []string
+}
+
+// AnalyzeGroupLists proposes minimal RX group lists for the codeplug's
+// digital channels.  Channels sharing a frequency, color code and slot
+// are given a group list holding the talkgroups transmitted on any of
+// them.  Channels having the same talkgroups share a group list.
+// Channels whose frequency has no talkgroup channels are left alone.
+func (cp *Codeplug) AnalyzeGroupLists() (*GroupListAnalysis, error) {
+	a := &GroupListAnalysis{
+		MaxContacts: cp.maxFields(RtGroupLists, FtGlContact),
+	}
+
+	existing := make(map[string][]string)
+	var existingNames []string
+	for _, r := range cp.records(RtGroupLists) {
+		existing[r.Name()] = fieldStrings(r.Fields(FtGlContact))
+		existingNames = append(existingNames, r.Name())
+	}
+
+	// Gather the talkgroups transmitted on each frequency.
+	var keys []string
+	keyChannels := make(map[string][]*Record)
+	keyContacts := make(map[string][]string)
+	for _, ch := range cp.records(RtChannels_md380) {
+		if ch.Field(FtCiChannelMode).String() != "Digital" {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s",
+			ch.Field(FtCiRxFrequency).String(),
+			ch.Field(FtCiColorCode).String(),
+			ch.Field(FtCiRepeaterSlot).String())
+		if keyChannels[key] == nil {
+			keys = append(keys, key)
+		}
+		keyChannels[key] = append(keyChannels[key], ch)
+
+		name := ch.Field(FtCiContactName).String()
+		contact := cp.FindRecordByName(RtContacts, name)
+		if contact == nil || contact.Field(FtDcCallType).String() != "Group" {
+			continue
+		}
+		if !containsString(keyContacts[key], name) {
+			keyContacts[key] = append(keyContacts[key], name)
+		}
+	}
+
+	// Merge frequencies having the same talkgroups into one plan.
+	plans := make(map[string]*GroupListPlan)
+	for _, key := range keys {
+		contacts := keyContacts[key]
+		if len(contacts) == 0 {
+			continue
+		}
+		set := contactSet(contacts)
+		plan := plans[set]
+		if plan == nil {
+			plan = &GroupListPlan{Contacts: contacts}
+			plans[set] = plan
+			a.Plans = append(a.Plans, plan)
+		}
+		for _, ch := range keyChannels[key] {
+			plan.Channels = append(plan.Channels, ch.Name())
+		}
+	}
+
+	// Use an existing group list having the same contacts, if any,
+	// preferring one already used by the plan's channels.
+	for _, plan := range a.Plans {
+		set := contactSet(plan.Contacts)
+		var candidates []string
+		for _, chName := range plan.Channels {
+			ch := cp.FindRecordByName(RtChannels_md380, chName)
+			candidates = append(candidates, ch.Field(FtCiGroupList).String())
+		}
+		candidates = append(candidates, existingNames...)
+		for _, name := range candidates {
+			contacts, ok := existing[name]
+			if ok && contactSet(contacts) == set {
+				plan.Name = name
+				plan.Existing = true
+				break
+			}
+		}
+
+		if len(plan.Contacts) > a.MaxContacts {
+			for contacts := plan.Contacts; len(contacts) > 0; {
+				n := len(contacts)
+				if n > a.MaxContacts {
+					n = a.MaxContacts
+				}
+				plan.Splits = append(plan.Splits, contacts[:n])
+				contacts = contacts[n:]
+			}
+		}
+	}
+
+	// Name the new group lists after the group list their first
+	// channel uses now, or the channel itself.
+	policy, err := cp.NewNamingPolicy(RtGroupLists, "{name}")
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range a.Plans {
+		if plan.Existing {
+			continue
+		}
+		ch := cp.FindRecordByName(RtChannels_md380, plan.Channels[0])
+		name := ch.Field(FtCiGroupList).String()
+		if name == "None" {
+			name = ch.Name()
+		}
+		plan.Name, err = policy.Name(map[string]string{"name": name})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sets := make(map[string][]string)
+	var setKeys []string
+	for _, name := range existingNames {
+		set := contactSet(existing[name])
+		if sets[set] == nil {
+			setKeys = append(setKeys, set)
+		}
+		sets[set] = append(sets[set], name)
+	}
+	for _, set := range setKeys {
+		if len(sets[set]) > 1 {
+			a.Duplicates = append(a.Duplicates, sets[set])
+		}
+	}
+
+	used := make(map[string]bool)
+	for _, plan := range a.Plans {
+		used[plan.Name] = true
+	}
+	planned := make(map[string]bool)
+	for _, plan := range a.Plans {
+		for _, chName := range plan.Channels {
+			planned[chName] = true
+		}
+	}
+	for _, ch := range cp.records(RtChannels_md380) {
+		if !planned[ch.Name()] {
+			used[ch.Field(FtCiGroupList).String()] = true
+		}
+	}
+	for _, name := range existingNames {
+		if !used[name] {
+			a.Unused = append(a.Unused, name)
+		}
+	}
+
+	return a, nil
+}
+
+// OptimizeGroupLists replaces the codeplug's RX group lists with those
+// proposed by AnalyzeGroupLists and removes the group lists no longer
+// used.  Plans with too many contacts for one group list, or with a
+// channel whose group list is locked, are not applied; their channels
+// keep their group lists.  Locked group lists are not removed.
+func (cp *Codeplug) OptimizeGroupLists() (*GroupListAnalysis, error) {
+	a, err := cp.AnalyzeGroupLists()
+	if err != nil {
+		return nil, err
+	}
+
+	// The new group lists are added before the unused ones are
+	// removed, so they must fit alongside them.
+	added := 0
+	for _, plan := range a.Plans {
+		if len(plan.Splits) == 0 && !cp.groupListLocked(plan.Channels) && !plan.Existing {
+			added++
+		}
+	}
+	if len(cp.records(RtGroupLists))+added > cp.MaxRecords(RtGroupLists) {
+		return nil, fmt.Errorf("too many %s", RtGroupLists)
+	}
+
+	for _, plan := range a.Plans {
+		if len(plan.Splits) != 0 || cp.groupListLocked(plan.Channels) {
+			continue
+		}
+		if !plan.Existing {
+			fvs := []fieldValue{{FtGlName, plan.Name}}
+			for _, name := range plan.Contacts {
+				fvs = append(fvs, fieldValue{FtGlContact, name})
+			}
+			_, err := cp.addRecord(RtGroupLists, fvs)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", plan.Name, err.Error())
+			}
+		}
+		for _, chName := range plan.Channels {
+			ch := cp.FindRecordByName(RtChannels_md380, chName)
+			err := ch.Field(FtCiGroupList).setString(plan.Name)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", chName, err.Error())
+			}
+		}
+	}
+
+	// Channels of unapplied plans still use their group lists.
+	used := make(map[string]bool)
+	for _, ch := range cp.records(RtChannels_md380) {
+		used[ch.Field(FtCiGroupList).String()] = true
+	}
+
+	var unused []*Record
+	a.Unused = nil
+	for _, r := range cp.records(RtGroupLists) {
+		if !used[r.Name()] && r.CheckUnlocked() == nil {
+			unused = append(unused, r)
+			a.Unused = append(a.Unused, r.Name())
+		}
+	}
+	if len(unused) != 0 {
+		change := cp.RemoveRecordsChange(unused)
+		for _, r := range unused {
+			cp.RemoveRecord(r)
+		}
+		change.Complete()
+	}
+
+	cp.changed = true
+
+	return a, nil
+}
+
+// groupListLocked returns true if the group list of any of the named
+// channels is locked.
+func (cp *Codeplug) groupListLocked(channelNames []string) bool {
+	for _, chName := range channelNames {
+		ch := cp.FindRecordByName(RtChannels_md380, chName)
+		if ch.Field(FtCiGroupList).CheckUnlocked() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a report of the analysis.
+func (a *GroupListAnalysis) String() string {
+	var buf bytes.Buffer
+
+	for _, plan := range a.Plans {
+		status := "new"
+		if plan.Existing {
+			status = "existing"
+		}
+		fmt.Fprintf(&buf, "RX group list '%s' (%s): %s\n",
+			plan.Name, status, strings.Join(plan.Contacts, ", "))
+		fmt.Fprintf(&buf, "\tchannels: %s\n", strings.Join(plan.Channels, ", "))
+		if len(plan.Splits) != 0 {
+			fmt.Fprintf(&buf, "\t%d contacts exceed the limit of %d, suggested split:\n",
+				len(plan.Contacts), a.MaxContacts)
+			for i, split := range plan.Splits {
+				fmt.Fprintf(&buf, "\t\t%d: %s\n", i+1, strings.Join(split, ", "))
+			}
+		}
+	}
+
+	for _, names := range a.Duplicates {
+		fmt.Fprintf(&buf, "duplicate RX group lists: %s\n", strings.Join(names, ", "))
+	}
+
+	if len(a.Unused) != 0 {
+		fmt.Fprintf(&buf, "unused RX group lists: %s\n", strings.Join(a.Unused, ", "))
+	}
+
+	return buf.String()
+}
+
+// fieldStrings returns the string values of the fields.
+func fieldStrings(fields []*Field) []string {
+	strs := make([]string, len(fields))
+	for i, f := range fields {
+		strs[i] = f.String()
+	}
+	return strs
+}
+
+// contactSet returns a string identifying the set of the names,
+// independent of their order.
+func contactSet(names []string) string {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, "\x00")
+}
+
+// containsString returns true if strs contains str.
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,315 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Talkgroup describes a DMR talkgroup, or a private call destination.
+type Talkgroup struct {
+	ID      DmrID
+	Name    string
+	Private bool
+
+	// Slot, if not 0, is the time slot used for the talkgroup in
+	// place of the hotspot's time slot.
+	Slot int
+}
+
+// ParseTalkgroup parses a talkgroup of the form "id[:name[:private]]",
+// as in "91:Worldwide" or "9990:Parrot:private".  If the name is
+// omitted, it is "TG id".
+func ParseTalkgroup(s string) (Talkgroup, error) {
+	var tg Talkgroup
+
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) > 3 {
+		return tg, fmt.Errorf("bad talkgroup: %s", s)
+	}
+
+	id, err := ParseDmrID(parts[0])
+	if err != nil {
+		return tg, fmt.Errorf("bad talkgroup: %s: %s", s, err.Error())
+	}
+	tg.ID = id
+
+	tg.Name = "TG " + id.String()
+	if len(parts) > 1 && strings.TrimSpace(parts[1]) != "" {
+		tg.Name = strings.TrimSpace(parts[1])
+	}
+
+	if len(parts) > 2 {
+		switch strings.ToLower(strings.TrimSpace(parts[2])) {
+		case "private":
+			tg.Private = true
+		case "group", "":
+		default:
+			return tg, fmt.Errorf("bad talkgroup call type: %s", s)
+		}
+	}
+
+	return tg, nil
+}
+
+// ParseTalkgroups parses a comma-separated list of talkgroups in the
+// form accepted by ParseTalkgroup.
+func ParseTalkgroups(s string) ([]Talkgroup, error) {
+	var tgs []Talkgroup
+	for _, str := range strings.Split(s, ",") {
+		if strings.TrimSpace(str) == "" {
+			continue
+		}
+		tg, err := ParseTalkgroup(str)
+		if err != nil {
+			return nil, err
+		}
+		tgs = append(tgs, tg)
+	}
+
+	return tgs, nil
+}
+
+// A Hotspot describes a personal DMR hotspot, such as a Pi-Star or
+// other MMDVM based hotspot.
+type Hotspot struct {
+	// Name names the hotspot's zone and RX group list.  It is
+	// "Hotspot" by default.
+	Name string
+
+	// RxFrequency and TxFrequency are the radio's receive and
+	// transmit frequencies in MHz.  TxFrequency defaults to
+	// RxFrequency, as used by simplex hotspots.
+	RxFrequency float64
+	TxFrequency float64
+
+	ColorCode int
+
+	// Slot is the time slot, 1 or 2.  It is 2 by default.
+	Slot int
+
+	// Talkgroups lists the talkgroups for which channels are made.
+	Talkgroups []Talkgroup
+
+	// ChannelNameTemplate is the NameTemplate for channel names.
+	// The variables {hotspot}, {tg.name}, {tg.id}, {slot} and {cc}
+	// are available.  It is "{tg.name}" by default.
+	ChannelNameTemplate string
+}
+
+// AddHotspot adds the channels, contacts, RX group list and zones
+// needed to use a hotspot.  A channel is added for each of the
+// hotspot's talkgroups.  Existing contacts with matching call IDs are
+// reused.  The group talkgroups form an RX group list, and the
+// channels are placed in a zone, or in several zones if they don't
+// fit in one.
+func (cp *Codeplug) AddHotspot(h *Hotspot) error {
+	hotspotName := h.Name
+	if hotspotName == "" {
+		hotspotName = "Hotspot"
+	}
+	txFrequency := h.TxFrequency
+	if txFrequency == 0 {
+		txFrequency = h.RxFrequency
+	}
+	slot := h.Slot
+	if slot == 0 {
+		slot = 2
+	}
+	channelTemplate := h.ChannelNameTemplate
+	if channelTemplate == "" {
+		channelTemplate = "{tg.name}"
+	}
+
+	if len(h.Talkgroups) == 0 {
+		return fmt.Errorf("hotspot has no talkgroups")
+	}
+	if h.ColorCode < 0 || h.ColorCode > 15 {
+		return fmt.Errorf("bad color code: %d", h.ColorCode)
+	}
+	if slot != 1 && slot != 2 {
+		return fmt.Errorf("bad time slot: %d", slot)
+	}
+	for _, tg := range h.Talkgroups {
+		if tg.Slot < 0 || tg.Slot > 2 {
+			return fmt.Errorf("bad time slot for talkgroup %s: %d", tg.ID, tg.Slot)
+		}
+	}
+	for _, freq := range []float64{h.RxFrequency, txFrequency} {
+		err := cp.frequencyValid(freq)
+		if err != nil {
+			return fmt.Errorf("%s: %s", frequencyToString(freq), err.Error())
+		}
+	}
+
+	channelPolicy, err := cp.NewNamingPolicy(RtChannels_md380, channelTemplate)
+	if err != nil {
+		return err
+	}
+	contactPolicy, err := cp.NewNamingPolicy(RtContacts, "{tg.name}")
+	if err != nil {
+		return err
+	}
+	groupListPolicy, err := cp.NewNamingPolicy(RtGroupLists, "{hotspot}")
+	if err != nil {
+		return err
+	}
+	zonePolicy, err := cp.NewNamingPolicy(RtZones_md380, "{hotspot}")
+	if err != nil {
+		return err
+	}
+
+	// Check that everything fits before changing the codeplug.
+	contacts := make([]*Record, len(h.Talkgroups))
+	newContacts := 0
+	groups := 0
+	for i, tg := range h.Talkgroups {
+		contacts[i] = cp.findContact(tg.ID, tg.Private)
+		if contacts[i] == nil {
+			newContacts++
+		}
+		if !tg.Private {
+			groups++
+		}
+	}
+
+	maxGroupListContacts := cp.maxFields(RtGroupLists, FtGlContact)
+	if groups > maxGroupListContacts {
+		return fmt.Errorf("too many talkgroups for an RX group list: %d (max %d)", groups, maxGroupListContacts)
+	}
+	groupLists := 0
+	if groups > 0 {
+		groupLists = 1
+	}
+
+	maxZoneChannels := cp.maxFields(RtZones_md380, FtZiChannel_md380)
+	zones := (len(h.Talkgroups) + maxZoneChannels - 1) / maxZoneChannels
+
+	needed := []struct {
+		rType RecordType
+		count int
+	}{
+		{RtChannels_md380, len(h.Talkgroups)},
+		{RtContacts, newContacts},
+		{RtGroupLists, groupLists},
+		{RtZones_md380, zones},
+	}
+	for _, n := range needed {
+		if len(cp.records(n.rType))+n.count > cp.MaxRecords(n.rType) {
+			return fmt.Errorf("too many %s", n.rType)
+		}
+	}
+
+	tgSlot := func(tg Talkgroup) int {
+		if tg.Slot != 0 {
+			return tg.Slot
+		}
+		return slot
+	}
+
+	vars := func(tg Talkgroup) map[string]string {
+		tg = cp.localTalkgroup(tg)
+		return map[string]string{
+			"hotspot": hotspotName,
+			"tg.name": tg.Name,
+			"tg.id":   tg.ID.String(),
+			"slot":    fmt.Sprint(tgSlot(tg)),
+			"cc":      fmt.Sprint(h.ColorCode),
+		}
+	}
+
+	groupNames := make([]string, 0, groups)
+	for i, tg := range h.Talkgroups {
+		if contacts[i] == nil {
+			name, err := contactPolicy.Name(vars(tg))
+			if err != nil {
+				return err
+			}
+			r, err := cp.addContact(name, tg)
+			if err != nil {
+				return err
+			}
+			contacts[i] = r
+		}
+		if !tg.Private {
+			groupNames = append(groupNames, contacts[i].Name())
+		}
+	}
+
+	groupListName := "None"
+	if groupLists > 0 {
+		groupListName, err = groupListPolicy.Name(vars(Talkgroup{}))
+		if err != nil {
+			return err
+		}
+		fvs := []fieldValue{{FtGlName, groupListName}}
+		for _, name := range groupNames {
+			fvs = append(fvs, fieldValue{FtGlContact, name})
+		}
+		_, err = cp.addRecord(RtGroupLists, fvs)
+		if err != nil {
+			return err
+		}
+	}
+
+	channelNames := make([]string, len(h.Talkgroups))
+	for i, tg := range h.Talkgroups {
+		name, err := channelPolicy.Name(vars(tg))
+		if err != nil {
+			return err
+		}
+		channelNames[i] = name
+
+		groupList := groupListName
+		if tg.Private {
+			groupList = "None"
+		}
+
+		err = cp.addDigitalChannel(digitalChannel{
+			name:          name,
+			rxFrequency:   h.RxFrequency,
+			txFrequency:   txFrequency,
+			colorCode:     h.ColorCode,
+			slot:          tgSlot(tg),
+			admitCriteria: "Color code",
+			contact:       contacts[i].Name(),
+			groupList:     groupList,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cp.addZones(zonePolicy, vars(Talkgroup{}), channelNames)
+	if err != nil {
+		return err
+	}
+
+	cp.changed = true
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bufio"
+	"html/template"
+	"io"
+)
+
+// htmlTemplate lays out a cheat sheet for printing.  Zones are kept
+// whole on a page where possible.
+var htmlTemplate = template.Must(template.New("sheet").Funcs(template.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; font-size: 9pt; margin: 1em; }
+h1 { font-size: 14pt; margin: 0 0 0.5em 0; }
+h2 { font-size: 11pt; margin: 1em 0 0.2em 0; }
+section { break-inside: avoid; page-break-inside: avoid; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #888; padding: 1px 4px; text-align: left; white-space: nowrap; }
+th { background: #ddd; }
+tr:nth-child(even) td { background: #f4f4f4; }
+td.freq { font-family: monospace; text-align: right; }
+@media print { body { margin: 0; } }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Zones}}<section>
+<h2>{{.Name}}</h2>
+<table>
+<tr><th>#</th><th>Channel</th><th>Mode</th><th>RX MHz</th><th>TX MHz</th><th>CC/TS or tones</th><th>Talkgroup</th></tr>
+{{range $i, $ch := .Channels}}<tr><td>{{inc $i}}</td><td>{{$ch.Name}}</td><td>{{$ch.Mode}}</td><td class="freq">{{$ch.RxFrequency}}</td><td class="freq">{{$ch.TxFrequency}}</td><td>{{$ch.Access}}</td><td>{{$ch.Talkgroup}}</td></tr>
+{{end}}</table>
+</section>
+{{end}}{{if .Contacts}}<section>
+<h2>Contacts</h2>
+<table>
+<tr><th>Name</th><th>ID</th><th>Type</th></tr>
+{{range .Contacts}}<tr><td>{{.Name}}</td><td>{{.CallID}}</td><td>{{.CallType}}</td></tr>
+{{end}}</table>
+</section>
+{{end}}</body>
+</html>
+`))
+
+// WriteHTML writes a printable cheat sheet of the codeplug: its zones
+// with their channels' frequencies and talkgroups, and its contacts.
+// A browser's print function turns it into a PDF.
+func (cp *Codeplug) WriteHTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, cp.newSheet())
+}
+
+// ExportHTML writes the codeplug's cheat sheet to an HTML file.
+func (cp *Codeplug) ExportHTML(filename string) (err error) {
+	file, err := cp.fsys.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+	}()
+
+	w := bufio.NewWriter(file)
+	err = cp.WriteHTML(w)
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,92 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An Identity is what identifies the operator in the general settings:
+// the radio's DMR ID, its name, and the intro screen lines shown at
+// power on.
+type Identity struct {
+	RadioID          DmrID
+	RadioName        string
+	IntroScreenLine1 string
+	IntroScreenLine2 string
+}
+
+// SetIdentity sets the general settings from id.  A zero RadioID or an
+// empty string leaves the corresponding field unchanged.  Text is
+// shortened to fit its field.
+func (cp *Codeplug) SetIdentity(id Identity) error {
+	r := cp.Records(RtGeneralSettings_md380)[0]
+
+	var fvs []fieldValue
+	if id.RadioID != 0 {
+		fvs = append(fvs, fieldValue{FtGsRadioID, id.RadioID.String()})
+	}
+	texts := []fieldValue{
+		{FtGsRadioName, id.RadioName},
+		{FtGsIntroScreenLine1, id.IntroScreenLine1},
+		{FtGsIntroScreenLine2, id.IntroScreenLine2},
+	}
+	for _, fv := range texts {
+		value := strings.TrimSpace(fv.value)
+		if value == "" || r.Field(fv.fType) == nil {
+			continue
+		}
+		if maxLen := r.stringFieldLength(fv.fType); len([]rune(value)) > maxLen {
+			value = strings.TrimSpace(string([]rune(value)[:maxLen]))
+		}
+		fvs = append(fvs, fieldValue{fv.fType, value})
+	}
+	if len(fvs) == 0 {
+		return nil
+	}
+
+	err := r.setFieldValues(fvs)
+	if err != nil {
+		return fmt.Errorf("%s: %s", RtGeneralSettings_md380, err.Error())
+	}
+
+	cp.changed = true
+
+	return nil
+}
+
+// stringFieldLength returns the number of characters held by the
+// record's UTF-16 string field of type fType.
+func (r *Record) stringFieldLength(fType FieldType) int {
+	for _, fi := range r.rDesc.fieldInfos {
+		if fi.fType == fType {
+			return fi.bitSize / 16
+		}
+	}
+
+	return 0
+}
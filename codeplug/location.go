@@ -0,0 +1,334 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A record's location is not stored in the radio's codeplug.  It is
+// saved in, and read from, text and JSON files as the pseudo-fields
+// named by LatitudeFieldName and LongitudeFieldName.
+const (
+	LatitudeFieldName  = "Latitude"
+	LongitudeFieldName = "Longitude"
+)
+
+// earthRadius is the mean radius of the earth in kilometers.
+const earthRadius = 6371.0
+
+// A Location is a position in decimal degrees.  Latitudes north of the
+// equator and longitudes east of Greenwich are positive.
+type Location struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// ParseLocation parses a location of the form "latitude,longitude",
+// as in "33.4152,-111.8315".
+func ParseLocation(s string) (Location, error) {
+	var loc Location
+
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return loc, fmt.Errorf("bad location: %s", s)
+	}
+
+	lat, err := parseCoordinate(parts[0], 90)
+	if err != nil {
+		return loc, fmt.Errorf("bad latitude: %s", parts[0])
+	}
+	lon, err := parseCoordinate(parts[1], 180)
+	if err != nil {
+		return loc, fmt.Errorf("bad longitude: %s", parts[1])
+	}
+
+	return Location{Latitude: lat, Longitude: lon}, nil
+}
+
+// parseCoordinate parses a coordinate whose magnitude is at most max.
+func parseCoordinate(s string, max float64) (float64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.Abs(f) > max {
+		return 0, fmt.Errorf("coordinate out of range")
+	}
+
+	return f, nil
+}
+
+// formatCoordinate formats a coordinate to about 10cm precision.
+func formatCoordinate(f float64) string {
+	return strconv.FormatFloat(f, 'f', 6, 64)
+}
+
+// String returns the location in the form accepted by ParseLocation.
+func (loc Location) String() string {
+	return formatCoordinate(loc.Latitude) + "," + formatCoordinate(loc.Longitude)
+}
+
+// radians returns the location's latitude and longitude in radians.
+func (loc Location) radians() (lat, lon float64) {
+	return loc.Latitude * math.Pi / 180, loc.Longitude * math.Pi / 180
+}
+
+// DistanceTo returns the great-circle distance in kilometers between
+// two locations.
+func (loc Location) DistanceTo(other Location) float64 {
+	lat1, lon1 := loc.radians()
+	lat2, lon2 := other.radians()
+
+	sinDLat := math.Sin((lat2 - lat1) / 2)
+	sinDLon := math.Sin((lon2 - lon1) / 2)
+	a := sinDLat*sinDLat + math.Cos(lat1)*math.Cos(lat2)*sinDLon*sinDLon
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// A Locator reports the distance, in kilometers, of a location from
+// some reference, such as a single Location or a Route.
+type Locator interface {
+	DistanceTo(Location) float64
+}
+
+// A Route is a travel route, the path joining its locations in order.
+type Route []Location
+
+// ParseRoute parses a route of the form "lat,lon;lat,lon;...".
+func ParseRoute(s string) (Route, error) {
+	var route Route
+	for _, str := range strings.Split(s, ";") {
+		if strings.TrimSpace(str) == "" {
+			continue
+		}
+		loc, err := ParseLocation(str)
+		if err != nil {
+			return nil, err
+		}
+		route = append(route, loc)
+	}
+	if len(route) == 0 {
+		return nil, fmt.Errorf("empty route")
+	}
+
+	return route, nil
+}
+
+// DistanceTo returns the distance in kilometers from loc to the
+// nearest point of the route.  Each leg of the route is treated as a
+// straight line on a local flat projection, which is accurate for legs
+// of up to a few hundred kilometers.
+func (route Route) DistanceTo(loc Location) float64 {
+	if len(route) == 0 {
+		return math.Inf(1)
+	}
+
+	min := route[0].DistanceTo(loc)
+	for i := 1; i < len(route); i++ {
+		d := legDistance(route[i-1], route[i], loc)
+		if d < min {
+			min = d
+		}
+	}
+
+	return min
+}
+
+// legDistance returns the distance in kilometers from loc to the
+// nearest point of the leg from a to b.
+func legDistance(a, b, loc Location) float64 {
+	lat0 := loc.Latitude * math.Pi / 180
+	project := func(l Location) (x, y float64) {
+		dLon := l.Longitude - loc.Longitude
+		if dLon > 180 {
+			dLon -= 360
+		} else if dLon < -180 {
+			dLon += 360
+		}
+		x = dLon * math.Pi / 180 * math.Cos(lat0) * earthRadius
+		y = (l.Latitude - loc.Latitude) * math.Pi / 180 * earthRadius
+		return x, y
+	}
+
+	ax, ay := project(a)
+	bx, by := project(b)
+	dx, dy := bx-ax, by-ay
+
+	t := 0.0
+	if lenSq := dx*dx + dy*dy; lenSq > 0 {
+		t = -(ax*dx + ay*dy) / lenSq
+		t = math.Max(0, math.Min(1, t))
+	}
+
+	x, y := ax+t*dx, ay+t*dy
+
+	return math.Sqrt(x*x + y*y)
+}
+
+// Location returns the record's location, and whether it has one.
+func (r *Record) Location() (Location, bool) {
+	if r.location == nil {
+		return Location{}, false
+	}
+
+	return *r.location, true
+}
+
+// SetLocation sets the record's location.
+func (r *Record) SetLocation(loc Location) {
+	r.location = &loc
+}
+
+// ClearLocation removes the record's location.
+func (r *Record) ClearLocation() {
+	r.location = nil
+}
+
+// isLocationFieldName returns true if name names a location pseudo-field.
+func isLocationFieldName(name string) bool {
+	return name == LatitudeFieldName || name == LongitudeFieldName
+}
+
+// setLocationField sets the latitude or longitude of the record's
+// location from the string value of a location pseudo-field.
+func (r *Record) setLocationField(name string, value string) error {
+	loc, _ := r.Location()
+
+	switch name {
+	case LatitudeFieldName:
+		lat, err := parseCoordinate(value, 90)
+		if err != nil {
+			return fmt.Errorf("bad latitude: %s", value)
+		}
+		loc.Latitude = lat
+
+	case LongitudeFieldName:
+		lon, err := parseCoordinate(value, 180)
+		if err != nil {
+			return fmt.Errorf("bad longitude: %s", value)
+		}
+		loc.Longitude = lon
+	}
+
+	r.SetLocation(loc)
+
+	return nil
+}
+
+// locationFields returns the names and values of the record's location
+// pseudo-fields, if it has a location.
+func (r *Record) locationFields() (names []string, values []string) {
+	loc, ok := r.Location()
+	if !ok {
+		return nil, nil
+	}
+
+	names = []string{LatitudeFieldName, LongitudeFieldName}
+	values = []string{formatCoordinate(loc.Latitude), formatCoordinate(loc.Longitude)}
+
+	return names, values
+}
+
+// ChannelsByDistance returns the channels that have locations within
+// maxDistance kilometers of ref, nearest first.  A maxDistance of zero
+// means there is no limit.
+func (cp *Codeplug) ChannelsByDistance(ref Locator, maxDistance float64) []*Record {
+	type channelDistance struct {
+		r        *Record
+		distance float64
+	}
+
+	var cds []channelDistance
+	for _, r := range cp.records(RtChannels_md380) {
+		loc, ok := r.Location()
+		if !ok {
+			continue
+		}
+		d := ref.DistanceTo(loc)
+		if maxDistance > 0 && d > maxDistance {
+			continue
+		}
+		cds = append(cds, channelDistance{r, d})
+	}
+
+	sort.SliceStable(cds, func(i, j int) bool {
+		return cds[i].distance < cds[j].distance
+	})
+
+	records := make([]*Record, len(cds))
+	for i, cd := range cds {
+		records[i] = cd.r
+	}
+
+	return records
+}
+
+// SortChannelsByDistance reorders the codeplug's channels, nearest to
+// ref first.  Channels without locations follow, in their original
+// order.
+func (cp *Codeplug) SortChannelsByDistance(ref Locator) {
+	sorted := cp.ChannelsByDistance(ref, 0)
+	for i, r := range sorted {
+		cp.MoveRecord(i, r)
+	}
+
+	cp.changed = true
+}
+
+// AddDistanceZones adds zones, named from the given name template,
+// holding the channels within maxDistance kilometers of ref, nearest
+// first.  As many zones as are needed to hold the channels are added.
+func (cp *Codeplug) AddDistanceZones(nameTemplate string, ref Locator, maxDistance float64) error {
+	records := cp.ChannelsByDistance(ref, maxDistance)
+	if len(records) == 0 {
+		return fmt.Errorf("no channels with locations within %g km", maxDistance)
+	}
+
+	policy, err := cp.NewNamingPolicy(RtZones_md380, nameTemplate)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(records))
+	for i, r := range records {
+		names[i] = r.Name()
+	}
+
+	err = cp.addZones(policy, nil, names)
+	if err != nil {
+		return err
+	}
+
+	cp.changed = true
+
+	return nil
+}
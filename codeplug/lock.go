@@ -0,0 +1,388 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Locks are not stored in the radio's codeplug.  They are saved in,
+// and read from, text and JSON files as the pseudo-field named by
+// LockedFieldName.  Its value is LockedAll for a locked record, or a
+// comma-separated list of the record's locked field types.
+const (
+	LockedFieldName = "Locked"
+	LockedAll       = "all"
+)
+
+// Lock locks the record.  A locked record's fields may not be changed,
+// and the record may not be removed, unless locks are overridden.
+func (r *Record) Lock() {
+	r.locked = true
+	r.lockedFields = nil
+}
+
+// Unlock unlocks the record and all of its fields.
+func (r *Record) Unlock() {
+	r.locked = false
+	r.lockedFields = nil
+}
+
+// IsLocked returns true if the record is locked.  Individual fields of
+// an unlocked record may still be locked.
+func (r *Record) IsLocked() bool {
+	return r.locked
+}
+
+// LockField locks the record's fields of the given type.
+func (r *Record) LockField(fType FieldType) {
+	if r.locked || containsFieldType(r.lockedFields, fType) {
+		return
+	}
+	r.lockedFields = append(r.lockedFields, fType)
+}
+
+// UnlockField unlocks the record's fields of the given type.  It has no
+// effect if the whole record is locked.
+func (r *Record) UnlockField(fType FieldType) {
+	for i, t := range r.lockedFields {
+		if t == fType {
+			r.lockedFields = append(r.lockedFields[:i], r.lockedFields[i+1:]...)
+			return
+		}
+	}
+}
+
+// LockedFieldTypes returns the types of the record's individually
+// locked fields.
+func (r *Record) LockedFieldTypes() []FieldType {
+	return append([]FieldType{}, r.lockedFields...)
+}
+
+// IsLocked returns true if the field, or its record, is locked.
+func (f *Field) IsLocked() bool {
+	r := f.record
+	return r.locked || containsFieldType(r.lockedFields, f.fType)
+}
+
+// SetLockOverride allows locked records and fields to be changed, if
+// override is true.
+func (cp *Codeplug) SetLockOverride(override bool) {
+	cp.lockOverride = override
+}
+
+// LockOverride returns true if locks are overridden.
+func (cp *Codeplug) LockOverride() bool {
+	return cp.lockOverride
+}
+
+// locksApply returns true if the record's locks are to be enforced.
+// They aren't while the codeplug is loading, nor for records not (yet)
+// part of the codeplug.
+func (r *Record) locksApply() bool {
+	cp := r.codeplug
+	if cp.lockOverride || !cp.loaded {
+		return false
+	}
+	records := cp.records(r.rType)
+	return r.rIndex < len(records) && records[r.rIndex] == r
+}
+
+// CheckUnlocked returns an error if the field may not be changed
+// because it is locked.
+func (f *Field) CheckUnlocked() error {
+	return f.record.CheckFieldsUnlocked(f.fType)
+}
+
+// CheckFieldsUnlocked returns an error if the record's fields of the
+// given type may not be changed, added, or removed because they are
+// locked.
+func (r *Record) CheckFieldsUnlocked(fType FieldType) error {
+	if !r.locked && !containsFieldType(r.lockedFields, fType) {
+		return nil
+	}
+	if !r.locksApply() {
+		return nil
+	}
+	typeName := string(fType)
+	for _, fi := range r.fieldInfos {
+		if fi.fType == fType {
+			typeName = fi.typeName
+		}
+	}
+	return fmt.Errorf("%s %s is locked", r.lockName(), typeName)
+}
+
+// CheckUnlocked returns an error if the record may not be removed
+// because it, or any of its fields, is locked.
+func (r *Record) CheckUnlocked() error {
+	if (!r.locked && len(r.lockedFields) == 0) || !r.locksApply() {
+		return nil
+	}
+	return fmt.Errorf("%s is locked", r.lockName())
+}
+
+// lockName returns the record's name for use in lock errors.
+func (r *Record) lockName() string {
+	name := r.Name()
+	if name == "" {
+		return r.TypeName()
+	}
+	return fmt.Sprintf("%s '%s'", r.TypeName(), name)
+}
+
+// SetLocks locks, or if lock is false unlocks, the named records of
+// the named record type.  No names are needed for a record type having
+// a single record.  If fieldTypeNames is not empty, only the records'
+// fields of those types are locked or unlocked.  Record and field type
+// names are those used in text files.
+func (cp *Codeplug) SetLocks(rTypeName string, names []string, fieldTypeNames []string, lock bool) error {
+	rType, err := cp.nameToRt(rTypeName)
+	if err != nil {
+		return err
+	}
+
+	fTypes := make([]FieldType, len(fieldTypeNames))
+	for i, name := range fieldTypeNames {
+		fTypes[i], err = cp.nameToFt(rType, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(names) == 0 && cp.MaxRecords(rType) == 1 {
+		names = []string{cp.Records(rType)[0].Name()}
+	}
+	records := make([]*Record, len(names))
+	for i, name := range names {
+		records[i] = cp.FindRecordByName(rType, name)
+		if records[i] == nil {
+			return fmt.Errorf("no %s record named '%s'", rType, name)
+		}
+	}
+
+	for _, r := range records {
+		switch {
+		case len(fTypes) == 0 && lock:
+			r.Lock()
+		case len(fTypes) == 0:
+			r.Unlock()
+		default:
+			for _, fType := range fTypes {
+				if lock {
+					r.LockField(fType)
+				} else {
+					r.UnlockField(fType)
+				}
+			}
+		}
+	}
+
+	cp.changed = true
+
+	return nil
+}
+
+// checkFieldValuesUnlocked returns an error if setting the field values
+// would change a locked field of the record.
+func (r *Record) checkFieldValuesUnlocked(fvs []fieldValue) error {
+	values := make(map[FieldType][]string)
+	var fTypes []FieldType
+	for _, fv := range fvs {
+		if values[fv.fType] == nil {
+			fTypes = append(fTypes, fv.fType)
+		}
+		values[fv.fType] = append(values[fv.fType], fv.value)
+	}
+
+	for _, fType := range fTypes {
+		fields := r.Fields(fType)
+		same := len(fields) == len(values[fType])
+		for i := 0; same && i < len(fields); i++ {
+			same = fields[i].String() == values[fType][i]
+		}
+		if same {
+			continue
+		}
+		if len(fields) == 0 {
+			if r.locked && r.locksApply() {
+				return fmt.Errorf("%s is locked", r.lockName())
+			}
+			continue
+		}
+		err := fields[0].CheckUnlocked()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkReferencesUnlocked returns an error if any locked field refers
+// to one of the named records of type rType.
+func (cp *Codeplug) checkReferencesUnlocked(rType RecordType, names map[string]bool) error {
+	for _, rd := range cp.rDesc {
+		for _, fi := range rd.fieldInfos {
+			if fi.listRecordType != rType {
+				continue
+			}
+			for _, r := range rd.records {
+				for _, f := range r.Fields(fi.fType) {
+					if !names[f.String()] {
+						continue
+					}
+					err := f.CheckUnlocked()
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isLockFieldName returns true if name names the lock pseudo-field.
+func isLockFieldName(name string) bool {
+	return name == LockedFieldName
+}
+
+// setLockField sets the record's locks from the value of a lock
+// pseudo-field.
+func (r *Record) setLockField(value string) error {
+	value = strings.TrimSpace(value)
+	if value == LockedAll {
+		r.Lock()
+		return nil
+	}
+
+	r.Unlock()
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		fType, err := r.codeplug.nameToFt(r.rType, name)
+		if err != nil {
+			return fmt.Errorf("bad locked field name: %s", name)
+		}
+		r.LockField(fType)
+	}
+
+	return nil
+}
+
+// lockFields returns the names and values of the record's lock
+// pseudo-field, if it has any locks.
+func (r *Record) lockFields() (names []string, values []string) {
+	switch {
+	case r.locked:
+		return []string{LockedFieldName}, []string{LockedAll}
+
+	case len(r.lockedFields) != 0:
+		strs := make([]string, len(r.lockedFields))
+		for i, fType := range r.lockedFields {
+			strs[i] = string(fType)
+		}
+		return []string{LockedFieldName}, []string{strings.Join(strs, ",")}
+	}
+
+	return nil, nil
+}
+
+// isPseudoFieldName returns true if name names a pseudo-field, one
+// stored only in text and JSON files.
+func isPseudoFieldName(name string) bool {
+	return isLocationFieldName(name) || isLockFieldName(name) ||
+		isAuditFieldName(name) || isTagsFieldName(name) ||
+		isExtraFieldName(name)
+}
+
+// setPseudoField sets the record's location, locks, modification, tags
+// or extras from the value of a pseudo-field.
+func (r *Record) setPseudoField(name string, value string) error {
+	if isExtraFieldName(name) {
+		return r.setExtraField(name, value)
+	}
+	if isLockFieldName(name) {
+		return r.setLockField(value)
+	}
+	if isTagsFieldName(name) {
+		return r.setTagsField(value)
+	}
+	if isAuditFieldName(name) {
+		return r.setAuditField(name, value)
+	}
+	return r.setLocationField(name, value)
+}
+
+// checkPseudoFieldUnlocked returns an error if setting the named
+// pseudo-field would change a location, tag, extra or lock that may
+// not be changed.  A location, tags or extras may not be changed in a
+// locked record, and a lock may not be changed in a record having any
+// locks.  A modification record may always be changed.
+func (r *Record) checkPseudoFieldUnlocked(name string, value string) error {
+	if isAuditFieldName(name) {
+		return nil
+	}
+	names, values := r.pseudoFields()
+	for i, n := range names {
+		if n == name && values[i] == value {
+			return nil
+		}
+	}
+	if isLockFieldName(name) || r.locked {
+		return r.CheckUnlocked()
+	}
+	return nil
+}
+
+// pseudoFields returns the names and values of the record's
+// pseudo-fields.
+func (r *Record) pseudoFields() (names []string, values []string) {
+	names, values = r.locationFields()
+	lockNames, lockValues := r.lockFields()
+	auditNames, auditValues := r.auditFields()
+	tagsNames, tagsValues := r.tagsFields()
+	extraNames, extraValues := r.extraFields()
+
+	names = append(append(append(append(names, tagsNames...), extraNames...), lockNames...), auditNames...)
+	values = append(append(append(append(values, tagsValues...), extraValues...), lockValues...), auditValues...)
+
+	return names, values
+}
+
+func containsFieldType(fTypes []FieldType, fType FieldType) bool {
+	for _, t := range fTypes {
+		if t == fType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// markdownCell escapes s for use in a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, "|", `\|`, -1)
+	if s == "" {
+		s = " "
+	}
+
+	return s
+}
+
+// writeMarkdownTable writes a Markdown table with the given header
+// and rows.
+func writeMarkdownTable(w io.Writer, header []string, rows [][]string) {
+	fmt.Fprintf(w, "| %s |\n", strings.Join(header, " | "))
+	fmt.Fprintf(w, "|%s\n", strings.Repeat(" --- |", len(header)))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = markdownCell(cell)
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+	}
+}
+
+// WriteMarkdown writes the codeplug's zones, with their channels, and
+// its contacts as Markdown tables, for publishing on a wiki.
+func (cp *Codeplug) WriteMarkdown(w io.Writer) error {
+	s := cp.newSheet()
+
+	fmt.Fprintf(w, "# %s\n", s.Title)
+
+	header := []string{"#", "Channel", "Mode", "RX MHz", "TX MHz", "CC/TS or tones", "Talkgroup"}
+	for _, zone := range s.Zones {
+		fmt.Fprintf(w, "\n## %s\n\n", zone.Name)
+		rows := make([][]string, len(zone.Channels))
+		for i, ch := range zone.Channels {
+			rows[i] = []string{
+				fmt.Sprint(i + 1),
+				ch.Name,
+				ch.Mode,
+				ch.RxFrequency,
+				ch.TxFrequency,
+				ch.Access,
+				ch.Talkgroup,
+			}
+		}
+		writeMarkdownTable(w, header, rows)
+	}
+
+	if len(s.Contacts) > 0 {
+		fmt.Fprintf(w, "\n## Contacts\n\n")
+		rows := make([][]string, len(s.Contacts))
+		for i, c := range s.Contacts {
+			rows[i] = []string{c.Name, c.CallID, c.CallType}
+		}
+		writeMarkdownTable(w, []string{"Name", "ID", "Type"}, rows)
+	}
+
+	return nil
+}
+
+// ExportMarkdown writes the codeplug's zones, channels and contacts to
+// a Markdown file.
+func (cp *Codeplug) ExportMarkdown(filename string) (err error) {
+	file, err := cp.fsys.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+	}()
+
+	w := bufio.NewWriter(file)
+	err = cp.WriteMarkdown(w)
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,72 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+)
+
+// NewCodeplugFromBytes returns a Codeplug holding data, the contents
+// of a codeplug file, without reading or writing any file.  It is used
+// where there is no file system, as in a browser.  fType is
+// FileTypeNone for .rdt and .bin images, which are recognized by their
+// size, or FileTypeText, FileTypeJSON or FileTypeXLSX.  name is used in
+// place of a filename in messages.  The codeplug must then be loaded
+// with Load.
+func NewCodeplugFromBytes(fType FileType, name string, data []byte) (*Codeplug, error) {
+	switch fType {
+	case FileTypeNone:
+		cp, err := NewCodeplug(FileTypeNew, name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = cp.findFileTypeBySize(int64(len(data)))
+		if err != nil {
+			return nil, fmt.Errorf("%s %s", name, err.Error())
+		}
+
+		cp.filename = name
+		cp.importData = data
+		err = cp.read(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return cp, nil
+
+	case FileTypeText, FileTypeJSON, FileTypeXLSX:
+		cp, err := NewCodeplug(fType, name)
+		if err != nil {
+			return nil, err
+		}
+		cp.importData = data
+
+		return cp, nil
+	}
+
+	return nil, fmt.Errorf("%s: unsupported file type", name)
+}
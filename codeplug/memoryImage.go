@@ -0,0 +1,233 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A MemoryRegion describes a named, contiguous range of a MemoryImage.
+type MemoryRegion struct {
+	Name   string
+	Offset int
+	Size   int
+}
+
+// End returns the offset just past the end of the region.
+func (r MemoryRegion) End() int {
+	return r.Offset + r.Size
+}
+
+// A MemoryImage models the layout of a radio's flash memory: the
+// regions it contains, the padding between them, and the value of an
+// erased byte.  It knows nothing of the records stored in the regions.
+type MemoryImage struct {
+	bytes   []byte
+	erased  byte
+	regions []MemoryRegion
+}
+
+// NewMemoryImage returns a MemoryImage for the given bytes, which are
+// shared, not copied.  The regions must lie within bytes and must not
+// overlap.
+func NewMemoryImage(bytes []byte, erased byte, regions []MemoryRegion) (*MemoryImage, error) {
+	sorted := make([]MemoryRegion, len(regions))
+	copy(sorted, regions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Offset < sorted[j].Offset
+	})
+
+	end := 0
+	for _, r := range sorted {
+		if r.Offset < 0 || r.Size < 0 || r.End() > len(bytes) {
+			return nil, fmt.Errorf("region %s (%d+%d) is outside the %d byte image", r.Name, r.Offset, r.Size, len(bytes))
+		}
+		if r.Offset < end {
+			return nil, fmt.Errorf("region %s overlaps the previous region", r.Name)
+		}
+		end = r.End()
+	}
+
+	mi := &MemoryImage{
+		bytes:   bytes,
+		erased:  erased,
+		regions: sorted,
+	}
+
+	return mi, nil
+}
+
+// Bytes returns the bytes of the entire image.
+func (mi *MemoryImage) Bytes() []byte {
+	return mi.bytes
+}
+
+// Size returns the size of the image in bytes.
+func (mi *MemoryImage) Size() int {
+	return len(mi.bytes)
+}
+
+// ErasedByte returns the value of each byte of erased flash.
+func (mi *MemoryImage) ErasedByte() byte {
+	return mi.erased
+}
+
+// Regions returns the image's regions in order of increasing offset.
+func (mi *MemoryImage) Regions() []MemoryRegion {
+	return mi.regions
+}
+
+// Region returns the region with the given name.
+func (mi *MemoryImage) Region(name string) (MemoryRegion, error) {
+	for _, r := range mi.regions {
+		if r.Name == name {
+			return r, nil
+		}
+	}
+
+	return MemoryRegion{}, fmt.Errorf("unknown memory region: %s", name)
+}
+
+// RegionBytes returns the bytes of the region with the given name.
+func (mi *MemoryImage) RegionBytes(name string) ([]byte, error) {
+	r, err := mi.Region(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return mi.bytes[r.Offset:r.End()], nil
+}
+
+// Padding returns the unnamed ranges of the image not covered by any
+// region.
+func (mi *MemoryImage) Padding() []MemoryRegion {
+	var padding []MemoryRegion
+
+	offset := 0
+	for _, r := range mi.regions {
+		if r.Offset > offset {
+			padding = append(padding, MemoryRegion{"", offset, r.Offset - offset})
+		}
+		offset = r.End()
+	}
+
+	if offset < len(mi.bytes) {
+		padding = append(padding, MemoryRegion{"", offset, len(mi.bytes) - offset})
+	}
+
+	return padding
+}
+
+// IsErased returns true if every byte of the given region holds the
+// erased value.
+func (mi *MemoryImage) IsErased(r MemoryRegion) bool {
+	for _, b := range mi.bytes[r.Offset:r.End()] {
+		if b != mi.erased {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Erase sets every byte of the given region to the erased value.
+func (mi *MemoryImage) Erase(r MemoryRegion) {
+	bytes := mi.bytes[r.Offset:r.End()]
+	for i := range bytes {
+		bytes[i] = mi.erased
+	}
+}
+
+// Span returns the smallest region containing all of the named regions.
+func (mi *MemoryImage) Span(names []string) (MemoryRegion, error) {
+	if len(names) == 0 {
+		return MemoryRegion{}, fmt.Errorf("no memory regions given")
+	}
+
+	span := MemoryRegion{Offset: len(mi.bytes)}
+	end := 0
+	for _, name := range names {
+		r, err := mi.Region(name)
+		if err != nil {
+			return MemoryRegion{}, err
+		}
+		if r.Offset < span.Offset {
+			span.Offset = r.Offset
+		}
+		if r.End() > end {
+			end = r.End()
+		}
+	}
+	span.Size = end - span.Offset
+
+	return span, nil
+}
+
+// MemoryImage returns a MemoryImage describing the radio's flash
+// contents of the codeplug.  There is one region per record type, named
+// by the record type.  The image shares the codeplug's bytes, which only
+// reflect changes to records after the codeplug is saved or written.
+func (cp *Codeplug) MemoryImage() (*MemoryImage, error) {
+	cpi := cp.codeplugInfo
+	if cpi == nil {
+		return nil, fmt.Errorf("codeplug is not loaded")
+	}
+	if cpi.BinOffset+cpi.BinSize > len(cp.bytes) {
+		return nil, fmt.Errorf("codeplug image is %d bytes, expected %d", len(cp.bytes), cpi.BinOffset+cpi.BinSize)
+	}
+	bytes := cp.bytes[cpi.BinOffset : cpi.BinOffset+cpi.BinSize]
+
+	return NewMemoryImage(bytes, 0xff, cp.memoryRegions())
+}
+
+// memoryRegions returns the regions of the codeplug's memory image,
+// one per record type.
+func (cp *Codeplug) memoryRegions() []MemoryRegion {
+	cpi := cp.codeplugInfo
+	regions := make([]MemoryRegion, 0, len(cpi.RecordInfos))
+	for _, ri := range cpi.RecordInfos {
+		max := ri.max
+		if max == 0 {
+			max = 1
+		}
+
+		// The BasicInformation record begins in the .rdt header.
+		start := ri.offset - cpi.BinOffset
+		end := start + ri.size*max
+		if start < 0 {
+			start = 0
+		}
+
+		regions = append(regions, MemoryRegion{
+			Name:   string(ri.rType),
+			Offset: start,
+			Size:   end - start,
+		})
+	}
+
+	return regions
+}
@@ -0,0 +1,224 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"sort"
+	"testing"
+)
+
+// templateCodeplugs returns a loaded codeplug for each of the blank
+// codeplugs captured from the supported radios.
+func templateCodeplugs(t *testing.T) []*Codeplug {
+	var cps []*Codeplug
+
+	freqRanges := AllFrequencyRanges()
+	models := make([]string, 0, len(freqRanges))
+	for model := range freqRanges {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	for _, model := range models {
+		for _, freq := range freqRanges[model] {
+			cp, err := NewCodeplug(FileTypeNew, "")
+			if err != nil {
+				t.Fatalf("%s %s: %s", model, freq, err)
+			}
+
+			ignoreWarnings := true
+			err = cp.Load(model, freq, ignoreWarnings)
+			if err != nil {
+				t.Fatalf("%s %s: %s", model, freq, err)
+			}
+			cps = append(cps, cp)
+		}
+	}
+
+	if len(cps) == 0 {
+		t.Fatal("no template codeplugs")
+	}
+
+	return cps
+}
+
+func TestMemoryImageTemplates(t *testing.T) {
+	for _, cp := range templateCodeplugs(t) {
+		name := cp.Model() + " " + cp.FrequencyRange()
+
+		mi, err := cp.MemoryImage()
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+
+		cpi := cp.codeplugInfo
+		if mi.Size() != cpi.BinSize {
+			t.Errorf("%s: image size %d, expected %d", name, mi.Size(), cpi.BinSize)
+		}
+
+		regions := mi.Regions()
+		if len(regions) != len(cpi.RecordInfos) {
+			t.Errorf("%s: %d regions, expected %d", name, len(regions), len(cpi.RecordInfos))
+		}
+
+		for _, ri := range cpi.RecordInfos {
+			r, err := mi.Region(string(ri.rType))
+			if err != nil {
+				t.Errorf("%s: %s", name, err)
+				continue
+			}
+			if r.End() > mi.Size() {
+				t.Errorf("%s: region %s ends at %d, past the image", name, r.Name, r.End())
+			}
+		}
+
+		covered := 0
+		for _, r := range regions {
+			covered += r.Size
+		}
+		for _, r := range mi.Padding() {
+			covered += r.Size
+		}
+		if covered != mi.Size() {
+			t.Errorf("%s: regions and padding cover %d bytes, expected %d", name, covered, mi.Size())
+		}
+
+		basic, err := mi.RegionBytes(string(RtBasicInformation_md380))
+		if err != nil {
+			t.Errorf("%s: %s", name, err)
+		} else if mi.IsErased(MemoryRegion{Offset: 0, Size: len(basic)}) {
+			t.Errorf("%s: basic information region is erased", name)
+		}
+	}
+}
+
+func TestMemoryImageSpan(t *testing.T) {
+	cp := templateCodeplugs(t)[0]
+
+	mi, err := cp.MemoryImage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contacts, err := mi.Region(string(RtContacts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	channels, err := mi.Region(string(RtChannels_md380))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	span, err := mi.Span([]string{string(RtChannels_md380), string(RtContacts)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, last := contacts, channels
+	if channels.Offset < contacts.Offset {
+		first, last = channels, contacts
+	}
+	if span.Offset != first.Offset || span.End() != last.End() {
+		t.Errorf("span %d+%d, expected %d+%d", span.Offset, span.Size, first.Offset, last.End()-first.Offset)
+	}
+
+	_, err = mi.Span(nil)
+	if err == nil {
+		t.Error("empty span: no error")
+	}
+
+	_, err = mi.Span([]string{"NoSuchRecord"})
+	if err == nil {
+		t.Error("unknown region: no error")
+	}
+}
+
+func TestMemoryImageErase(t *testing.T) {
+	cp := templateCodeplugs(t)[0]
+
+	mi, err := cp.MemoryImage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := mi.Region(string(RtContacts))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mi.Erase(r)
+	if !mi.IsErased(r) {
+		t.Errorf("region %s not erased", r.Name)
+	}
+
+	cpi := cp.codeplugInfo
+	if cp.bytes[cpi.BinOffset+r.Offset] != mi.ErasedByte() {
+		t.Error("image does not share the codeplug's bytes")
+	}
+}
+
+func TestNewMemoryImageErrors(t *testing.T) {
+	bytes := make([]byte, 16)
+
+	tests := []struct {
+		name    string
+		regions []MemoryRegion
+	}{
+		{"outside", []MemoryRegion{{"a", 8, 16}}},
+		{"negative", []MemoryRegion{{"a", -1, 4}}},
+		{"overlap", []MemoryRegion{{"a", 0, 8}, {"b", 4, 8}}},
+	}
+
+	for _, test := range tests {
+		_, err := NewMemoryImage(bytes, 0xff, test.regions)
+		if err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+
+	mi, err := NewMemoryImage(bytes, 0xff, []MemoryRegion{{"b", 8, 4}, {"a", 0, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mi.Regions()[0].Name != "a" {
+		t.Error("regions not sorted by offset")
+	}
+	if len(mi.Padding()) != 2 {
+		t.Errorf("%d padding regions, expected 2", len(mi.Padding()))
+	}
+}
+
+func TestMemoryImageNotLoaded(t *testing.T) {
+	cp, err := NewCodeplug(FileTypeNew, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cp.MemoryImage()
+	if err == nil {
+		t.Error("unloaded codeplug: no error")
+	}
+}
@@ -199,7 +199,7 @@ func bcdBytesToString(bytes []byte) string {
 	s := ""
 	for _, b := range bytes {
 		s += string('0' + ((int32(b) >> 4) & 0xf))
-		s += string('0' + uint32(b)&0xf)
+		s += string(rune('0' + uint32(b)&0xf))
 	}
 	return s
 }
@@ -0,0 +1,151 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/dfu"
+)
+
+// An Identifier is a Transport that can report the model and frequency
+// range of the connected radio, as returned by Codeplug.Model and
+// Codeplug.FrequencyRange.  The frequency range may be empty if it is
+// unknown.
+type Identifier interface {
+	Identify() (model string, frequencyRange string, err error)
+}
+
+// A ModelMismatchError is returned when writing a codeplug to a radio
+// of a different model or frequency range.
+type ModelMismatchError struct {
+	Model               string
+	FrequencyRange      string
+	RadioModel          string
+	RadioFrequencyRange string
+}
+
+func (e *ModelMismatchError) Error() string {
+	var diffs []string
+	if e.Model != e.RadioModel {
+		diffs = append(diffs, fmt.Sprintf("the codeplug is for model %s, but the radio is a %s", e.Model, e.RadioModel))
+	}
+	if e.RadioFrequencyRange != "" && e.FrequencyRange != e.RadioFrequencyRange {
+		diffs = append(diffs, fmt.Sprintf("the codeplug's frequency range is %s, but the radio's is %s", e.FrequencyRange, e.RadioFrequencyRange))
+	}
+
+	return "codeplug does not match the radio: " + strings.Join(diffs, "; ")
+}
+
+// SetIgnoreModelMismatch sets whether the codeplug is written to a
+// radio even if its model or frequency range differs from that of the
+// radio.  By default, if the transport is an Identifier, WriteRadio,
+// WriteRadioRecords and WriteRadios return a *ModelMismatchError
+// rather than write to a radio that differs.
+func (cp *Codeplug) SetIgnoreModelMismatch(ignore bool) {
+	cp.ignoreModelMismatch = ignore
+}
+
+// checkRadioModel returns a *ModelMismatchError if the radio connected
+// by t is of a different model or frequency range than the codeplug.
+// Radios whose transport cannot identify them are not checked.
+func (cp *Codeplug) checkRadioModel(t Transport) error {
+	if cp.ignoreModelMismatch {
+		return nil
+	}
+
+	id, ok := t.(Identifier)
+	if !ok {
+		return nil
+	}
+
+	radioModel, radioFrequencyRange, err := id.Identify()
+	if err != nil {
+		return err
+	}
+
+	model := cp.Model()
+	frequencyRange := cp.FrequencyRange()
+	if model != radioModel ||
+		(radioFrequencyRange != "" && frequencyRange != radioFrequencyRange) {
+		return &ModelMismatchError{
+			Model:               model,
+			FrequencyRange:      frequencyRange,
+			RadioModel:          radioModel,
+			RadioFrequencyRange: radioFrequencyRange,
+		}
+	}
+
+	return nil
+}
+
+// dfuTransport is the USB DFU transport.  It identifies the radio from
+// its information block.
+type dfuTransport struct {
+	*dfu.Dfu
+}
+
+func (t dfuTransport) Identify() (model string, frequencyRange string, err error) {
+	info, err := t.RadioInfo()
+	if err != nil {
+		return "", "", err
+	}
+
+	return identifyRadioInfo(info)
+}
+
+// identifyRadioInfo returns the model and frequency range named by a
+// radio's information block, which begins the image of an .rdt file.
+// The frequency range is empty if the block doesn't name a known one.
+func identifyRadioInfo(info []byte) (model string, frequencyRange string, err error) {
+	for _, cpi := range codeplugInfos {
+		if rdtDataOffset+len(info) > cpi.BinOffset {
+			continue
+		}
+
+		cp := new(Codeplug)
+		cp.rDesc = make(map[RecordType]*rDesc)
+		cp.codeplugInfo = cpi
+		cp.bytes = bytes.Repeat([]byte{0xff}, cpi.RdtSize)
+		copy(cp.bytes[rdtDataOffset:], info)
+		cp.loadHeader()
+
+		if !containsString(cpi.Models, cp.Model()) {
+			continue
+		}
+
+		frequencyRange := cp.FrequencyRange()
+		if !containsString(cp.frequencyRanges(), frequencyRange) {
+			frequencyRange = ""
+		}
+
+		return cp.Model(), frequencyRange, nil
+	}
+
+	return "", "", fmt.Errorf("unknown radio model: %q", bytes.TrimRight(info[:8], "\x00\xff"))
+}
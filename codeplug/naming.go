@@ -0,0 +1,297 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// A NameTemplate generates record names from named variables.  Each
+// variable is written in braces, as in "{city} {tg.name} TS{slot}".
+// A literal brace is written by doubling it.
+type NameTemplate struct {
+	text  string
+	parts []namePart
+}
+
+// namePart is either literal text or the name of a variable.
+type namePart struct {
+	literal  string
+	variable string
+}
+
+// ParseNameTemplate returns the NameTemplate described by text.
+func ParseNameTemplate(text string) (*NameTemplate, error) {
+	t := &NameTemplate{text: text}
+
+	var literal bytes.Buffer
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == '{' && i+1 < len(text) && text[i+1] == '{':
+			literal.WriteByte('{')
+			i++
+
+		case c == '}' && i+1 < len(text) && text[i+1] == '}':
+			literal.WriteByte('}')
+			i++
+
+		case c == '{':
+			end := strings.IndexByte(text[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("name template %q: unclosed '{'", text)
+			}
+			variable := strings.TrimSpace(text[i+1 : i+end])
+			if variable == "" {
+				return nil, fmt.Errorf("name template %q: empty variable", text)
+			}
+			if literal.Len() > 0 {
+				t.parts = append(t.parts, namePart{literal: literal.String()})
+				literal.Reset()
+			}
+			t.parts = append(t.parts, namePart{variable: variable})
+			i += end
+
+		case c == '}':
+			return nil, fmt.Errorf("name template %q: unmatched '}'", text)
+
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	if literal.Len() > 0 {
+		t.parts = append(t.parts, namePart{literal: literal.String()})
+	}
+
+	return t, nil
+}
+
+// String returns the text of the template.
+func (t *NameTemplate) String() string {
+	return t.text
+}
+
+// Variables returns the sorted names of the template's variables.
+func (t *NameTemplate) Variables() []string {
+	seen := make(map[string]bool)
+	var variables []string
+	for _, p := range t.parts {
+		if p.variable != "" && !seen[p.variable] {
+			seen[p.variable] = true
+			variables = append(variables, p.variable)
+		}
+	}
+	sort.Strings(variables)
+
+	return variables
+}
+
+// Expand returns the name produced by substituting vars into the
+// template.  It is an error for the template to use a variable that
+// is missing from vars.
+func (t *NameTemplate) Expand(vars map[string]string) (string, error) {
+	return t.expand(vars, 0, nil)
+}
+
+// expand is like Expand, but shortens variable values until the name
+// has at most maxLen characters.  Their words in abbrevs are
+// abbreviated first, then the longest value loses letters, as by
+// ShortenName.  It fails if the name can't be shortened enough.  A
+// maxLen of zero means there is no limit.
+func (t *NameTemplate) expand(vars map[string]string, maxLen int, abbrevs map[string]string) (string, error) {
+	values := make([][]rune, len(t.parts))
+	for i, p := range t.parts {
+		if p.variable == "" {
+			continue
+		}
+		value, ok := vars[p.variable]
+		if !ok {
+			return "", fmt.Errorf("name template %q: no value for {%s}", t.text, p.variable)
+		}
+		values[i] = []rune(strings.TrimSpace(value))
+	}
+
+	join := func() string {
+		var sb bytes.Buffer
+		for i, p := range t.parts {
+			if p.variable == "" {
+				sb.WriteString(p.literal)
+			} else {
+				sb.WriteString(string(values[i]))
+			}
+		}
+		return strings.Join(strings.Fields(sb.String()), " ")
+	}
+
+	name := join()
+	for maxLen > 0 && utf8.RuneCountInString(name) > maxLen {
+		abbreviated := false
+		for i := range values {
+			value, ok := abbreviateOnce(values[i], abbrevs)
+			if ok {
+				values[i] = value
+				abbreviated = true
+				break
+			}
+		}
+		if !abbreviated {
+			longest := -1
+			var shorter []rune
+			for i := range values {
+				if longest >= 0 && len(values[i]) <= len(values[longest]) {
+					continue
+				}
+				value, ok := dropOnce(values[i])
+				if ok {
+					longest = i
+					shorter = value
+				}
+			}
+			if longest < 0 {
+				name = ShortenName(name, maxLen, abbrevs)
+				if utf8.RuneCountInString(name) > maxLen {
+					return "", fmt.Errorf("name template %q: can't shorten %q to %d characters", t.text, name, maxLen)
+				}
+				break
+			}
+			values[longest] = shorter
+		}
+		name = join()
+	}
+
+	return strings.TrimSpace(name), nil
+}
+
+// A NamingPolicy generates names for new records of a single record
+// type.  Names are shortened to the record type's name length limit,
+// and a numeric suffix is added to names that are already in use.
+type NamingPolicy struct {
+	// Template generates each name.
+	Template *NameTemplate
+
+	// MaxLength is the maximum number of characters in a name.
+	MaxLength int
+
+	// Abbreviations is the dictionary used to shorten names longer
+	// than MaxLength, as by ShortenName.
+	Abbreviations map[string]string
+
+	// SuffixFormat formats the number added to disambiguate a name.
+	// It is " %d" by default.
+	SuffixFormat string
+
+	used map[string]bool
+}
+
+// NewNamingPolicy returns a NamingPolicy that generates names for new
+// records of type rType using the given template.  Names of the
+// codeplug's existing records of that type are considered in use.
+func (cp *Codeplug) NewNamingPolicy(rType RecordType, template string) (*NamingPolicy, error) {
+	maxLen, err := cp.nameMaxLength(rType)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := ParseNameTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &NamingPolicy{
+		Template:      t,
+		MaxLength:     maxLen,
+		Abbreviations: cp.Abbreviations(),
+	}
+	for _, r := range cp.records(rType) {
+		p.Reserve(r.Name())
+	}
+
+	return p, nil
+}
+
+// nameMaxLength returns the maximum number of characters in the names
+// of records of type rType.
+func (cp *Codeplug) nameMaxLength(rType RecordType) (int, error) {
+	rd := cp.rDesc[rType]
+	if rd == nil {
+		return 0, fmt.Errorf("unknown record type: %s", rType)
+	}
+
+	ri := rd.recordInfo
+	for _, fi := range ri.fieldInfos {
+		if fi.fType == ri.nameFieldType {
+			return fi.bitSize / 16, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%s records have no name", rType)
+}
+
+// Reserve marks name as in use, so that it will not be generated.
+func (p *NamingPolicy) Reserve(name string) {
+	if p.used == nil {
+		p.used = make(map[string]bool)
+	}
+	p.used[name] = true
+}
+
+// Name returns a new name generated from vars, and marks it as in use.
+func (p *NamingPolicy) Name(vars map[string]string) (string, error) {
+	name, err := p.Template.expand(vars, p.MaxLength, p.Abbreviations)
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		return "", fmt.Errorf("name template %q: empty name", p.Template)
+	}
+
+	suffixFormat := p.SuffixFormat
+	if suffixFormat == "" {
+		suffixFormat = " %d"
+	}
+
+	newName := name
+	for n := 2; p.used[newName]; n++ {
+		suffix := fmt.Sprintf(suffixFormat, n)
+		room := p.MaxLength - utf8.RuneCountInString(suffix)
+		if room <= 0 {
+			return "", fmt.Errorf("too many records named %q", name)
+		}
+		base, err := p.Template.expand(vars, room, p.Abbreviations)
+		if err != nil {
+			return "", err
+		}
+		newName = base + suffix
+	}
+
+	p.Reserve(newName)
+
+	return newName, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// gzipMagic begins every gzip-compressed file.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// zipMagic begins every zip archive, and so every .xlsx file.
+var zipMagic = []byte("PK\x03\x04")
+
+// sniffLength is the number of leading bytes examined to tell a text
+// file from a binary one.
+const sniffLength = 4096
+
+// DetectFileType returns the type of the codeplug file whose name and
+// contents are given, as passed to NewCodeplug.  Spreadsheets are
+// recognized by their zip signature and rdt images by their DfuSe
+// container.  bin images, including flash dumps, are binary files of
+// their size.  All of these are reported as FileTypeNone.  JSON files
+// are recognized by their leading "{" and other text files as text.
+// The extension decides only where the contents are not conclusive,
+// and a file named as an rdt or bin image must be one.
+func DetectFileType(name string, data []byte) (FileType, error) {
+	ext := strings.ToLower(filepath.Ext(name))
+	switch {
+	case bytes.HasPrefix(data, zipMagic):
+		return FileTypeXLSX, nil
+	case isImage(data):
+		return FileTypeNone, nil
+	case ext == ".rdt" || ext == ".bin":
+		if isImageSize(int64(len(data))) {
+			// A corrupt image, reported when it is loaded
+			return FileTypeNone, nil
+		}
+		// An image of the wrong size
+	case bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")):
+		return FileTypeJSON, nil
+	case isText(data):
+		if ext == ".json" {
+			return FileTypeJSON, nil
+		}
+		return FileTypeText, nil
+	}
+
+	return FileTypeNone, fmt.Errorf("%s: is not a known codeplug file type", name)
+}
+
+// isImage returns true if data is an rdt image with a valid DfuSe
+// container, or a binary file of the size of a bin image or flash dump.
+func isImage(data []byte) bool {
+	size := int64(len(data))
+	for _, cpi := range codeplugInfos {
+		if size == int64(cpi.RdtSize) && checkRdt(data) == nil {
+			return true
+		}
+	}
+	if isText(data) {
+		return false
+	}
+	for _, cpi := range codeplugInfos {
+		if size == int64(cpi.BinSize) {
+			return true
+		}
+	}
+
+	return isFlashDumpSize(size)
+}
+
+// isImageSize returns true if size is that of an rdt or bin image or of
+// a flash dump.
+func isImageSize(size int64) bool {
+	for _, cpi := range codeplugInfos {
+		if size == int64(cpi.RdtSize) || size == int64(cpi.BinSize) {
+			return true
+		}
+	}
+
+	return isFlashDumpSize(size)
+}
+
+// isText returns true if the start of data looks like UTF-8 text.
+func isText(data []byte) bool {
+	if len(data) > sniffLength {
+		data = data[:sniffLength]
+		// Don't reject a rune cut in two at the end.
+		for i := 0; i < utf8.UTFMax && !utf8.Valid(data); i++ {
+			data = data[:len(data)-1]
+		}
+	}
+
+	return utf8.Valid(data) && bytes.IndexByte(data, 0) < 0
+}
+
+// NewCodeplugFromFile returns a Codeplug for filename, whose type is
+// detected from its contents by DetectFileType.  A gzip-compressed file
+// of any type is decompressed, and the codeplug of a zip bundle, as
+// found by ReadBundle, is extracted.  Such a codeplug is saved,
+// uncompressed, to the name of the file it was found in, without any
+// ".gz" extension, in filename's directory.  The codeplug must then be
+// loaded with Load.
+func NewCodeplugFromFile(filename string) (*Codeplug, error) {
+	return NewCodeplugFromFileFS(vfs.OS, filename)
+}
+
+// NewCodeplugFromFileFS is NewCodeplugFromFile for a codeplug whose
+// files are read from and written to fsys.
+func NewCodeplugFromFileFS(fsys vfs.FS, filename string) (*Codeplug, error) {
+	data, err := fs.ReadFile(fsys, filename)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			err = fmt.Errorf("%s: does not exist", filename)
+		}
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		return newCodeplugFromData(fsys, filename, data)
+
+	case isBundle(data):
+		name, contents, err := ReadBundle(filename, data)
+		if err != nil {
+			return nil, err
+		}
+		name = filepath.Join(filepath.Dir(filename), name)
+		return newCodeplugFromData(fsys, name, contents)
+	}
+
+	fType, err := DetectFileType(filename, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCodeplugFS(fsys, fType, filename)
+}
+
+// newCodeplugFromData returns a Codeplug holding data, the contents of
+// the file name, decompressing it if it is gzip-compressed.  The
+// codeplug's files are written to fsys.
+func newCodeplugFromData(fsys vfs.FS, name string, data []byte) (*Codeplug, error) {
+	if bytes.HasPrefix(data, gzipMagic) {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err.Error())
+		}
+		data, err = ioutil.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err.Error())
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	fType, err := DetectFileType(name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := NewCodeplugFromBytes(fType, name, data)
+	if err != nil {
+		return nil, err
+	}
+	cp.SetFileSystem(fsys)
+
+	return cp, nil
+}
+
+// Open returns the codeplug in filename, loaded as the model and
+// frequency range it records, ignoring any warnings.  The file is of
+// any type recognized by NewCodeplugFromFile.  The model and radio type
+// are then given by the codeplug's Model and Type methods, and the
+// file's type by FileType.
+func Open(filename string) (*Codeplug, error) {
+	cp, err := NewCodeplugFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	models, frequencyRanges := cp.ModelsFrequencyRanges()
+	if len(models) == 0 {
+		return nil, errors.New("unknown model in codeplug")
+	}
+	model := models[0]
+	if len(frequencyRanges[model]) == 0 {
+		return nil, errors.New("unknown frequency range in codeplug")
+	}
+
+	ignoreWarnings := true
+	err = cp.Load(model, frequencyRanges[model][0], ignoreWarnings)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
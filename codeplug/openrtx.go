@@ -0,0 +1,302 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// OpenRTX firmware runs on MD-380 and MD-UV380 hardware and adds M17
+// to FM and DMR.  WriteOpenRTX writes the codeplug as JSON following
+// OpenRTX's channel, contact and bank structures: frequencies are in
+// Hz, power in milliwatts, and contacts and channels are referenced by
+// their zero-based index.
+//
+// The radio's codeplug has no M17 fields, so they are taken from tags.
+// An analog channel tagged "m17" is written as an M17 channel.  Its
+// channel access number is given by a tag "m17-can-<n>", and its
+// destination callsign, if not broadcast, by a tag "m17-dst-<call>".
+// DCS codes, which OpenRTX does not support, are omitted.
+
+// OpenRTXTag marks an analog channel to be written as an M17 channel.
+const OpenRTXTag = "m17"
+
+const (
+	openRTXCanTag  = "m17-can-"
+	openRTXDstTag  = "m17-dst-"
+	openRTXVersion = 1
+)
+
+type openRTXCodeplug struct {
+	Format   string           `json:"format"`
+	Version  int              `json:"version"`
+	Settings openRTXSettings  `json:"settings"`
+	Contacts []openRTXContact `json:"contacts"`
+	Channels []openRTXChannel `json:"channels"`
+	Banks    []openRTXBank    `json:"banks"`
+}
+
+type openRTXSettings struct {
+	Callsign string `json:"callsign"`
+	DmrID    uint32 `json:"dmrId"`
+}
+
+type openRTXContact struct {
+	Name string            `json:"name"`
+	Mode string            `json:"mode"`
+	DMR  *openRTXDMRTarget `json:"dmr,omitempty"`
+}
+
+type openRTXDMRTarget struct {
+	ID   uint32 `json:"id"`
+	Type string `json:"type"`
+}
+
+type openRTXChannel struct {
+	Name        string          `json:"name"`
+	Mode        string          `json:"mode"`
+	Bandwidth   int             `json:"bandwidth"`
+	Power       int             `json:"power"`
+	RxFrequency int64           `json:"rxFrequency"`
+	TxFrequency int64           `json:"txFrequency"`
+	RxOnly      bool            `json:"rxOnly"`
+	FM          *openRTXFM      `json:"fm,omitempty"`
+	DMR         *openRTXDMR     `json:"dmr,omitempty"`
+	M17         *openRTXM17Info `json:"m17,omitempty"`
+}
+
+type openRTXFM struct {
+	RxTone float64 `json:"rxTone,omitempty"`
+	TxTone float64 `json:"txTone,omitempty"`
+}
+
+type openRTXDMR struct {
+	ColorCode int  `json:"colorCode"`
+	Timeslot  int  `json:"timeslot"`
+	Contact   *int `json:"contact,omitempty"`
+}
+
+type openRTXM17Info struct {
+	RxCan       int    `json:"rxCan"`
+	TxCan       int    `json:"txCan"`
+	Destination string `json:"destination,omitempty"`
+}
+
+type openRTXBank struct {
+	Name     string `json:"name"`
+	Channels []int  `json:"channels"`
+}
+
+// openRTXPowers holds the transmit power, in milliwatts, of each of
+// the codeplug's power levels.
+var openRTXPowers = map[string]int{
+	"Low":    1000,
+	"Middle": 2500,
+	"High":   5000,
+}
+
+// openRTXHz returns the frequency, given in MHz, in Hz.
+func openRTXHz(s string) (int64, error) {
+	mhz, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad frequency: %s", s)
+	}
+
+	return int64(math.Round(mhz * 1e6)), nil
+}
+
+// openRTXTone returns the CTCSS tone, in Hz, of s, or 0 if s is not a
+// CTCSS tone.
+func openRTXTone(s string) float64 {
+	tone, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+
+	return tone
+}
+
+// openRTXM17 returns the M17 settings given by the channel's tags.
+func openRTXM17(r *Record) (*openRTXM17Info, error) {
+	m17 := new(openRTXM17Info)
+	for _, tag := range r.Tags() {
+		switch {
+		case strings.HasPrefix(tag, openRTXCanTag):
+			can, err := strconv.Atoi(strings.TrimPrefix(tag, openRTXCanTag))
+			if err != nil || can < 0 || can > 15 {
+				return nil, fmt.Errorf("%s: bad M17 channel access number tag: %s", r.Name(), tag)
+			}
+			m17.RxCan = can
+			m17.TxCan = can
+		case strings.HasPrefix(tag, openRTXDstTag):
+			m17.Destination = strings.ToUpper(strings.TrimPrefix(tag, openRTXDstTag))
+		}
+	}
+
+	return m17, nil
+}
+
+// newOpenRTXCodeplug returns the codeplug in OpenRTX form.
+func (cp *Codeplug) newOpenRTXCodeplug() (*openRTXCodeplug, error) {
+	o := &openRTXCodeplug{
+		Format:   "openrtx",
+		Version:  openRTXVersion,
+		Contacts: []openRTXContact{},
+		Channels: []openRTXChannel{},
+		Banks:    []openRTXBank{},
+	}
+
+	for _, r := range cp.records(RtGeneralSettings_md380) {
+		fields := strings.Fields(recordString(r, FtGsRadioName))
+		if len(fields) > 0 {
+			o.Settings.Callsign = strings.ToUpper(fields[0])
+		}
+		id, err := ParseDmrID(recordString(r, FtGsRadioID))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", FtGsRadioID, err.Error())
+		}
+		o.Settings.DmrID = uint32(id)
+	}
+
+	contactIndexes := make(map[string]int)
+	for _, r := range cp.records(RtContacts) {
+		id, err := ParseDmrID(recordString(r, FtDcCallID))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name(), err.Error())
+		}
+		contactIndexes[r.Name()] = len(o.Contacts)
+		o.Contacts = append(o.Contacts, openRTXContact{
+			Name: r.Name(),
+			Mode: "DMR",
+			DMR: &openRTXDMRTarget{
+				ID:   uint32(id),
+				Type: recordString(r, FtDcCallType),
+			},
+		})
+	}
+
+	channelIndexes := make(map[string]int)
+	for _, r := range cp.records(RtChannels_md380) {
+		ch := openRTXChannel{
+			Name:   r.Name(),
+			Power:  openRTXPowers[recordString(r, FtCiPower)],
+			RxOnly: recordString(r, FtCiRxOnly) == "On",
+		}
+
+		var err error
+		ch.RxFrequency, err = openRTXHz(recordString(r, FtCiRxFrequency))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name(), err.Error())
+		}
+		ch.TxFrequency, err = openRTXHz(recordString(r, FtCiTxFrequency))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", r.Name(), err.Error())
+		}
+
+		khz, _ := strconv.ParseFloat(recordString(r, FtCiBandwidth), 64)
+		ch.Bandwidth = int(math.Round(khz * 1000))
+
+		switch {
+		case recordString(r, FtCiChannelMode) == "Digital":
+			ch.Mode = "DMR"
+			ch.DMR = new(openRTXDMR)
+			ch.DMR.ColorCode, _ = strconv.Atoi(recordString(r, FtCiColorCode))
+			ch.DMR.Timeslot, _ = strconv.Atoi(recordString(r, FtCiRepeaterSlot))
+			if i, ok := contactIndexes[recordString(r, FtCiContactName)]; ok {
+				ch.DMR.Contact = &i
+			}
+		case r.HasTag(OpenRTXTag):
+			ch.Mode = "M17"
+			ch.M17, err = openRTXM17(r)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			ch.Mode = "FM"
+			ch.FM = &openRTXFM{
+				RxTone: openRTXTone(recordString(r, FtCiCtcssDecode)),
+				TxTone: openRTXTone(recordString(r, FtCiCtcssEncode)),
+			}
+		}
+
+		channelIndexes[ch.Name] = len(o.Channels)
+		o.Channels = append(o.Channels, ch)
+	}
+
+	for _, r := range cp.records(RtZones_md380) {
+		bank := openRTXBank{Name: r.Name(), Channels: []int{}}
+		for _, f := range r.Fields(FtZiChannel_md380) {
+			if i, ok := channelIndexes[f.String()]; ok {
+				bank.Channels = append(bank.Channels, i)
+			}
+		}
+		o.Banks = append(o.Banks, bank)
+	}
+
+	return o, nil
+}
+
+// WriteOpenRTX writes the codeplug to w in the JSON form described
+// above, for OpenRTX firmware.
+func (cp *Codeplug) WriteOpenRTX(w io.Writer) error {
+	o, err := cp.newOpenRTXCodeplug()
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+
+	return encoder.Encode(o)
+}
+
+// ExportOpenRTX writes the codeplug to an OpenRTX JSON file.
+func (cp *Codeplug) ExportOpenRTX(filename string) (err error) {
+	file, err := cp.fsys.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+	}()
+
+	w := bufio.NewWriter(file)
+	err = cp.WriteOpenRTX(w)
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,260 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// overlayRecord is a record of an overlay and the field values it sets.
+type overlayRecord struct {
+	rType   RecordType
+	name    string
+	index   int
+	pos     *position
+	fvs     []fieldValue
+	pseudos []*parsedField
+}
+
+// ApplyOverlayFile applies an overlay file to the codeplug.  The file
+// is in JSON if its name ends in ".json", and in text otherwise.  If
+// the file is signed, its signature is verified.
+func (cp *Codeplug) ApplyOverlayFile(filename string) error {
+	data, err := fs.ReadFile(cp.fsys, filename)
+	if err != nil {
+		return err
+	}
+
+	fileType := FileTypeText
+	if strings.ToLower(filepath.Ext(filename)) == ".json" {
+		fileType = FileTypeJSON
+	}
+
+	content, _, err := splitProvenance(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	err = cp.applyOverlay(bytes.NewReader(content), fileType, filename)
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return nil
+}
+
+// ApplyOverlay applies an overlay, in the text or JSON codeplug file
+// format, to the codeplug.  An overlay holds only the records and
+// fields to change.  A record of a type the codeplug has only one of,
+// such as GeneralSettings, changes the fields given.  Other records
+// are identified by their Name field: an existing record of that name
+// has the fields given changed, otherwise a new record is added.
+// Records without names, such as GPSSystems, are identified by index.  The
+// fields given for a field type that may occur more than once, such as
+// a zone's Channel, replace the existing fields of that type.  If any
+// of the overlay's values is bad, the codeplug is left unchanged.
+func (cp *Codeplug) ApplyOverlay(r io.Reader, fileType FileType) error {
+	return cp.applyOverlay(r, fileType, "")
+}
+
+// applyOverlay applies an overlay read from the file filename, which
+// is used to find the files a text overlay includes.
+func (cp *Codeplug) applyOverlay(r io.Reader, fileType FileType, filename string) error {
+	var pRecs []*parsedRecord
+	switch fileType {
+	case FileTypeText:
+		pRecs = cp.parseTextFile(r, filename)
+	case FileTypeJSON:
+		pRecs = cp.parseJSONFile(r)
+	default:
+		return fmt.Errorf("overlays must be text or JSON")
+	}
+
+	oRecs, err := cp.overlayRecords(pRecs)
+	if err != nil {
+		return err
+	}
+
+	added := make(map[RecordType]map[string]bool)
+	for _, or := range oRecs {
+		if or.name == "" || cp.FindRecordByName(or.rType, or.name) != nil {
+			continue
+		}
+		if added[or.rType] == nil {
+			added[or.rType] = make(map[string]bool)
+		}
+		added[or.rType][or.name] = true
+	}
+	for rType, names := range added {
+		if len(cp.records(rType))+len(names) > cp.MaxRecords(rType) {
+			return fmt.Errorf("too many %s", rType)
+		}
+	}
+
+	// Add the new records first, so that the overlay's records may
+	// refer to each other in any order.
+	var newRecords []*Record
+	removeNewRecords := func() {
+		for _, r := range newRecords {
+			cp.RemoveRecord(r)
+		}
+	}
+	for _, or := range oRecs {
+		if or.name == "" || cp.FindRecordByName(or.rType, or.name) != nil {
+			continue
+		}
+		nameFieldType := cp.rDesc[or.rType].nameFieldType
+		r, err := cp.addRecord(or.rType, []fieldValue{{nameFieldType, or.name}})
+		if err != nil {
+			removeNewRecords()
+			return overlayError(or.pos, fmt.Errorf("%s %s: %s", or.rType, or.name, err.Error()))
+		}
+		newRecords = append(newRecords, r)
+	}
+
+	targets := make([]*Record, len(oRecs))
+	for i, or := range oRecs {
+		records := cp.Records(or.rType)
+		switch {
+		case or.name != "":
+			targets[i] = cp.FindRecordByName(or.rType, or.name)
+		case or.index < len(records):
+			targets[i] = records[or.index]
+		default:
+			removeNewRecords()
+			return overlayError(or.pos, fmt.Errorf("%s[%d]: no such record", or.rType, or.index+1))
+		}
+	}
+
+	// Try each record's values on a copy before changing the
+	// codeplug, so that a bad value leaves it as it was.
+	for i, or := range oRecs {
+		err := or.apply(targets[i].Copy())
+		if err != nil {
+			removeNewRecords()
+			return err
+		}
+	}
+
+	for i, or := range oRecs {
+		err := or.apply(targets[i])
+		if err != nil {
+			removeNewRecords()
+			cp.changed = true
+			return err
+		}
+	}
+
+	cp.changed = true
+
+	return nil
+}
+
+// apply sets the overlay record's field values and pseudo-fields
+// in r.
+func (or *overlayRecord) apply(r *Record) error {
+	err := r.setFieldValues(or.fvs)
+	if err != nil {
+		return overlayError(or.pos, fmt.Errorf("%s %s: %s", or.rType, or.name, err.Error()))
+	}
+
+	for _, pf := range or.pseudos {
+		err := r.checkPseudoFieldUnlocked(pf.name, pf.value)
+		if err != nil {
+			return overlayError(pf.pos, err)
+		}
+		err = r.setPseudoField(pf.name, pf.value)
+		if err != nil {
+			return overlayError(pf.pos, err)
+		}
+	}
+
+	return nil
+}
+
+// overlayRecords converts the parsed records of an overlay into
+// overlayRecords, checking their record and field names.
+func (cp *Codeplug) overlayRecords(pRecs []*parsedRecord) ([]*overlayRecord, error) {
+	var oRecs []*overlayRecord
+	for _, pr := range pRecs {
+		if pr.err != nil {
+			return nil, overlayError(pr.pos, pr.err)
+		}
+
+		rType, err := cp.nameToRt(pr.name)
+		if err != nil {
+			return nil, overlayError(pr.pos, err)
+		}
+		or := &overlayRecord{rType: rType, index: pr.index, pos: pr.pos}
+
+		rd := cp.rDesc[rType]
+		for _, pf := range pr.pFields {
+			if pf.err != nil {
+				return nil, overlayError(pf.pos, pf.err)
+			}
+
+			if isPseudoFieldName(pf.name) {
+				or.pseudos = append(or.pseudos, pf)
+				continue
+			}
+
+			fType, err := cp.nameToFt(rType, pf.name)
+			if err != nil {
+				return nil, overlayError(pf.pos, err)
+			}
+			if rd.max > 1 && fType == rd.nameFieldType {
+				or.name = pf.value
+				continue
+			}
+			or.fvs = append(or.fvs, fieldValue{fType, pf.value})
+		}
+
+		if rd.max > 1 && rd.nameFieldType != "" && or.name == "" {
+			return nil, overlayError(pr.pos, fmt.Errorf("%s record has no %s", rType, rd.nameFieldType))
+		}
+
+		oRecs = append(oRecs, or)
+	}
+
+	return oRecs, nil
+}
+
+// overlayError prefixes err with its position in the overlay file, if
+// known.
+func overlayError(pos *position, err error) error {
+	if pos == nil {
+		return err
+	}
+	if pos.filename != "" {
+		return fmt.Errorf("%s: line %d:%d: %s", pos.filename, pos.line+1, pos.column+1, err.Error())
+	}
+	return fmt.Errorf("line %d:%d: %s", pos.line+1, pos.column+1, err.Error())
+}
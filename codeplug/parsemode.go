@@ -0,0 +1,149 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseMode selects how records and fields that a codeplug doesn't
+// know, such as those in files written by a newer version, are treated
+// when a text, JSON or spreadsheet file is imported.
+type ParseMode int
+
+const (
+	// ParseNormal, the default, rejects a file with an unknown
+	// record, and warns of unknown fields and invalid values.
+	ParseNormal ParseMode = iota
+
+	// ParseStrict rejects a file with an unknown record or field.
+	ParseStrict
+
+	// ParseLenient skips unknown records and fields, and invalid
+	// values, loading the rest of the file.  The problems are
+	// available from ParseWarnings.
+	ParseLenient
+)
+
+var parseModeNames = map[ParseMode]string{
+	ParseNormal:  "normal",
+	ParseStrict:  "strict",
+	ParseLenient: "lenient",
+}
+
+func (m ParseMode) String() string {
+	return parseModeNames[m]
+}
+
+// ParseParseMode returns the ParseMode named by s: "normal", "strict",
+// "lenient", or "" for ParseNormal.
+func ParseParseMode(s string) (ParseMode, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return ParseNormal, nil
+	}
+	for mode, name := range parseModeNames {
+		if s == name {
+			return mode, nil
+		}
+	}
+
+	return ParseNormal, fmt.Errorf("bad parse mode: %s", s)
+}
+
+// SetParseMode sets how the codeplug treats unknown records and
+// fields when it is loaded from, or has records parsed from, a text,
+// JSON or spreadsheet file.
+func (cp *Codeplug) SetParseMode(mode ParseMode) {
+	cp.parseMode = mode
+}
+
+// ParseMode returns the codeplug's parse mode.
+func (cp *Codeplug) ParseMode() ParseMode {
+	return cp.parseMode
+}
+
+// A ParseWarning describes a problem found, and skipped, while parsing
+// a text, JSON or spreadsheet file.
+type ParseWarning struct {
+	// Filename names the file, if it was included by another.
+	Filename string
+
+	// Line and Column locate the problem in a text file.  They are
+	// zero for other files.
+	Line   int
+	Column int
+
+	// Record and Field name the record and field, if known.
+	Record string
+	Field  string
+
+	Message string
+}
+
+func (w ParseWarning) String() string {
+	var prefix string
+	if w.Filename != "" {
+		prefix = w.Filename + ": "
+	}
+	if w.Line != 0 {
+		prefix += fmt.Sprintf("line %d:%d: ", w.Line, w.Column)
+	}
+	name := w.Record
+	if w.Field != "" {
+		name += "." + w.Field
+	}
+
+	return fmt.Sprintf("%s%s: %s", prefix, name, w.Message)
+}
+
+// ParseWarnings returns the warnings found by the most recent parse of
+// a text, JSON or spreadsheet file into the codeplug.
+func (cp *Codeplug) ParseWarnings() []ParseWarning {
+	return cp.parseWarnings
+}
+
+// newParseWarning returns a warning about the named record and field.
+func newParseWarning(pos *position, record, field string, err error) ParseWarning {
+	w := ParseWarning{
+		Record:  record,
+		Field:   field,
+		Message: err.Error(),
+	}
+	if pos != nil {
+		w.Filename = pos.filename
+		w.Line = pos.line + 1
+		w.Column = pos.column + 1
+	}
+
+	return w
+}
+
+// addParseWarning records a warning about the named record and field.
+func (cp *Codeplug) addParseWarning(pos *position, record, field string, err error) {
+	cp.parseWarnings = append(cp.parseWarnings, newParseWarning(pos, record, field, err))
+}
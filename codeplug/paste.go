@@ -0,0 +1,538 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Fields of a pasted repeater listing, recording which have been seen.
+const (
+	listingOutput = 1 << iota
+	listingInput
+	listingOffset
+	listingColorCode
+	listingTone
+	listingCallsign
+	listingCity
+)
+
+// listingLabels maps the labels of a pasted repeater listing, in lower
+// case and without spaces, to the fields they introduce.  Tx and Rx
+// are from the repeater's point of view, as on Brandmeister's pages.
+var listingLabels = map[string]int{
+	"tx":          listingOutput,
+	"output":      listingOutput,
+	"downlink":    listingOutput,
+	"frequency":   listingOutput,
+	"freq":        listingOutput,
+	"rx":          listingInput,
+	"input":       listingInput,
+	"inputfreq":   listingInput,
+	"uplink":      listingInput,
+	"offset":      listingOffset,
+	"shift":       listingOffset,
+	"cc":          listingColorCode,
+	"colorcode":   listingColorCode,
+	"colourcode":  listingColorCode,
+	"dmrcc":       listingColorCode,
+	"tone":        listingTone,
+	"uplinktone":  listingTone,
+	"pl":          listingTone,
+	"ctcss":       listingTone,
+	"dcs":         listingTone,
+	"callsign":    listingCallsign,
+	"call":        listingCallsign,
+	"city":        listingCity,
+	"location":    listingCity,
+	"nearestcity": listingCity,
+	"qth":         listingCity,
+}
+
+var (
+	listingLabelPattern    = regexp.MustCompile(`^([A-Za-z][A-Za-z ]*?)\s*:\s*(.*)$`)
+	listingCCPattern       = regexp.MustCompile(`^cc:?([0-9]{1,2})$`)
+	listingDCSPattern      = regexp.MustCompile(`^d([0-7]{3})([ni]?)$`)
+	listingCallsignPattern = regexp.MustCompile(`^[A-Z0-9]{0,3}[0-9][A-Z]{1,4}(/[A-Z0-9]+)?$`)
+)
+
+// A listingEntry is a repeater being parsed from a pasted listing.
+type listingEntry struct {
+	rptr    Repeater
+	offset  float64
+	seen    int
+	dmr     bool
+	analog  bool
+	offAir  bool
+	labeled bool
+}
+
+// set records that field has been given, returning false if it
+// already had been.
+func (e *listingEntry) set(field int) bool {
+	if e.seen&field != 0 {
+		return false
+	}
+	e.seen |= field
+	return true
+}
+
+// parseListingFrequency parses a frequency in MHz, possibly followed
+// by "MHz".
+func parseListingFrequency(s string) (float64, bool) {
+	s = strings.TrimSuffix(strings.ToLower(s), "mhz")
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 28 || f > 1300 {
+		return 0, false
+	}
+
+	return f, true
+}
+
+// parseListingTone returns the CTCSS tone or DCS code s in the form
+// used by the codeplug, as in "100.0" or "D023N".
+func parseListingTone(s string) (string, bool) {
+	lower := strings.ToLower(strings.TrimSuffix(strings.ToLower(s), "hz"))
+	if m := listingDCSPattern.FindStringSubmatch(lower); m != nil {
+		polarity := strings.ToUpper(m[2])
+		if polarity == "" {
+			polarity = "N"
+		}
+		tone := "D" + m[1] + polarity
+		return tone, ctcssDcsStringToBinary(tone) >= 0
+	}
+
+	f, err := strconv.ParseFloat(lower, 64)
+	if err != nil {
+		return "", false
+	}
+	tone := strconv.FormatFloat(f, 'f', 1, 64)
+
+	return tone, ctcssDcsStringToBinary(tone) >= 0
+}
+
+// parseValue parses s as the value of the labeled field.
+func (e *listingEntry) parseValue(field int, s string) bool {
+	switch field {
+	case listingOutput, listingInput:
+		f, ok := parseListingFrequency(s)
+		if !ok || !e.set(field) {
+			return false
+		}
+		if field == listingOutput {
+			e.rptr.Frequency = f
+		} else {
+			e.rptr.Input = f
+		}
+
+	case listingOffset:
+		s = strings.TrimSuffix(strings.ToLower(s), "mhz")
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || !e.set(field) {
+			return false
+		}
+		e.offset = f
+
+	case listingColorCode:
+		cc, err := strconv.Atoi(s)
+		if err != nil || cc < 0 || cc > 15 || !e.set(field) {
+			return false
+		}
+		e.rptr.ColorCode = cc
+		e.dmr = true
+
+	case listingTone:
+		tone, ok := parseListingTone(s)
+		if !ok || !e.set(field) {
+			return false
+		}
+		e.rptr.Tone = tone
+
+	case listingCallsign:
+		if !e.set(field) {
+			return false
+		}
+		e.rptr.Callsign = strings.ToUpper(s)
+
+	case listingCity:
+		if !e.set(field) {
+			return false
+		}
+		e.rptr.City = s
+	}
+
+	return true
+}
+
+// parseWord parses a word of a listing that has no label, returning
+// whether it was recognized.
+func (e *listingEntry) parseWord(word string) bool {
+	lower := strings.ToLower(word)
+	switch lower {
+	case "mhz", "-", "+":
+		return true
+	case "dmr":
+		e.dmr = true
+		return true
+	case "fm", "nfm", "analog":
+		e.analog = true
+		return true
+	case "on-air", "online":
+		return true
+	case "off-air", "offline":
+		e.offAir = true
+		return true
+	}
+
+	if m := listingCCPattern.FindStringSubmatch(lower); m != nil {
+		return e.parseValue(listingColorCode, m[1])
+	}
+
+	if strings.HasPrefix(word, "+") || strings.HasPrefix(word, "-") {
+		return e.parseValue(listingOffset, word)
+	}
+
+	if listingDCSPattern.MatchString(lower) {
+		return e.parseValue(listingTone, word)
+	}
+
+	// A number with one decimal is taken as a tone, as in "100.0",
+	// and others as frequencies, as in "146.940".
+	if i := strings.IndexByte(word, '.'); i >= 0 && len(word)-i == 2 {
+		if e.parseValue(listingTone, word) {
+			return true
+		}
+	}
+	if _, ok := parseListingFrequency(word); ok {
+		if e.seen&listingOutput == 0 {
+			return e.parseValue(listingOutput, word)
+		}
+		return e.parseValue(listingInput, word)
+	}
+
+	if e.seen&listingCallsign == 0 && listingCallsignPattern.MatchString(word) {
+		return e.parseValue(listingCallsign, word)
+	}
+
+	return false
+}
+
+// parseWords parses the words of a line or column of a listing,
+// returning whether any was recognized.
+func (e *listingEntry) parseWords(words []string) bool {
+	recognized := false
+	for i := 0; i < len(words); i++ {
+		word := strings.Trim(words[i], ",;()")
+		label := strings.ToLower(strings.TrimSuffix(word, ":"))
+		if (label == "color" || label == "colour") && i+1 < len(words) &&
+			strings.HasPrefix(strings.ToLower(words[i+1]), "code") {
+			label = "colorcode"
+			i++
+		}
+
+		field, ok := listingLabels[label]
+		if ok && i+1 < len(words) {
+			if i == 0 {
+				e.labeled = true
+			}
+			i++
+			value := strings.Trim(words[i], ",;()")
+			if value == ":" && i+1 < len(words) {
+				i++
+				value = strings.Trim(words[i], ",;()")
+			}
+			if field == listingCity {
+				value = strings.Join(words[i:], " ")
+				i = len(words)
+			}
+			if e.parseValue(field, value) {
+				recognized = true
+			}
+			continue
+		}
+
+		if e.parseWord(word) {
+			recognized = true
+		}
+	}
+
+	return recognized
+}
+
+// parseListingLine parses a line of a listing.
+func parseListingLine(line string) *listingEntry {
+	e := new(listingEntry)
+
+	if m := listingLabelPattern.FindStringSubmatch(line); m != nil {
+		label := strings.ToLower(strings.Replace(m[1], " ", "", -1))
+		field, ok := listingLabels[label]
+		if !ok {
+			return e
+		}
+		e.labeled = true
+		value := strings.TrimSpace(m[2])
+		if field == listingCity || field == listingCallsign {
+			e.parseValue(field, value)
+			return e
+		}
+
+		words := strings.Fields(value)
+		if len(words) > 0 && e.parseValue(field, words[0]) {
+			words = words[1:]
+		}
+		e.parseWords(words)
+		return e
+	}
+
+	// The columns of a table row are separated by tabs.  The first
+	// column that is not otherwise recognized names the repeater's
+	// location, as in RepeaterBook's tables.
+	for _, column := range strings.Split(line, "\t") {
+		words := strings.Fields(column)
+		if e.parseWords(words) || len(words) == 0 {
+			continue
+		}
+		if !strings.Contains(line, "\t") {
+			continue
+		}
+		if e.seen&listingCity == 0 && strings.IndexFunc(column, isLetter) >= 0 {
+			e.parseValue(listingCity, strings.TrimSpace(column))
+		}
+	}
+
+	return e
+}
+
+func isLetter(r rune) bool {
+	return r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z'
+}
+
+// merge adds the fields of other to e, returning false if they
+// conflict.
+func (e *listingEntry) merge(other *listingEntry) bool {
+	if e.seen&other.seen != 0 {
+		return false
+	}
+
+	if other.seen&listingOutput != 0 {
+		e.rptr.Frequency = other.rptr.Frequency
+	}
+	if other.seen&listingInput != 0 {
+		e.rptr.Input = other.rptr.Input
+	}
+	if other.seen&listingOffset != 0 {
+		e.offset = other.offset
+	}
+	if other.seen&listingColorCode != 0 {
+		e.rptr.ColorCode = other.rptr.ColorCode
+	}
+	if other.seen&listingTone != 0 {
+		e.rptr.Tone = other.rptr.Tone
+	}
+	if other.seen&listingCallsign != 0 {
+		e.rptr.Callsign = other.rptr.Callsign
+	}
+	if other.seen&listingCity != 0 {
+		e.rptr.City = other.rptr.City
+	}
+	e.seen |= other.seen
+	e.dmr = e.dmr || other.dmr
+	e.analog = e.analog || other.analog
+	e.offAir = e.offAir || other.offAir
+
+	return true
+}
+
+// repeater returns the repeater described by e, or nil if it has no
+// frequency.
+func (e *listingEntry) repeater() *Repeater {
+	if e.seen&listingOutput == 0 {
+		return nil
+	}
+
+	rptr := e.rptr
+	if e.seen&listingInput == 0 {
+		rptr.Input = rptr.Frequency + e.offset
+	}
+	rptr.DMR = e.dmr
+	rptr.Analog = e.analog || !e.dmr
+	if rptr.DMR && e.seen&listingColorCode == 0 {
+		rptr.ColorCode = 1
+	}
+	rptr.Operational = !e.offAir
+
+	return &rptr
+}
+
+// ParseRepeaterListing parses repeaters from text pasted from a web
+// page or message, such as rows of RepeaterBook's tables, the details
+// on a Brandmeister repeater's page, or lines such as
+// "439.9875 -9.4 CC1".  Each line holding a frequency describes a
+// repeater, except that consecutive lines introduced by a label, such
+// as "Tx: 439.9875" and "Rx: 430.5875", describe a single repeater.
+// The first frequency, or that labeled Tx or Output, is the repeater's
+// output; the second, or that labeled Rx or Input, its input.  A
+// signed number is the offset of the input from the output, in MHz.
+// A repeater with a color code, or marked DMR, is a DMR repeater, with
+// color code 1 unless another is given; others are analog.  Lines
+// without a
+// frequency, such as table headings, are ignored.
+func ParseRepeaterListing(text string) ([]Repeater, error) {
+	var repeaters []Repeater
+	var entry *listingEntry
+
+	flush := func() {
+		if entry != nil {
+			if rptr := entry.repeater(); rptr != nil {
+				repeaters = append(repeaters, *rptr)
+			}
+		}
+		entry = nil
+	}
+
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		e := parseListingLine(line)
+		if e.seen == 0 && !e.dmr && !e.analog {
+			continue
+		}
+		if entry != nil && e.labeled && entry.merge(e) {
+			continue
+		}
+		flush()
+		entry = e
+	}
+	flush()
+
+	if len(repeaters) == 0 {
+		return nil, fmt.Errorf("no repeater frequencies found")
+	}
+
+	return repeaters, nil
+}
+
+// String describes the repeater in the form accepted by
+// ParseRepeaterListing, with its city last.
+func (rptr *Repeater) String() string {
+	parts := []string{
+		"Output: " + frequencyToString(rptr.Frequency),
+		"Input: " + frequencyToString(rptr.Input),
+	}
+	if rptr.DMR {
+		parts = append(parts, fmt.Sprintf("CC%d", rptr.ColorCode))
+		if rptr.Analog {
+			parts = append(parts, "FM")
+		}
+	} else if rptr.Tone != "" {
+		parts = append(parts, "Tone: "+rptr.Tone)
+	}
+	if rptr.Callsign != "" {
+		parts = append(parts, "Callsign: "+rptr.Callsign)
+	}
+	if rptr.City != "" {
+		parts = append(parts, "City: "+rptr.City)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// RepeaterChannelName returns an unused channel name for the repeater,
+// generated by the NameTemplate template from the variables
+// {callsign}, {city} and {freq}.  If the template generates an empty
+// name, the repeater's frequency is used.
+func (cp *Codeplug) RepeaterChannelName(rptr *Repeater, template string) (string, error) {
+	vars := map[string]string{
+		"callsign": rptr.Callsign,
+		"city":     rptr.City,
+		"freq":     strconv.FormatFloat(rptr.Frequency, 'f', -1, 64),
+	}
+
+	policy, err := cp.NewNamingPolicy(RtChannels_md380, template)
+	if err != nil {
+		return "", err
+	}
+	name, err := policy.Name(vars)
+	if err == nil {
+		return name, nil
+	}
+
+	policy, err = cp.NewNamingPolicy(RtChannels_md380, "{freq}")
+	if err != nil {
+		return "", err
+	}
+
+	return policy.Name(vars)
+}
+
+// AddRepeaterChannel adds a channel named name for the repeater.  The
+// channel of a DMR repeater uses talkgroup tg in time slot 2, or
+// talkgroup 9, "Local", if tg.ID is 0.  Analog channels use the
+// repeater's tone.
+func (cp *Codeplug) AddRepeaterChannel(rptr *Repeater, name string, tg Talkgroup) error {
+	if name == "" {
+		return fmt.Errorf("channel has no name")
+	}
+	if cp.FindRecordByName(RtChannels_md380, name) != nil {
+		return fmt.Errorf("channel %s already exists", name)
+	}
+
+	err := cp.frequencyValid(rptr.Frequency)
+	if err == nil {
+		err = cp.frequencyValid(rptr.Input)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", name, err.Error())
+	}
+
+	contactName := ""
+	if rptr.DMR {
+		if tg.ID == 0 {
+			tg = Talkgroup{ID: 9, Name: "Local"}
+		}
+		contact, err := cp.talkgroupContact(tg)
+		if err != nil {
+			return err
+		}
+		contactName = contact.Name()
+	}
+
+	err = cp.addRepeaterChannel(rptr, name, rptr.DMR, contactName)
+	if err != nil {
+		return fmt.Errorf("%s: %s", name, err.Error())
+	}
+
+	cp.changed = true
+
+	return nil
+}
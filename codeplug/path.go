@@ -0,0 +1,364 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// A fieldPath selects records of a type, and optionally one of their
+// fields, as in
+//
+//	Channels[Name=='W1AW 2m'].Power
+//	Channels[ChannelMode==Digital && Name=~'^BM '].ColorCode
+//	Contacts['Parrot'].CallID
+//	GeneralSettings.RadioName
+//
+// A field having several values meets a condition if any value does,
+// or for "!=", if none equals it.
+type fieldPath struct {
+	text       string
+	rType      RecordType
+	name       string
+	conditions []pathCondition
+	fType      FieldType
+}
+
+// A pathCondition is one of a fieldPath's conditions.
+type pathCondition struct {
+	fType   FieldType
+	op      string
+	value   string
+	pattern *regexp.Regexp
+}
+
+// parsePath returns the path described by text.
+func (cp *Codeplug) parsePath(text string) (*fieldPath, error) {
+	p := &fieldPath{text: text}
+
+	s := strings.TrimSpace(text)
+	end := strings.IndexAny(s, "[.")
+	if end < 0 {
+		end = len(s)
+	}
+	rType, err := cp.nameToRt(strings.TrimSpace(s[:end]))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", text, err.Error())
+	}
+	p.rType = rType
+	s = s[end:]
+
+	if strings.HasPrefix(s, "[") {
+		end := closingBracket(s)
+		if end < 0 {
+			return nil, fmt.Errorf("%s: missing ]", text)
+		}
+		err := cp.parseSelector(p, s[1:end])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", text, err.Error())
+		}
+		s = s[end+1:]
+	}
+
+	if s == "" {
+		return p, nil
+	}
+
+	if !strings.HasPrefix(s, ".") {
+		return nil, fmt.Errorf("%s: expected . before %s", text, s)
+	}
+	fType, err := cp.nameToFt(rType, strings.TrimSpace(s[1:]))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", text, err.Error())
+	}
+	p.fType = fType
+
+	return p, nil
+}
+
+// closingBracket returns the index in s of the "]" closing the "["
+// beginning s, or -1 if there is none.  Brackets within quotes are
+// ignored.
+func closingBracket(s string) int {
+	var quote rune
+	for i, c := range s {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// splitUnquoted splits s around each instance of sep not within
+// quotes.
+func splitUnquoted(s string, sep string) []string {
+	var strs []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case strings.HasPrefix(s[i:], sep):
+			strs = append(strs, s[start:i])
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+
+	return append(strs, s[start:])
+}
+
+// unquote returns s without surrounding spaces and quotes.
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		s = s[1 : len(s)-1]
+	}
+
+	return s
+}
+
+// parseSelector sets the path's record name or conditions from the
+// text between its brackets.
+func (cp *Codeplug) parseSelector(p *fieldPath, text string) error {
+	if strings.TrimSpace(text) == "*" {
+		return nil
+	}
+
+	for _, cond := range splitUnquoted(text, "&&") {
+		var op string
+		var parts []string
+		for _, op = range []string{"==", "!=", "=~"} {
+			parts = splitUnquoted(cond, op)
+			if len(parts) > 1 {
+				break
+			}
+		}
+
+		switch len(parts) {
+		case 1:
+			if len(p.conditions) != 0 || p.name != "" {
+				return fmt.Errorf("bad condition: %s", strings.TrimSpace(cond))
+			}
+			p.name = unquote(cond)
+			if p.name == "" {
+				return fmt.Errorf("empty record name")
+			}
+			continue
+		case 2:
+		default:
+			return fmt.Errorf("bad condition: %s", strings.TrimSpace(cond))
+		}
+
+		if p.name != "" {
+			return fmt.Errorf("bad condition: %s", strings.TrimSpace(cond))
+		}
+
+		fType, err := cp.nameToFt(p.rType, strings.TrimSpace(parts[0]))
+		if err != nil {
+			return err
+		}
+		c := pathCondition{
+			fType: fType,
+			op:    op,
+			value: unquote(parts[1]),
+		}
+		if op == "=~" {
+			c.pattern, err = regexp.Compile(c.value)
+			if err != nil {
+				return fmt.Errorf("bad regular expression: %s", err.Error())
+			}
+		}
+		p.conditions = append(p.conditions, c)
+	}
+
+	return nil
+}
+
+// match returns true if the record meets the condition.
+func (c *pathCondition) match(r *Record) bool {
+	for _, f := range r.Fields(c.fType) {
+		value := f.String()
+		switch c.op {
+		case "==":
+			if value == c.value {
+				return true
+			}
+		case "!=":
+			if value == c.value {
+				return false
+			}
+		case "=~":
+			if c.pattern.MatchString(value) {
+				return true
+			}
+		}
+	}
+
+	return c.op == "!="
+}
+
+// selectRecords returns the records the path selects.
+func (cp *Codeplug) selectRecords(p *fieldPath) ([]*Record, error) {
+	if p.name != "" {
+		r := cp.FindRecordByName(p.rType, p.name)
+		if r == nil {
+			return nil, fmt.Errorf("no %s record named '%s'", p.rType, p.name)
+		}
+		return []*Record{r}, nil
+	}
+
+	var records []*Record
+	for _, r := range cp.Records(p.rType) {
+		matched := true
+		for i := range p.conditions {
+			if !p.conditions[i].match(r) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			records = append(records, r)
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: no records selected", p.text)
+	}
+
+	return records, nil
+}
+
+// SelectRecords returns the records selected by the path, which must
+// not name a field.  See SelectFields for the form of paths.
+func (cp *Codeplug) SelectRecords(pathText string) ([]*Record, error) {
+	p, err := cp.parsePath(pathText)
+	if err != nil {
+		return nil, err
+	}
+	if p.fType != "" {
+		return nil, fmt.Errorf("%s: selects fields, not records", pathText)
+	}
+
+	return cp.selectRecords(p)
+}
+
+// SelectFields returns the fields selected by the path, a record type
+// followed by an optional record selector in brackets and a field type,
+// as in "Channels[Name=='W1AW 2m'].Power".  A field type having several
+// values in a record contributes each of them.  The selector is either
+// a record name, "*", or conditions on field values joined by "&&",
+// each comparing a field with "==", "!=" or "=~" (a regular expression
+// match).  Values containing spaces or operators are quoted with ' or ".
+// Without a selector, every record of the type is selected.
+func (cp *Codeplug) SelectFields(pathText string) ([]*Field, error) {
+	p, err := cp.parsePath(pathText)
+	if err != nil {
+		return nil, err
+	}
+	if p.fType == "" {
+		return nil, fmt.Errorf("%s: no field type", pathText)
+	}
+
+	records, err := cp.selectRecords(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []*Field
+	for _, r := range records {
+		fields = append(fields, r.Fields(p.fType)...)
+	}
+
+	return fields, nil
+}
+
+// SetFields sets the fields selected by the path to value, returning
+// the fields whose values changed.  The field type must have a single
+// value per record.  Nothing is changed if any selected field is
+// locked or the value is invalid for any of them.
+func (cp *Codeplug) SetFields(pathText string, value string) ([]*Field, error) {
+	p, err := cp.parsePath(pathText)
+	if err != nil {
+		return nil, err
+	}
+	if p.fType == "" {
+		return nil, fmt.Errorf("%s: no field type", pathText)
+	}
+
+	records, err := cp.selectRecords(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []*Field
+	for _, r := range records {
+		f := r.Field(p.fType)
+		if f == nil {
+			return nil, fmt.Errorf("%s has no %s field", r.lockName(), p.fType)
+		}
+		if f.max > 1 {
+			return nil, fmt.Errorf("%s has a list of values", f.FullTypeName())
+		}
+		if f.String() == value {
+			continue
+		}
+		err := f.CheckUnlocked()
+		if err != nil {
+			return nil, err
+		}
+		err = r.NewField(p.fType).setString(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", f.FullTypeName(), err.Error())
+		}
+		fields = append(fields, f)
+	}
+
+	for _, f := range fields {
+		err := f.SetString(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", f.FullTypeName(), err.Error())
+		}
+	}
+
+	return fields, nil
+}
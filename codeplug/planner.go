@@ -0,0 +1,271 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A Site is a repeater or hotspot through which talkgroups may be
+// reached.  Its Talkgroups are the ones it carries, such as a
+// repeater's static talkgroups, each with the time slot carrying it.
+type Site struct {
+	Hotspot
+
+	// Dynamic reports whether the site carries any talkgroup on
+	// request, as a Brandmeister hotspot does.  Talkgroups reached
+	// only this way use the site's Slot.
+	Dynamic bool
+}
+
+// ReadSites reads sites from a JSON array of objects with the fields
+// of Site, as in
+//
+//	[{"Name": "W7ABC", "RxFrequency": 449.1, "TxFrequency": 444.1,
+//	  "ColorCode": 1, "Talkgroups": [{"ID": 3100, "Slot": 1}]},
+//	 {"Name": "Hotspot", "RxFrequency": 438.8, "ColorCode": 1,
+//	  "Dynamic": true}]
+func ReadSites(rdr io.Reader) ([]Site, error) {
+	var sites []Site
+	err := json.NewDecoder(rdr).Decode(&sites)
+	if err != nil {
+		return nil, fmt.Errorf("bad sites file: %s", err.Error())
+	}
+
+	for i, s := range sites {
+		if s.Name == "" {
+			return nil, fmt.Errorf("site %d has no name", i+1)
+		}
+		if s.RxFrequency == 0 {
+			return nil, fmt.Errorf("site %s has no frequency", s.Name)
+		}
+		if len(s.Talkgroups) == 0 && !s.Dynamic {
+			return nil, fmt.Errorf("site %s carries no talkgroups", s.Name)
+		}
+	}
+
+	return sites, nil
+}
+
+// A TalkgroupPlan is the result of PlanTalkgroups.
+type TalkgroupPlan struct {
+	// Hotspots holds, for each site used, the site with its
+	// Talkgroups replaced by the talkgroups reached through it.
+	Hotspots []*Hotspot
+
+	// Unreachable lists the talkgroups no site carries.
+	Unreachable []Talkgroup
+}
+
+// talkgroupKey identifies a talkgroup regardless of its name and slot.
+type talkgroupKey struct {
+	id      DmrID
+	private bool
+}
+
+func keyOfTalkgroup(tg Talkgroup) talkgroupKey {
+	return talkgroupKey{tg.ID, tg.Private}
+}
+
+// PlanTalkgroups chooses sites from which to reach the wanted
+// talkgroups, using as few sites as it can.  Sites are chosen
+// greedily, each time taking the site carrying the most talkgroups
+// not yet reached; ties go to the site listed first, so sites should
+// be listed in order of preference.  Each talkgroup is reached through
+// exactly one site.  The talkgroups keep their wanted names, and take
+// their time slots from the site carrying them.
+func PlanTalkgroups(wanted []Talkgroup, sites []Site) *TalkgroupPlan {
+	remaining := make(map[talkgroupKey]bool)
+	for _, tg := range wanted {
+		remaining[keyOfTalkgroup(tg)] = true
+	}
+
+	slots := make([]map[talkgroupKey]int, len(sites))
+	for i, s := range sites {
+		slots[i] = make(map[talkgroupKey]int)
+		for _, tg := range s.Talkgroups {
+			slots[i][keyOfTalkgroup(tg)] = tg.Slot
+		}
+	}
+	carries := func(i int, key talkgroupKey) bool {
+		_, ok := slots[i][key]
+		return ok || sites[i].Dynamic
+	}
+
+	plan := &TalkgroupPlan{}
+	used := make([]bool, len(sites))
+	for len(remaining) > 0 {
+		best := -1
+		bestCount := 0
+		for i := range sites {
+			if used[i] {
+				continue
+			}
+			count := 0
+			for key := range remaining {
+				if carries(i, key) {
+					count++
+				}
+			}
+			if count > bestCount {
+				best = i
+				bestCount = count
+			}
+		}
+		if best < 0 {
+			break
+		}
+		used[best] = true
+
+		h := sites[best].Hotspot
+		h.Talkgroups = nil
+		for _, tg := range wanted {
+			key := keyOfTalkgroup(tg)
+			if !remaining[key] || !carries(best, key) {
+				continue
+			}
+			delete(remaining, key)
+			tg.Slot = slots[best][key]
+			h.Talkgroups = append(h.Talkgroups, tg)
+		}
+		plan.Hotspots = append(plan.Hotspots, &h)
+	}
+
+	for _, tg := range wanted {
+		key := keyOfTalkgroup(tg)
+		if remaining[key] {
+			delete(remaining, key)
+			plan.Unreachable = append(plan.Unreachable, tg)
+		}
+	}
+
+	return plan
+}
+
+// String returns a summary of the plan, one line per site used and
+// a line listing any unreachable talkgroups.
+func (p *TalkgroupPlan) String() string {
+	var b strings.Builder
+	tgString := func(tg Talkgroup) string {
+		s := fmt.Sprintf("%s (%s)", tg.Name, tg.ID)
+		if tg.Slot != 0 {
+			s += fmt.Sprintf(" TS%d", tg.Slot)
+		}
+		return s
+	}
+
+	for _, h := range p.Hotspots {
+		strs := make([]string, len(h.Talkgroups))
+		for i, tg := range h.Talkgroups {
+			strs[i] = tgString(tg)
+		}
+		fmt.Fprintf(&b, "%s: %s\n", h.Name, strings.Join(strs, ", "))
+	}
+	if len(p.Unreachable) > 0 {
+		strs := make([]string, len(p.Unreachable))
+		for i, tg := range p.Unreachable {
+			strs[i] = tgString(tg)
+		}
+		fmt.Fprintf(&b, "unreachable: %s\n", strings.Join(strs, ", "))
+	}
+
+	return b.String()
+}
+
+// AddTalkgroupPlan adds the channels, contacts, RX group lists and
+// zones for each site of the plan, as AddHotspot does.  It fails
+// without changing the codeplug if the plan's channels, contacts,
+// RX group lists or zones won't all fit, or if a site's frequency is
+// outside the radio's range.
+func (cp *Codeplug) AddTalkgroupPlan(p *TalkgroupPlan) error {
+	if len(p.Hotspots) == 0 {
+		return fmt.Errorf("no talkgroups are reachable")
+	}
+
+	maxGroupListContacts := cp.maxFields(RtGroupLists, FtGlContact)
+	maxZoneChannels := cp.maxFields(RtZones_md380, FtZiChannel_md380)
+	newContacts := make(map[talkgroupKey]bool)
+	var channels, groupLists, zones int
+	for _, h := range p.Hotspots {
+		if h.ColorCode < 0 || h.ColorCode > 15 {
+			return fmt.Errorf("%s: bad color code: %d", h.Name, h.ColorCode)
+		}
+		for _, freq := range []float64{h.RxFrequency, h.TxFrequency} {
+			if freq == 0 {
+				continue
+			}
+			err := cp.frequencyValid(freq)
+			if err != nil {
+				return fmt.Errorf("%s: %s: %s", h.Name, frequencyToString(freq), err.Error())
+			}
+		}
+
+		groups := 0
+		for _, tg := range h.Talkgroups {
+			if cp.findContact(tg.ID, tg.Private) == nil {
+				newContacts[keyOfTalkgroup(tg)] = true
+			}
+			if !tg.Private {
+				groups++
+			}
+		}
+		if groups > maxGroupListContacts {
+			return fmt.Errorf("%s: too many talkgroups for an RX group list: %d (max %d)", h.Name, groups, maxGroupListContacts)
+		}
+		if groups > 0 {
+			groupLists++
+		}
+		channels += len(h.Talkgroups)
+		zones += (len(h.Talkgroups) + maxZoneChannels - 1) / maxZoneChannels
+	}
+
+	needed := []struct {
+		rType RecordType
+		count int
+	}{
+		{RtChannels_md380, channels},
+		{RtContacts, len(newContacts)},
+		{RtGroupLists, groupLists},
+		{RtZones_md380, zones},
+	}
+	for _, n := range needed {
+		if len(cp.records(n.rType))+n.count > cp.MaxRecords(n.rType) {
+			return fmt.Errorf("too many %s", n.rType)
+		}
+	}
+
+	for _, h := range p.Hotspots {
+		err := cp.AddHotspot(h)
+		if err != nil {
+			return fmt.Errorf("%s: %s", h.Name, err.Error())
+		}
+	}
+
+	return nil
+}
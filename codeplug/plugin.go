@@ -0,0 +1,296 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"plugin"
+	"sort"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/dfu"
+)
+
+// Third-party packages may add support for additional radios, file
+// formats and programming transports without modifying this package.
+// Radio models are added with AddDefinitions, file formats with
+// RegisterFileFormat, transports with RegisterTransport and validation
+// checks with RegisterValidator, usually from the package's init
+// function.  Such a package may either be
+// linked into a program or be built as a Go plugin and loaded at run
+// time with LoadPlugin.
+
+// A FileFormat describes a codeplug file format provided by a third
+// party.
+type FileFormat struct {
+	// Name identifies the format, e.g. "chirp".
+	Name string
+
+	// Ext is the filename extension, without the dot, of files in
+	// this format.
+	Ext string
+
+	// Import returns the records read from a file in this format.
+	// It typically converts the file to the codeplug text format and
+	// calls cp.ParseRecords.  It may be nil if the format cannot be
+	// imported.
+	Import func(cp *Codeplug, rdr io.Reader) ([]*Record, error)
+
+	// Export writes the codeplug in this format.  It may be nil if
+	// the format cannot be exported.
+	Export func(cp *Codeplug, w io.Writer) error
+}
+
+var fileFormats = make(map[string]*FileFormat)
+
+// RegisterFileFormat adds a file format, replacing any previously
+// registered format of the same name.
+func RegisterFileFormat(format *FileFormat) error {
+	if format.Name == "" {
+		return fmt.Errorf("file format has no name")
+	}
+	if format.Import == nil && format.Export == nil {
+		return fmt.Errorf("file format %s: no Import or Export function", format.Name)
+	}
+
+	fileFormats[format.Name] = format
+
+	return nil
+}
+
+// FileFormats returns the names of the registered file formats.
+func FileFormats() []string {
+	names := make([]string, 0, len(fileFormats))
+	for name := range fileFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// LookupFileFormat returns the registered file format of the given name.
+func LookupFileFormat(name string) (*FileFormat, error) {
+	format := fileFormats[name]
+	if format == nil {
+		return nil, fmt.Errorf("unknown file format: %s", name)
+	}
+
+	return format, nil
+}
+
+// NewCodeplugFormat returns a Codeplug that will be imported, when
+// loaded, from filename, a file of the named registered format.
+func NewCodeplugFormat(formatName string, filename string) (*Codeplug, error) {
+	format, err := LookupFileFormat(formatName)
+	if err != nil {
+		return nil, err
+	}
+	if format.Import == nil {
+		return nil, fmt.Errorf("file format %s cannot be imported", formatName)
+	}
+
+	cp, err := NewCodeplug(FileTypeFormat, filename)
+	if err != nil {
+		return nil, err
+	}
+	cp.fileFormat = format
+
+	return cp, nil
+}
+
+func (cp *Codeplug) importFormat(filename string, ignoreWarnings bool) error {
+	file, err := cp.fsys.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	records, err := cp.fileFormat.Import(cp, file)
+	if err != nil {
+		if _, warning := err.(Warning); !warning || !ignoreWarnings {
+			return err
+		}
+	}
+
+	err = cp.storeParsedRecords(records)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExportFormat writes the codeplug to filename in the named registered
+// file format.
+func (cp *Codeplug) ExportFormat(formatName string, filename string) (err error) {
+	format, err := LookupFileFormat(formatName)
+	if err != nil {
+		return err
+	}
+	if format.Export == nil {
+		return fmt.Errorf("file format %s cannot be exported", formatName)
+	}
+
+	file, err := cp.fsys.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+		return
+	}()
+
+	return format.Export(cp, file)
+}
+
+// A Transport reads and writes regions of a radio's codeplug.  The data
+// slice holds the codeplug's entire bin image, and each region is read
+// into, or written from, the same offset of data.
+type Transport interface {
+	ReadCodeplugRegion(data []byte, offset, size int) error
+	WriteCodeplugRegion(data []byte, offset, size int) error
+	Close()
+}
+
+// A TransportOpener connects to a radio, returning a Transport.  The
+// progress function, if not nil, is called as data is transferred.
+type TransportOpener func(progress func(cur int) bool) (Transport, error)
+
+// DefaultTransport is the name of the USB DFU transport used unless
+// another is selected with SetTransport.
+const DefaultTransport = "dfu"
+
+var transports = map[string]TransportOpener{
+	DefaultTransport: func(progress func(cur int) bool) (Transport, error) {
+		df, err := dfu.New(progress)
+		if err != nil {
+			return nil, err
+		}
+		return dfuTransport{df}, nil
+	},
+}
+
+var transportName = DefaultTransport
+
+// RegisterTransport adds a transport, replacing any previously
+// registered transport of the same name.
+func RegisterTransport(name string, open TransportOpener) error {
+	if name == "" {
+		return fmt.Errorf("transport has no name")
+	}
+	if open == nil {
+		return fmt.Errorf("transport %s: no opener", name)
+	}
+
+	transports[name] = open
+
+	return nil
+}
+
+// Transports returns the names of the registered transports.
+func Transports() []string {
+	names := make([]string, 0, len(transports))
+	for name := range transports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SetTransport selects the registered transport used to communicate
+// with radios.
+func SetTransport(name string) error {
+	if transports[name] == nil {
+		return fmt.Errorf("unknown transport: %s", name)
+	}
+
+	transportName = name
+
+	return nil
+}
+
+// openTransport connects to the radio using the selected transport.
+func openTransport(progress func(cur int) bool) (Transport, error) {
+	return transports[transportName](progress)
+}
+
+// A Rebooter is a Transport that can restart the radio after writing
+// its codeplug, so that it need not be turned off and on by hand.
+type Rebooter interface {
+	// Reboot restarts the radio and waits, for at most timeout,
+	// until it can be reached again.
+	Reboot(timeout time.Duration) error
+}
+
+// DefaultRebootTimeout is a suitable time to wait for a radio to
+// restart.
+const DefaultRebootTimeout = 30 * time.Second
+
+// ErrNoReboot is returned after a successful write to the radio, when
+// a reboot was requested but the transport cannot reboot the radio.
+var ErrNoReboot = errors.New("the radio cannot be restarted by its transport; turn it off and back on again")
+
+// SetRebootAfterWrite sets whether the radio is restarted after the
+// codeplug is written to it.  If timeout is not 0, WriteRadio,
+// WriteRadioRecords and WriteRadios restart the radio and wait for at
+// most timeout for it to return.  By default, the radio is not
+// restarted.
+func (cp *Codeplug) SetRebootAfterWrite(timeout time.Duration) {
+	cp.rebootTimeout = timeout
+}
+
+// rebootRadio restarts the radio after a write, if requested.
+func (cp *Codeplug) rebootRadio(t Transport) error {
+	if cp.rebootTimeout == 0 {
+		return nil
+	}
+
+	r, ok := t.(Rebooter)
+	if !ok {
+		return ErrNoReboot
+	}
+
+	return r.Reboot(cp.rebootTimeout)
+}
+
+// LoadPlugin loads the Go plugin at path.  The plugin registers its
+// radio definitions, file formats and transports from its init
+// functions.
+func LoadPlugin(path string) error {
+	_, err := plugin.Open(path)
+	if err != nil {
+		return fmt.Errorf("loading plugin %s: %s", path, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// A Policy requires the channels it applies to to have certain field
+// values, as in "hotspot channels must be Low power" or "12.5 kHz
+// bandwidth only above 440 MHz".  A policy applies to the channels
+// meeting all of its conditions.  Policies are read from JSON files
+// holding a list of policies:
+//
+//	[
+//		{
+//			"name": "hotspots use low power",
+//			"tagged": "hotspot",
+//			"require": {"Power": "Low"}
+//		},
+//		{
+//			"name": "narrow above 440 MHz",
+//			"minFrequency": 440,
+//			"require": {"Bandwidth": "12.5"}
+//		}
+//	]
+type Policy struct {
+	Name string `json:"name"`
+
+	// Mode, if non-empty, is the channel mode, "Analog" or "Digital",
+	// of the channels the policy applies to.
+	Mode string `json:"mode,omitempty"`
+
+	// MinFrequency and MaxFrequency, if non-zero, limit the policy
+	// to channels receiving on frequencies, in MHz, within them.
+	MinFrequency float64 `json:"minFrequency,omitempty"`
+	MaxFrequency float64 `json:"maxFrequency,omitempty"`
+
+	// ChannelName, if non-empty, is a regular expression matching
+	// the names of the channels the policy applies to.
+	ChannelName string `json:"channelName,omitempty"`
+
+	// Tagged, if non-empty, is a tag filter, as accepted by
+	// ParseTagFilter, selecting the channels the policy applies to.
+	Tagged string `json:"tagged,omitempty"`
+
+	// Require maps channel field names, as used in text files, to
+	// the values they must have.
+	Require map[string]string `json:"require"`
+
+	namePattern *regexp.Regexp
+	filter      *TagFilter
+	fTypes      []FieldType
+	values      map[FieldType]string
+}
+
+// A PolicyViolation is a channel field whose value a policy forbids.
+type PolicyViolation struct {
+	Policy   string
+	Field    *Field
+	Required string
+}
+
+// String returns a description of the violation.
+func (v *PolicyViolation) String() string {
+	return fmt.Sprintf("%s: is %s, policy '%s' requires %s",
+		v.Field.FullTypeName(), v.Field.String(), v.Policy, v.Required)
+}
+
+// ParsePolicies returns the policies described by the JSON in data.
+func ParsePolicies(data []byte) ([]*Policy, error) {
+	var policies []*Policy
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&policies)
+	if err != nil {
+		return nil, fmt.Errorf("bad policies: %s", err.Error())
+	}
+
+	for i, p := range policies {
+		if p.Name == "" {
+			p.Name = fmt.Sprintf("%d", i+1)
+		}
+	}
+
+	return policies, nil
+}
+
+// LoadPolicies returns the policies in the named JSON file.
+func LoadPolicies(filename string) ([]*Policy, error) {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := ParsePolicies(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return policies, nil
+}
+
+// SetPolicies sets the policies the codeplug's channels must conform
+// to.  Violations are reported as warnings when the codeplug is
+// validated, as when it is saved.
+func (cp *Codeplug) SetPolicies(policies []*Policy) error {
+	// The template is never added to the codeplug.  It is only used
+	// to check the policies' required values.
+	template := cp.newRecord(RtChannels_md380, 0)
+	for _, p := range policies {
+		err := p.compile(cp, template)
+		if err != nil {
+			return fmt.Errorf("policy '%s': %s", p.Name, err.Error())
+		}
+	}
+
+	cp.policies = policies
+
+	return nil
+}
+
+// Policies returns the codeplug's policies.
+func (cp *Codeplug) Policies() []*Policy {
+	return cp.policies
+}
+
+// compile checks the policy's conditions and required values, saving
+// them in the forms used to apply the policy.
+func (p *Policy) compile(cp *Codeplug, template *Record) error {
+	switch p.Mode {
+	case "", "Analog", "Digital":
+	default:
+		return fmt.Errorf("bad mode: %s", p.Mode)
+	}
+
+	p.namePattern = nil
+	if p.ChannelName != "" {
+		pattern, err := regexp.Compile(p.ChannelName)
+		if err != nil {
+			return fmt.Errorf("bad channelName: %s", err.Error())
+		}
+		p.namePattern = pattern
+	}
+
+	p.filter = nil
+	if p.Tagged != "" {
+		filter, err := ParseTagFilter(p.Tagged)
+		if err != nil {
+			return err
+		}
+		p.filter = filter
+	}
+
+	if len(p.Require) == 0 {
+		return fmt.Errorf("nothing required")
+	}
+
+	names := make([]string, 0, len(p.Require))
+	for name := range p.Require {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	p.fTypes = nil
+	p.values = make(map[FieldType]string)
+	for _, name := range names {
+		fType, err := cp.nameToFt(RtChannels_md380, name)
+		if err != nil {
+			return err
+		}
+		f, err := template.NewFieldWithValue(fType, 0, p.Require[name])
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, err.Error())
+		}
+		p.fTypes = append(p.fTypes, fType)
+		p.values[fType] = f.String()
+	}
+
+	return nil
+}
+
+// appliesTo returns true if the channel meets the policy's conditions.
+func (p *Policy) appliesTo(ch *Record) bool {
+	if p.Mode != "" && ch.Field(FtCiChannelMode).String() != p.Mode {
+		return false
+	}
+
+	if p.MinFrequency != 0 || p.MaxFrequency != 0 {
+		freq, err := strconv.ParseFloat(ch.Field(FtCiRxFrequency).String(), 64)
+		if err != nil {
+			return false
+		}
+		if p.MinFrequency != 0 && freq < p.MinFrequency {
+			return false
+		}
+		if p.MaxFrequency != 0 && freq > p.MaxFrequency {
+			return false
+		}
+	}
+
+	if p.namePattern != nil && !p.namePattern.MatchString(ch.Name()) {
+		return false
+	}
+
+	if p.filter != nil && !p.filter.Match(ch) {
+		return false
+	}
+
+	return true
+}
+
+// PolicyViolations returns the channel fields whose values violate the
+// codeplug's policies.
+func (cp *Codeplug) PolicyViolations() []PolicyViolation {
+	var violations []PolicyViolation
+	for _, ch := range cp.records(RtChannels_md380) {
+		for _, p := range cp.policies {
+			if !p.appliesTo(ch) {
+				continue
+			}
+			for _, fType := range p.fTypes {
+				f := ch.Field(fType)
+				if f == nil || f.String() == p.values[fType] {
+					continue
+				}
+				violations = append(violations, PolicyViolation{
+					Policy:   p.Name,
+					Field:    f,
+					Required: p.values[fType],
+				})
+			}
+		}
+	}
+
+	return violations
+}
+
+// FixPolicyViolations sets the channel fields violating the codeplug's
+// policies to the values required.  Locked fields are left alone.  It
+// returns the violations remaining.
+func (cp *Codeplug) FixPolicyViolations() ([]PolicyViolation, error) {
+	var remaining []PolicyViolation
+	for _, v := range cp.PolicyViolations() {
+		f := v.Field
+		if f.String() == v.Required {
+			// Fixed for an earlier policy.
+			continue
+		}
+		if f.CheckUnlocked() != nil {
+			remaining = append(remaining, v)
+			continue
+		}
+		err := f.setString(v.Required)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", f.FullTypeName(), err.Error())
+		}
+		cp.changed = true
+	}
+
+	// A fix for one policy may violate another.
+	for _, v := range cp.PolicyViolations() {
+		found := false
+		for _, r := range remaining {
+			if r.Field == v.Field && r.Policy == v.Policy {
+				found = true
+				break
+			}
+		}
+		if !found {
+			remaining = append(remaining, v)
+		}
+	}
+
+	return remaining, nil
+}
+
+// policiesValid returns a description of the codeplug's policy
+// violations, one per line.
+func (cp *Codeplug) policiesValid() string {
+	var buf bytes.Buffer
+	for _, v := range cp.PolicyViolations() {
+		fmt.Fprintf(&buf, "%s\n", v.String())
+	}
+
+	return buf.String()
+}
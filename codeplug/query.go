@@ -0,0 +1,1076 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Query evaluates expr, an expression in a subset of the jq language,
+// over the codeplug's JSON form as written by WriteJSON, returning the
+// expression's outputs.  For example,
+//
+//	.Channels[] | [.Name, .RxFrequency] | @tsv
+//	.Zones[] | {Name, channels: (.Channel | length)}
+//	[.Channels[] | select(.ChannelMode == "Digital")] | length
+//
+// The subset has ".", ".field", ".[index]", ".[]", "|", ",", array
+// and object construction, literals, parentheses, the operators ==,
+// !=, <, <=, >, >=, +, -, and, or, the functions select, map, sort_by,
+// test, length, keys, add, sort, unique, not, tonumber, tostring and
+// empty, and the @csv and @tsv formats.  Field values are strings, so
+// numbers are compared after tonumber, as in
+// "select((.RxFrequency | tonumber) > 440)".
+func (cp *Codeplug) Query(expr string) ([]interface{}, error) {
+	q, err := parseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = cp.WriteJSON(&buf)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	err = json.Unmarshal(buf.Bytes(), &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return q(doc)
+}
+
+// A queryFunc returns the outputs of a query expression for an input.
+type queryFunc func(v interface{}) ([]interface{}, error)
+
+// A queryParser parses a query expression into a queryFunc.
+type queryParser struct {
+	tokens []string
+	pos    int
+}
+
+// parseQuery returns the queryFunc evaluating expr.
+func parseQuery(expr string) (queryFunc, error) {
+	tokens, err := queryTokens(expr)
+	if err != nil {
+		return nil, fmt.Errorf("query: %s", err.Error())
+	}
+
+	p := &queryParser{tokens: tokens}
+	q, err := p.parsePipe()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %s", p.tokens[p.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query: %s", err.Error())
+	}
+
+	return q, nil
+}
+
+// queryTokens splits expr into tokens.  String literals keep their
+// quotes.
+func queryTokens(expr string) ([]string, error) {
+	var tokens []string
+	s := expr
+	for {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if s == "" {
+			return tokens, nil
+		}
+
+		c, size := utf8.DecodeRuneInString(s)
+		end := size
+		switch {
+		case c == '"':
+			end = 1
+			for end < len(s) && s[end] != '"' {
+				if s[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(s) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			end++
+
+		case c == '@' || c == '_' || unicode.IsLetter(c):
+			end = strings.IndexFunc(s[size:], func(c rune) bool {
+				return c != '_' && !unicode.IsLetter(c) && !unicode.IsDigit(c)
+			})
+			if end < 0 {
+				end = len(s)
+			} else {
+				end += size
+			}
+
+		case unicode.IsDigit(c):
+			end = strings.IndexFunc(s, func(c rune) bool {
+				return c != '.' && !unicode.IsDigit(c)
+			})
+			if end < 0 {
+				end = len(s)
+			}
+
+		case strings.ContainsRune("=!<>", c):
+			if len(s) > 1 && s[1] == '=' {
+				end = 2
+			} else if c == '=' || c == '!' {
+				return nil, fmt.Errorf("bad operator: %c", c)
+			}
+
+		case strings.ContainsRune(".|,()[]{}:+-", c):
+
+		default:
+			return nil, fmt.Errorf("unexpected character: %c", c)
+		}
+
+		tokens = append(tokens, s[:end])
+		s = s[end:]
+	}
+}
+
+// peek returns the next token, or the empty string at the end.
+func (p *queryParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+
+	return p.tokens[p.pos]
+}
+
+// next returns the next token, advancing past it.
+func (p *queryParser) next() string {
+	token := p.peek()
+	p.pos++
+
+	return token
+}
+
+// expect advances past the next token, which must be token.
+func (p *queryParser) expect(token string) error {
+	next := p.next()
+	if next != token {
+		if next == "" {
+			next = "end of expression"
+		}
+		return fmt.Errorf("expected %s, found %s", token, next)
+	}
+
+	return nil
+}
+
+// parsePipe parses expressions joined by "|".
+func (p *queryParser) parsePipe() (queryFunc, error) {
+	left, err := p.parseComma()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "|" {
+		p.next()
+		right, err := p.parseComma()
+		if err != nil {
+			return nil, err
+		}
+		left = pipeQuery(left, right)
+	}
+
+	return left, nil
+}
+
+// pipeQuery returns a query feeding each output of left to right.
+func pipeQuery(left, right queryFunc) queryFunc {
+	return func(v interface{}) ([]interface{}, error) {
+		lefts, err := left(v)
+		if err != nil {
+			return nil, err
+		}
+		var outputs []interface{}
+		for _, l := range lefts {
+			rights, err := right(l)
+			if err != nil {
+				return nil, err
+			}
+			outputs = append(outputs, rights...)
+		}
+		return outputs, nil
+	}
+}
+
+// parseComma parses expressions joined by ",".
+func (p *queryParser) parseComma() (queryFunc, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "," {
+		p.next()
+		right, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		first := left
+		left = func(v interface{}) ([]interface{}, error) {
+			lefts, err := first(v)
+			if err != nil {
+				return nil, err
+			}
+			rights, err := right(v)
+			if err != nil {
+				return nil, err
+			}
+			return append(lefts, rights...), nil
+		}
+	}
+
+	return left, nil
+}
+
+// parseOr parses expressions joined by "or".
+func (p *queryParser) parseOr() (queryFunc, error) {
+	return p.parseBinary([]string{"or"}, p.parseAnd)
+}
+
+// parseAnd parses expressions joined by "and".
+func (p *queryParser) parseAnd() (queryFunc, error) {
+	return p.parseBinary([]string{"and"}, p.parseComparison)
+}
+
+// parseComparison parses an expression, possibly compared with another.
+func (p *queryParser) parseComparison() (queryFunc, error) {
+	return p.parseBinary([]string{"==", "!=", "<", "<=", ">", ">="}, p.parseSum)
+}
+
+// parseSum parses expressions joined by "+" or "-".
+func (p *queryParser) parseSum() (queryFunc, error) {
+	return p.parseBinary([]string{"+", "-"}, p.parsePostfix)
+}
+
+// parseBinary parses operands, parsed by parseOperand, joined by any of
+// the operators.
+func (p *queryParser) parseBinary(operators []string, parseOperand func() (queryFunc, error)) (queryFunc, error) {
+	left, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	for stringInSlice(p.peek(), operators) {
+		op := p.next()
+		right, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryQuery(op, left, right)
+	}
+
+	return left, nil
+}
+
+// binaryQuery returns a query applying the operator to each pair of
+// outputs of left and right.
+func binaryQuery(op string, left, right queryFunc) queryFunc {
+	return func(v interface{}) ([]interface{}, error) {
+		lefts, err := left(v)
+		if err != nil {
+			return nil, err
+		}
+		var outputs []interface{}
+		for _, l := range lefts {
+			if op == "and" && !queryTrue(l) || op == "or" && queryTrue(l) {
+				outputs = append(outputs, op == "or")
+				continue
+			}
+			rights, err := right(v)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range rights {
+				output, err := queryOperate(op, l, r)
+				if err != nil {
+					return nil, err
+				}
+				outputs = append(outputs, output)
+			}
+		}
+		return outputs, nil
+	}
+}
+
+// queryOperate returns the result of applying the operator to l and r.
+func queryOperate(op string, l, r interface{}) (interface{}, error) {
+	switch op {
+	case "and", "or":
+		return queryTrue(r), nil
+	case "==":
+		return compareQueryValues(l, r) == 0, nil
+	case "!=":
+		return compareQueryValues(l, r) != 0, nil
+	case "<":
+		return compareQueryValues(l, r) < 0, nil
+	case "<=":
+		return compareQueryValues(l, r) <= 0, nil
+	case ">":
+		return compareQueryValues(l, r) > 0, nil
+	case ">=":
+		return compareQueryValues(l, r) >= 0, nil
+	}
+
+	if l == nil && op == "+" {
+		return r, nil
+	}
+	if r == nil && op == "+" {
+		return l, nil
+	}
+
+	switch l := l.(type) {
+	case float64:
+		if r, ok := r.(float64); ok {
+			if op == "+" {
+				return l + r, nil
+			}
+			return l - r, nil
+		}
+	case string:
+		if r, ok := r.(string); ok && op == "+" {
+			return l + r, nil
+		}
+	case []interface{}:
+		if r, ok := r.([]interface{}); ok && op == "+" {
+			return append(append([]interface{}{}, l...), r...), nil
+		}
+	case map[string]interface{}:
+		if r, ok := r.(map[string]interface{}); ok && op == "+" {
+			m := make(map[string]interface{})
+			for k, v := range l {
+				m[k] = v
+			}
+			for k, v := range r {
+				m[k] = v
+			}
+			return m, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot apply %s to %s and %s", op, queryTypeName(l), queryTypeName(r))
+}
+
+// parsePostfix parses a term followed by any number of field accesses,
+// indexes and iterations.
+func (p *queryParser) parsePostfix() (queryFunc, error) {
+	q, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch p.peek() {
+		case "[":
+			q, err = p.parseIndex(q)
+		case ".":
+			p.next()
+			q, err = p.parseField(q)
+		default:
+			return q, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseField parses the field name following a ".", applying it to the
+// outputs of q.
+func (p *queryParser) parseField(q queryFunc) (queryFunc, error) {
+	token := p.next()
+	var name string
+	switch {
+	case strings.HasPrefix(token, "\""):
+		var err error
+		name, err = strconv.Unquote(token)
+		if err != nil {
+			return nil, fmt.Errorf("bad string: %s", token)
+		}
+	case isQueryIdent(token):
+		name = token
+	default:
+		return nil, fmt.Errorf("expected field name after ., found %s", token)
+	}
+
+	return pipeQuery(q, func(v interface{}) ([]interface{}, error) {
+		output, err := queryIndex(v, name)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{output}, nil
+	}), nil
+}
+
+// parseIndex parses "[]" or "[index]", applying it to the outputs of q.
+func (p *queryParser) parseIndex(q queryFunc) (queryFunc, error) {
+	p.next()
+	if p.peek() == "]" {
+		p.next()
+		return pipeQuery(q, queryIterate), nil
+	}
+
+	index, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	err = p.expect("]")
+	if err != nil {
+		return nil, err
+	}
+
+	return pipeQuery(q, func(v interface{}) ([]interface{}, error) {
+		indexes, err := index(v)
+		if err != nil {
+			return nil, err
+		}
+		var outputs []interface{}
+		for _, i := range indexes {
+			output, err := queryIndex(v, i)
+			if err != nil {
+				return nil, err
+			}
+			outputs = append(outputs, output)
+		}
+		return outputs, nil
+	}), nil
+}
+
+// queryIndex returns v's field or element given by index.
+func queryIndex(v interface{}, index interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		if name, ok := index.(string); ok {
+			return v[name], nil
+		}
+	case []interface{}:
+		if n, ok := index.(float64); ok {
+			i := int(n)
+			if i < 0 {
+				i += len(v)
+			}
+			if i < 0 || i >= len(v) {
+				return nil, nil
+			}
+			return v[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot index %s with %s", queryTypeName(v), queryString(index))
+}
+
+// queryIterate returns the elements of an array or the values of an
+// object, in key order.
+func queryIterate(v interface{}) ([]interface{}, error) {
+	switch v := v.(type) {
+	case []interface{}:
+		return v, nil
+	case map[string]interface{}:
+		keys := queryKeys(v)
+		outputs := make([]interface{}, len(keys))
+		for i, key := range keys {
+			outputs[i] = v[key.(string)]
+		}
+		return outputs, nil
+	}
+
+	return nil, fmt.Errorf("cannot iterate over %s", queryTypeName(v))
+}
+
+// parseTerm parses a literal, ".", ".field", a parenthesized
+// expression, an array or object construction, a function call or a
+// format.
+func (p *queryParser) parseTerm() (queryFunc, error) {
+	token := p.next()
+	switch {
+	case token == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+
+	case token == ".":
+		next := p.peek()
+		if next == "[" || next == "" || !isQueryIdent(next) && !strings.HasPrefix(next, "\"") {
+			return queryIdentity, nil
+		}
+		return p.parseField(queryIdentity)
+
+	case token == "(":
+		q, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		return q, p.expect(")")
+
+	case token == "[":
+		if p.peek() == "]" {
+			p.next()
+			return queryConstant([]interface{}{}), nil
+		}
+		q, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expect("]")
+		if err != nil {
+			return nil, err
+		}
+		return func(v interface{}) ([]interface{}, error) {
+			outputs, err := q(v)
+			if err != nil {
+				return nil, err
+			}
+			if outputs == nil {
+				outputs = []interface{}{}
+			}
+			return []interface{}{outputs}, nil
+		}, nil
+
+	case token == "{":
+		return p.parseObject()
+
+	case token == "-":
+		q, err := p.parsePostfix()
+		if err != nil {
+			return nil, err
+		}
+		return binaryQuery("-", queryConstant(float64(0)), q), nil
+
+	case strings.HasPrefix(token, "\""):
+		s, err := strconv.Unquote(token)
+		if err != nil {
+			return nil, fmt.Errorf("bad string: %s", token)
+		}
+		return queryConstant(s), nil
+
+	case unicode.IsDigit(rune(token[0])):
+		n, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad number: %s", token)
+		}
+		return queryConstant(n), nil
+
+	case token == "true" || token == "false":
+		return queryConstant(token == "true"), nil
+
+	case token == "null":
+		return queryConstant(nil), nil
+
+	case isQueryIdent(token) || strings.HasPrefix(token, "@"):
+		return p.parseFunction(token)
+	}
+
+	return nil, fmt.Errorf("unexpected %s", token)
+}
+
+// parseObject parses an object construction, after its "{".  Each
+// entry is "key: value" or "key", short for "key: .key".
+func (p *queryParser) parseObject() (queryFunc, error) {
+	var keys []string
+	var values []queryFunc
+	for p.peek() != "}" {
+		if len(keys) != 0 {
+			err := p.expect(",")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		token := p.next()
+		key := token
+		if strings.HasPrefix(token, "\"") {
+			var err error
+			key, err = strconv.Unquote(token)
+			if err != nil {
+				return nil, fmt.Errorf("bad string: %s", token)
+			}
+		} else if !isQueryIdent(token) {
+			return nil, fmt.Errorf("bad object key: %s", token)
+		}
+
+		value := func(v interface{}) ([]interface{}, error) {
+			output, err := queryIndex(v, key)
+			return []interface{}{output}, err
+		}
+		if p.peek() == ":" {
+			p.next()
+			var err error
+			value, err = p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	p.next()
+
+	return func(v interface{}) ([]interface{}, error) {
+		objects := []interface{}{map[string]interface{}{}}
+		for i, key := range keys {
+			outputs, err := values[i](v)
+			if err != nil {
+				return nil, err
+			}
+			var next []interface{}
+			for _, o := range objects {
+				for _, output := range outputs {
+					m := make(map[string]interface{})
+					for k, v := range o.(map[string]interface{}) {
+						m[k] = v
+					}
+					m[key] = output
+					next = append(next, m)
+				}
+			}
+			objects = next
+		}
+		return objects, nil
+	}, nil
+}
+
+// parseFunction parses a call of the named function or a format.
+func (p *queryParser) parseFunction(name string) (queryFunc, error) {
+	var arg queryFunc
+	switch name {
+	case "select", "map", "sort_by", "test":
+		err := p.expect("(")
+		if err != nil {
+			return nil, err
+		}
+		arg, err = p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expect(")")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch name {
+	case "select":
+		return func(v interface{}) ([]interface{}, error) {
+			conds, err := arg(v)
+			if err != nil {
+				return nil, err
+			}
+			var outputs []interface{}
+			for _, cond := range conds {
+				if queryTrue(cond) {
+					outputs = append(outputs, v)
+				}
+			}
+			return outputs, nil
+		}, nil
+
+	case "map":
+		return func(v interface{}) ([]interface{}, error) {
+			outputs, err := pipeQuery(queryIterate, arg)(v)
+			if err != nil {
+				return nil, err
+			}
+			if outputs == nil {
+				outputs = []interface{}{}
+			}
+			return []interface{}{outputs}, nil
+		}, nil
+
+	case "sort_by":
+		return func(v interface{}) ([]interface{}, error) {
+			elems, ok := v.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("cannot sort %s", queryTypeName(v))
+			}
+			keys := make([]interface{}, len(elems))
+			for i, elem := range elems {
+				outputs, err := arg(elem)
+				if err != nil {
+					return nil, err
+				}
+				keys[i] = outputs
+			}
+			indexes := make([]int, len(elems))
+			for i := range indexes {
+				indexes[i] = i
+			}
+			sort.SliceStable(indexes, func(i, j int) bool {
+				return compareQueryValues(keys[indexes[i]], keys[indexes[j]]) < 0
+			})
+			sorted := make([]interface{}, len(elems))
+			for i, index := range indexes {
+				sorted[i] = elems[index]
+			}
+			return []interface{}{sorted}, nil
+		}, nil
+
+	case "test":
+		return func(v interface{}) ([]interface{}, error) {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("cannot test %s", queryTypeName(v))
+			}
+			patterns, err := arg(v)
+			if err != nil {
+				return nil, err
+			}
+			var outputs []interface{}
+			for _, pattern := range patterns {
+				str, ok := pattern.(string)
+				if !ok {
+					return nil, fmt.Errorf("test: %s is not a string", queryString(pattern))
+				}
+				re, err := regexp.Compile(str)
+				if err != nil {
+					return nil, fmt.Errorf("test: %s", err.Error())
+				}
+				outputs = append(outputs, re.MatchString(s))
+			}
+			return outputs, nil
+		}, nil
+
+	case "empty":
+		return func(v interface{}) ([]interface{}, error) {
+			return nil, nil
+		}, nil
+	}
+
+	f, ok := queryFunctions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function: %s", name)
+	}
+
+	return func(v interface{}) ([]interface{}, error) {
+		output, err := f(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err.Error())
+		}
+		return []interface{}{output}, nil
+	}, nil
+}
+
+// queryFunctions are the functions and formats taking no arguments.
+var queryFunctions = map[string]func(v interface{}) (interface{}, error){
+	"length": func(v interface{}) (interface{}, error) {
+		switch v := v.(type) {
+		case nil:
+			return float64(0), nil
+		case bool:
+			return nil, fmt.Errorf("%s has no length", queryTypeName(v))
+		case float64:
+			return math.Abs(v), nil
+		case string:
+			return float64(utf8.RuneCountInString(v)), nil
+		case []interface{}:
+			return float64(len(v)), nil
+		case map[string]interface{}:
+			return float64(len(v)), nil
+		}
+		return nil, nil
+	},
+
+	"keys": func(v interface{}) (interface{}, error) {
+		switch v := v.(type) {
+		case []interface{}:
+			keys := make([]interface{}, len(v))
+			for i := range v {
+				keys[i] = float64(i)
+			}
+			return keys, nil
+		case map[string]interface{}:
+			return queryKeys(v), nil
+		}
+		return nil, fmt.Errorf("%s has no keys", queryTypeName(v))
+	},
+
+	"add": func(v interface{}) (interface{}, error) {
+		elems, err := queryIterate(v)
+		if err != nil {
+			return nil, err
+		}
+		var sum interface{}
+		for _, elem := range elems {
+			sum, err = queryOperate("+", sum, elem)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return sum, nil
+	},
+
+	"sort": func(v interface{}) (interface{}, error) {
+		elems, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot sort %s", queryTypeName(v))
+		}
+		sorted := append([]interface{}{}, elems...)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return compareQueryValues(sorted[i], sorted[j]) < 0
+		})
+		return sorted, nil
+	},
+
+	"unique": func(v interface{}) (interface{}, error) {
+		elems, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot sort %s", queryTypeName(v))
+		}
+		sorted := append([]interface{}{}, elems...)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return compareQueryValues(sorted[i], sorted[j]) < 0
+		})
+		unique := []interface{}{}
+		for i, elem := range sorted {
+			if i == 0 || compareQueryValues(elem, sorted[i-1]) != 0 {
+				unique = append(unique, elem)
+			}
+		}
+		return unique, nil
+	},
+
+	"not": func(v interface{}) (interface{}, error) {
+		return !queryTrue(v), nil
+	},
+
+	"tonumber": func(v interface{}) (interface{}, error) {
+		switch v := v.(type) {
+		case float64:
+			return v, nil
+		case string:
+			n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse %s as a number", queryString(v))
+			}
+			return n, nil
+		}
+		return nil, fmt.Errorf("cannot convert %s to a number", queryTypeName(v))
+	},
+
+	"tostring": func(v interface{}) (interface{}, error) {
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+		return queryString(v), nil
+	},
+
+	"@csv": func(v interface{}) (interface{}, error) {
+		return queryFormat(v, ",", func(s string) string {
+			return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+		})
+	},
+
+	"@tsv": func(v interface{}) (interface{}, error) {
+		return queryFormat(v, "\t", strings.NewReplacer(
+			"\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r").Replace)
+	},
+}
+
+// queryFormat returns the elements of the array v joined by sep, with
+// strings passed through quote.
+func queryFormat(v interface{}, sep string, quote func(string) string) (interface{}, error) {
+	elems, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot format %s", queryTypeName(v))
+	}
+
+	strs := make([]string, len(elems))
+	for i, elem := range elems {
+		switch elem := elem.(type) {
+		case nil:
+		case string:
+			strs[i] = quote(elem)
+		case bool, float64:
+			strs[i] = queryString(elem)
+		default:
+			return nil, fmt.Errorf("cannot format %s", queryTypeName(elem))
+		}
+	}
+
+	return strings.Join(strs, sep), nil
+}
+
+// queryIdentity returns its input.
+func queryIdentity(v interface{}) ([]interface{}, error) {
+	return []interface{}{v}, nil
+}
+
+// queryConstant returns a query whose output is c.
+func queryConstant(c interface{}) queryFunc {
+	return func(v interface{}) ([]interface{}, error) {
+		return []interface{}{c}, nil
+	}
+}
+
+// isQueryIdent returns true if token is an identifier.
+func isQueryIdent(token string) bool {
+	c, _ := utf8.DecodeRuneInString(token)
+	return c == '_' || unicode.IsLetter(c)
+}
+
+// queryTrue returns false if v is false or null, true otherwise.
+func queryTrue(v interface{}) bool {
+	return v != nil && v != false
+}
+
+// queryKeys returns the object's keys, sorted.
+func queryKeys(m map[string]interface{}) []interface{} {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]interface{}, len(names))
+	for i, name := range names {
+		keys[i] = name
+	}
+
+	return keys
+}
+
+// queryTypeName returns the name of v's JSON type.
+func queryTypeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+
+	return fmt.Sprintf("%T", v)
+}
+
+// queryString returns v in JSON.
+func queryString(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}
+
+// queryTypeOrder returns the position of v's type in the order used to
+// compare values of differing types.
+func queryTypeOrder(v interface{}) int {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case bool:
+		if !v {
+			return 1
+		}
+		return 2
+	case float64:
+		return 3
+	case string:
+		return 4
+	case []interface{}:
+		return 5
+	}
+
+	return 6
+}
+
+// compareQueryValues returns -1, 0 or 1 as a is less than, equal to or
+// greater than b.  Values of differing types are ordered null, false,
+// true, numbers, strings, arrays and objects.
+func compareQueryValues(a, b interface{}) int {
+	ta, tb := queryTypeOrder(a), queryTypeOrder(b)
+	if ta != tb {
+		if ta < tb {
+			return -1
+		}
+		return 1
+	}
+
+	switch a := a.(type) {
+	case float64:
+		b := b.(float64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	case string:
+		return strings.Compare(a, b.(string))
+	case []interface{}:
+		b := b.([]interface{})
+		for i := 0; i < len(a) && i < len(b); i++ {
+			c := compareQueryValues(a[i], b[i])
+			if c != 0 {
+				return c
+			}
+		}
+		switch {
+		case len(a) < len(b):
+			return -1
+		case len(a) > len(b):
+			return 1
+		}
+	case map[string]interface{}:
+		b := b.(map[string]interface{})
+		c := compareQueryValues(queryKeys(a), queryKeys(b))
+		if c != 0 {
+			return c
+		}
+		for _, key := range queryKeys(a) {
+			c := compareQueryValues(a[key.(string)], b[key.(string)])
+			if c != 0 {
+				return c
+			}
+		}
+	}
+
+	return 0
+}
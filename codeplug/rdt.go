@@ -0,0 +1,168 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+// An .rdt file is a DfuSe container holding a single image element.
+// These are the offsets of the container fields we check and regenerate.
+const (
+	rdtSignatureOffset   = 0
+	rdtVersionOffset     = 5
+	rdtImageSizeOffset   = 6
+	rdtTargetsOffset     = 10
+	rdtTargetOffset      = 11
+	rdtTargetSizeOffset  = 277
+	rdtElementsOffset    = 281
+	rdtElementSizeOffset = 289
+	rdtDataOffset        = 293
+	rdtSuffixSize        = 16
+
+	rdtSignature       = "DfuSe"
+	rdtTargetSignature = "Target"
+	rdtSuffixSignature = "UFD"
+
+	// The vendor's CPS doesn't compute the DFU suffix CRC.  It
+	// writes this constant instead.
+	vendorRdtCRC = 0x8e657112
+)
+
+// An RdtError describes a corrupt region of an .rdt file.
+type RdtError struct {
+	Offset int
+	Size   int
+	Msg    string
+}
+
+func (e *RdtError) Error() string {
+	return fmt.Sprintf("corrupt rdt file at offset %d (%d bytes): %s",
+		e.Offset, e.Size, e.Msg)
+}
+
+// rdtCRC returns the DFU suffix CRC of the given rdt bytes.
+func rdtCRC(bytes []byte) uint32 {
+	return ^crc32.ChecksumIEEE(bytes[:len(bytes)-4])
+}
+
+// checkRdt returns an *RdtError if the container fields of the given
+// rdt file bytes are inconsistent.
+func checkRdt(bytes []byte) error {
+	size := len(bytes)
+	if size < rdtDataOffset+rdtSuffixSize {
+		return &RdtError{0, size, "file too short"}
+	}
+
+	le := binary.LittleEndian
+
+	if string(bytes[:len(rdtSignature)]) != rdtSignature {
+		return &RdtError{rdtSignatureOffset, len(rdtSignature),
+			"bad DfuSe signature"}
+	}
+
+	if bytes[rdtVersionOffset] != 1 {
+		return &RdtError{rdtVersionOffset, 1,
+			fmt.Sprintf("unknown DfuSe version %d", bytes[rdtVersionOffset])}
+	}
+
+	if bytes[rdtTargetsOffset] != 1 {
+		return &RdtError{rdtTargetsOffset, 1,
+			fmt.Sprintf("%d targets, expected 1", bytes[rdtTargetsOffset])}
+	}
+
+	target := bytes[rdtTargetOffset : rdtTargetOffset+len(rdtTargetSignature)]
+	if string(target) != rdtTargetSignature {
+		return &RdtError{rdtTargetOffset, len(rdtTargetSignature),
+			"bad target signature"}
+	}
+
+	elements := le.Uint32(bytes[rdtElementsOffset:])
+	if elements != 1 {
+		return &RdtError{rdtElementsOffset, 4,
+			fmt.Sprintf("%d image elements, expected 1", elements)}
+	}
+
+	elementSize := int(le.Uint32(bytes[rdtElementSizeOffset:]))
+	if rdtDataOffset+elementSize > size-rdtSuffixSize {
+		return &RdtError{rdtElementSizeOffset, 4,
+			fmt.Sprintf("image element size %d exceeds file size",
+				elementSize)}
+	}
+
+	targetSize := int(le.Uint32(bytes[rdtTargetSizeOffset:]))
+	if targetSize != elementSize+8 {
+		return &RdtError{rdtTargetSizeOffset, 4,
+			fmt.Sprintf("target size %d doesn't match element size %d",
+				targetSize, elementSize)}
+	}
+
+	imageSize := int(le.Uint32(bytes[rdtImageSizeOffset:]))
+	if imageSize != rdtDataOffset+elementSize {
+		return &RdtError{rdtImageSizeOffset, 4,
+			fmt.Sprintf("image size %d doesn't match element size %d",
+				imageSize, elementSize)}
+	}
+
+	suffix := bytes[size-rdtSuffixSize:]
+	if string(suffix[8:11]) != rdtSuffixSignature || suffix[11] != rdtSuffixSize {
+		return &RdtError{size - rdtSuffixSize, rdtSuffixSize,
+			"bad DFU suffix"}
+	}
+
+	crc := le.Uint32(suffix[12:])
+	if crc != vendorRdtCRC && crc != rdtCRC(bytes) {
+		return &RdtError{0, size - 4,
+			fmt.Sprintf("CRC mismatch: file has %#08x, computed %#08x",
+				crc, rdtCRC(bytes))}
+	}
+
+	return nil
+}
+
+// fixRdt regenerates the container fields of the given rdt file bytes,
+// whose image element holds elementSize bytes, including the DFU
+// suffix CRC.
+func fixRdt(bytes []byte, elementSize int) {
+	size := len(bytes)
+	le := binary.LittleEndian
+
+	copy(bytes[rdtSignatureOffset:], rdtSignature)
+	bytes[rdtVersionOffset] = 1
+	le.PutUint32(bytes[rdtImageSizeOffset:], uint32(rdtDataOffset+elementSize))
+	bytes[rdtTargetsOffset] = 1
+	copy(bytes[rdtTargetOffset:], rdtTargetSignature)
+	le.PutUint32(bytes[rdtTargetSizeOffset:], uint32(elementSize+8))
+	le.PutUint32(bytes[rdtElementsOffset:], 1)
+	le.PutUint32(bytes[rdtElementSizeOffset:], uint32(elementSize))
+
+	suffix := bytes[size-rdtSuffixSize:]
+	copy(suffix[8:], rdtSuffixSignature)
+	suffix[11] = rdtSuffixSize
+	le.PutUint32(suffix[12:], rdtCRC(bytes))
+}
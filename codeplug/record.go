@@ -27,16 +27,36 @@ package codeplug
 
 import (
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // A Record represents a record within a Codeplug.
 type Record struct {
 	*rDesc
-	fDesc  *map[FieldType]*fDesc
+	fDesc        *map[FieldType]*fDesc
+	rIndex       int
+	location     *Location
+	locked       bool
+	lockedFields []FieldType
+	modification *Modification
+	tags         []string
+	extras       map[string]string
+	lazy         *lazyRecord
+}
+
+// A lazyRecord holds the state of a record read from the codeplug
+// whose fields, other than its name, are parsed on first use.
+type lazyRecord struct {
+	once   sync.Once
+	loaded bool
+
+	// rIndex is the record's index in the codeplug when it was read.
 	rIndex int
+
+	// name holds the record's name fields, parsed when it was read.
+	name *fDesc
 }
 
 // An rDesc contains a record type's dynamic information.
@@ -45,6 +65,7 @@ type rDesc struct {
 	codeplug        *Codeplug
 	records         []*Record
 	cachedListNames *[]string
+	listNamesMutex  sync.Mutex
 }
 
 // A recordInfo contains a record type's static information.
@@ -92,7 +113,7 @@ func (rd *rDesc) loadRecords() {
 				r = cp.newRecord(rd.rType, rIndex)
 			}
 
-			r.load()
+			r.loadName()
 			nameField := r.NameField()
 			if nameField != nil && nameField.String() == "" {
 				continue
@@ -111,7 +132,7 @@ func (r *Record) NewField(fType FieldType) *Field {
 		m := make(map[FieldType]*fDesc)
 		r.fDesc = &m
 	}
-	fd := (*r.fDesc)[fType]
+	fd := r.fDescs()[fType]
 	f := new(Field)
 	if fd == nil {
 		for _, fi := range r.rDesc.fieldInfos {
@@ -126,7 +147,7 @@ func (r *Record) NewField(fType FieldType) *Field {
 		}
 		fd.record = r
 		fd.fields = make([]*Field, 0)
-		(*r.fDesc)[fType] = fd
+		r.fDescs()[fType] = fd
 	}
 	f.fDesc = fd
 	f.value = newValue(fd.valueType)
@@ -140,7 +161,7 @@ func (r *Record) addField(f *Field) error {
 		return fmt.Errorf("too many fields: %s", string(f.fType))
 	}
 
-	fd := (*r.fDesc)[f.fType]
+	fd := r.fDescs()[f.fType]
 	f.fIndex = len(fd.fields)
 	fd.fields = append(fd.fields, f)
 
@@ -150,58 +171,92 @@ func (r *Record) addField(f *Field) error {
 // load replaces the record's contents with the fields found in
 // the codeplug.
 func (r *Record) load() {
-	ri := r.rDesc.recordInfo
+	for _, fi := range r.rDesc.recordInfo.fieldInfos {
+		(*r.fDesc)[fi.fType] = r.loadFields(fi, r.rIndex)
+	}
+}
 
-	for i := range ri.fieldInfos {
-		fi := ri.fieldInfos[i]
-		fi.index = i
-		if fi.max == 0 {
-			fi.max = 1
+// loadName parses only the record's name fields from the codeplug,
+// leaving its other fields to be parsed on first use.
+func (r *Record) loadName() {
+	r.lazy = &lazyRecord{rIndex: r.rIndex}
+
+	for _, fi := range r.rDesc.recordInfo.fieldInfos {
+		if fi.fType == r.nameFieldType {
+			fd := r.loadFields(fi, r.rIndex)
+			(*r.fDesc)[fi.fType] = fd
+			r.lazy.name = fd
 		}
-		fi.recordInfo = ri
-		fd := &fDesc{fieldInfo: fi}
-		(*r.fDesc)[fi.fType] = fd
-		if fi.valueType == VtName || fi.valueType == VtUniqueName {
-			ri.nameFieldType = fi.fType
+	}
+}
+
+// loadRest parses the fields of a record read by loadName, from where
+// the record was when it was read.
+func (r *Record) loadRest() {
+	for _, fi := range r.rDesc.recordInfo.fieldInfos {
+		if (*r.fDesc)[fi.fType] == nil {
+			(*r.fDesc)[fi.fType] = r.loadFields(fi, r.lazy.rIndex)
 		}
-		fd.record = r
+	}
+	r.lazy.loaded = true
+}
+
+// fDescs returns the record's field descriptions, first parsing its
+// fields if that has been put off.
+func (r *Record) fDescs() map[FieldType]*fDesc {
+	if r.lazy != nil {
+		r.lazy.once.Do(r.loadRest)
 	}
 
-	for _, fd := range *r.fDesc {
-		fi := fd.fieldInfo
-		fields := make([]*Field, fi.max)
-
-		length := 0
-		for fIndex := range fields {
-			if !fd.fieldDeleted(r, fIndex) {
-				f := fields[fIndex]
-				if f == nil {
-					f = &Field{}
-				}
-
-				f.fDesc = fd
-				f.fIndex = fIndex
-				f.value = newValue(fi.valueType)
-
-				f.load()
-
-				span := f.span
-				if span != nil {
-					if span.scale == 0 {
-						span.scale = 1
-					}
-					if span.interval == 0 {
-						span.interval = 1
-					}
-				}
-
-				fields[length] = f
-				length++
+	return *r.fDesc
+}
+
+// pending returns true if the record's fields have not yet been parsed.
+func (r *Record) pending() bool {
+	return r.lazy != nil && !r.lazy.loaded
+}
+
+// loadFields returns the fields of the given type parsed from the
+// record at rIndex in the codeplug.
+func (r *Record) loadFields(fi *fieldInfo, rIndex int) *fDesc {
+	from := r
+	if rIndex != r.rIndex {
+		from = &Record{rDesc: r.rDesc, rIndex: rIndex}
+	}
+	fd := &fDesc{fieldInfo: fi, record: from}
+
+	fields := make([]*Field, fi.max)
+	length := 0
+	for fIndex := range fields {
+		if fd.fieldDeleted(from, fIndex) {
+			continue
+		}
+
+		f := &Field{}
+		f.fDesc = fd
+		f.fIndex = fIndex
+		f.value = newValue(fi.valueType)
+
+		f.load()
+
+		span := f.span
+		if span != nil {
+			if span.scale == 0 {
+				span.scale = 1
+			}
+			if span.interval == 0 {
+				span.interval = 1
 			}
 		}
 
-		fd.fields = fields[:length]
+		fields[length] = f
+		length++
 	}
+
+	fd.fields = fields[:length]
+	fd.record = r
+
+	return fd
 }
 
 // valid returns nil if all fields in the record are valid.
@@ -224,6 +279,12 @@ func (r *Record) valid() error {
 
 // stores stores all all fields of the record into the given byte slice.
 func (r *Record) store() {
+	// A record whose fields were never parsed is unchanged, and is
+	// still in place in the codeplug.
+	if r.pending() {
+		return
+	}
+
 	for _, fd := range *r.fDesc {
 		for fIndex := 0; fIndex < fd.max; fIndex++ {
 			if fIndex < len(fd.fields) {
@@ -237,21 +298,15 @@ func (r *Record) store() {
 
 // FieldTypes return all valid FieldTypes for the record.
 func (r *Record) FieldTypes() []FieldType {
-	fds := *r.fDesc
-
-	indexedStrs := make(map[int]string)
-	indexes := make([]int, 0, len(fds))
-
-	for fType, fd := range fds {
-		index := fd.fieldInfo.index
-		indexes = append(indexes, index)
-		indexedStrs[index] = string(fType)
-	}
-	sort.Ints(indexes)
-
-	fTypes := make([]FieldType, len(indexes))
-	for i, index := range indexes {
-		fTypes[i] = FieldType(indexedStrs[index])
+	fds := r.fDescs()
+
+	// The field infos are in order of index, the order of the
+	// record's fields.
+	fTypes := make([]FieldType, 0, len(fds))
+	for _, fi := range r.fieldInfos {
+		if fds[fi.fType] != nil {
+			fTypes = append(fTypes, fi.fType)
+		}
 	}
 
 	return fTypes
@@ -259,7 +314,7 @@ func (r *Record) FieldTypes() []FieldType {
 
 // Fields returns a slice of all fields of the given type in the record.
 func (r *Record) Fields(fType FieldType) []*Field {
-	fDesc := (*r.fDesc)[fType]
+	fDesc := r.fDescs()[fType]
 	if fDesc == nil {
 		return nil
 	}
@@ -278,7 +333,7 @@ func (r *Record) Field(fType FieldType) *Field {
 // MaxFields returns the maximum number of fields of the given type for
 // record.
 func (r *Record) MaxFields(fType FieldType) int {
-	return (*r.fDesc)[fType].max
+	return r.fDescs()[fType].max
 }
 
 // Type returns the record's type.
@@ -308,10 +363,18 @@ func (r *Record) Codeplug() *Codeplug {
 
 // NameField returns the field containing the record's name.
 func (r *Record) NameField() *Field {
-	if (*r.fDesc)[r.nameFieldType] == nil {
+	// The name fields of a record are parsed when it is read, so
+	// they are found without parsing the rest of it.
+	var fd *fDesc
+	if r.lazy != nil {
+		fd = r.lazy.name
+	} else {
+		fd = (*r.fDesc)[r.nameFieldType]
+	}
+	if fd == nil || len(fd.fields) == 0 {
 		return nil
 	}
-	return r.Field(r.nameFieldType)
+	return fd.fields[0]
 }
 
 // NameType returns the fieldtype containing the record's name field.
@@ -396,6 +459,9 @@ func (r *Record) makeNameUnique(namesp *[]string) error {
 
 // ListNames returns a slice of the names of all records in the rDesc.
 func (rd *rDesc) ListNames() *[]string {
+	rd.listNamesMutex.Lock()
+	defer rd.listNamesMutex.Unlock()
+
 	lenCachedListNames := 0
 	if rd.cachedListNames != nil {
 		lenCachedListNames = len(*rd.cachedListNames)
@@ -506,14 +572,14 @@ func (r *Record) MoveField(dIndex int, f *Field) {
 func (r *Record) InsertField(f *Field) error {
 	fType := f.fType
 	i := f.fIndex
-	fields := (*r.fDesc)[fType].fields
+	fields := r.fDescs()[fType].fields
 	fields = append(fields[:i], append([]*Field{f}, fields[i:]...)...)
 
 	for i, f := range fields {
 		f.fIndex = i
 	}
 
-	(*r.fDesc)[fType].fields = fields
+	r.fDescs()[fType].fields = fields
 
 	return nil
 }
@@ -537,13 +603,21 @@ func (r *Record) RemoveField(f *Field) {
 	for i, f := range fields {
 		f.fIndex = i
 	}
-	(*r.fDesc)[fType].fields = fields
+	r.fDescs()[fType].fields = fields
 }
 
 func (or *Record) Copy() *Record {
 	r := new(Record)
 	r.rDesc = or.rDesc
 	r.rIndex = 0
+	if or.location != nil {
+		loc := *or.location
+		r.location = &loc
+	}
+	r.locked = or.locked
+	r.lockedFields = append([]FieldType{}, or.lockedFields...)
+	r.tags = append([]string(nil), or.tags...)
+	r.extras = copyExtras(or.extras)
 
 	for _, fType := range or.FieldTypes() {
 		for _, of := range or.Fields(fType) {
@@ -565,7 +639,7 @@ func recordNames(records []*Record) []string {
 }
 
 func (r *Record) FindFieldByName(fType FieldType, name string) *Field {
-	allFields := (*r.fDesc)[fType].fields
+	allFields := r.fDescs()[fType].fields
 	for _, f := range allFields {
 		if f.String() == name {
 			return f
@@ -0,0 +1,246 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A RenameRule describes how to generate new names for a set of records.
+//
+// Pattern is a regular expression matched against each record's name.
+// Records whose names do not match are left unchanged.  An empty
+// Pattern matches every name.
+//
+// Replacement is a name template producing the new name.  Its
+// variables are {name}, the current name, {n}, the record's number,
+// and the pattern's submatches, by index, as in {1}, or by name.
+//
+// Records are numbered in order beginning at Start and incrementing
+// by Step, which defaults to 1.  Numbers are padded with zeros to
+// Width digits.
+//
+// Case, if non-empty, is one of "upper", "lower", or "title" and is
+// applied to the new name.
+type RenameRule struct {
+	Pattern     string
+	Replacement string
+	Start       int
+	Step        int
+	Width       int
+	Case        string
+}
+
+// A Rename is a proposed new name for a record.  Err is non-nil if the
+// record cannot be given the new name.
+type Rename struct {
+	Record  *Record
+	OldName string
+	NewName string
+	Err     error
+}
+
+// RenameCases returns the valid values of a RenameRule's Case.
+func RenameCases() []string {
+	return []string{"upper", "lower", "title"}
+}
+
+// PreviewRenames returns the renames that would result from applying
+// rule to records, which must all be of the same type.  Records whose
+// names would not change are omitted.  The codeplug is not modified.
+func (cp *Codeplug) PreviewRenames(records []*Record, rule *RenameRule) ([]Rename, error) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	rType := records[0].Type()
+	for _, r := range records {
+		if r.Type() != rType {
+			return nil, fmt.Errorf("records to rename must all be %s", rType)
+		}
+	}
+
+	maxLen, err := cp.nameMaxLength(rType)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, err := regexp.Compile(rule.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("bad rename pattern: %s", err.Error())
+	}
+
+	template, err := ParseNameTemplate(rule.Replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rule.Case {
+	case "", "upper", "lower", "title":
+	default:
+		return nil, fmt.Errorf("bad rename case: %s", rule.Case)
+	}
+
+	step := rule.Step
+	if step == 0 {
+		step = 1
+	}
+
+	var renames []Rename
+	n := rule.Start
+	for _, r := range records {
+		oldName := r.Name()
+		matches := pattern.FindStringSubmatch(oldName)
+		if matches == nil {
+			continue
+		}
+
+		vars := map[string]string{
+			"name": oldName,
+			"n":    fmt.Sprintf("%0*d", rule.Width, n),
+		}
+		n += step
+		for i, name := range pattern.SubexpNames() {
+			vars[strconv.Itoa(i)] = matches[i]
+			if name != "" {
+				vars[name] = matches[i]
+			}
+		}
+
+		newName, err := template.Expand(vars)
+		if err != nil {
+			return nil, err
+		}
+		newName = renameCase(newName, rule.Case)
+		if newName == oldName {
+			continue
+		}
+
+		rename := Rename{Record: r, OldName: oldName, NewName: newName}
+		switch {
+		case newName == "":
+			rename.Err = fmt.Errorf("empty name")
+
+		case utf8.RuneCountInString(newName) > maxLen:
+			rename.Err = fmt.Errorf("name is longer than %d characters", maxLen)
+
+		default:
+			rename.Err = r.NameField().CheckUnlocked()
+		}
+		renames = append(renames, rename)
+	}
+
+	cp.checkRenameDuplicates(rType, renames)
+
+	return renames, nil
+}
+
+// checkRenameDuplicates sets the Err of each rename whose new name
+// would duplicate the name of another record of type rType.
+func (cp *Codeplug) checkRenameDuplicates(rType RecordType, renames []Rename) {
+	names := make(map[string]int)
+	renamed := make(map[*Record]string)
+	for _, rename := range renames {
+		renamed[rename.Record] = rename.NewName
+	}
+	for _, r := range cp.records(rType) {
+		name, ok := renamed[r]
+		if !ok {
+			name = r.Name()
+		}
+		names[name]++
+	}
+
+	for i := range renames {
+		rename := &renames[i]
+		if rename.Err == nil && names[rename.NewName] > 1 {
+			rename.Err = fmt.Errorf("duplicate name")
+		}
+	}
+}
+
+// ApplyRenames renames the records as previewed by PreviewRenames.
+// The renames are made as a single change, so they may be undone
+// together.  No records are renamed if any rename has an error.
+func (cp *Codeplug) ApplyRenames(renames []Rename) error {
+	if len(renames) == 0 {
+		return nil
+	}
+
+	for _, rename := range renames {
+		if rename.Err != nil {
+			return fmt.Errorf("%s: %s", rename.OldName, rename.Err.Error())
+		}
+	}
+
+	records := make([]*Record, len(renames))
+	previousNames := make([]string, len(renames))
+	for i, rename := range renames {
+		records[i] = rename.Record
+		previousNames[i] = rename.Record.Name()
+	}
+
+	for i, rename := range renames {
+		err := rename.Record.NameField().setString(rename.NewName)
+		if err != nil {
+			for j := i - 1; j >= 0; j-- {
+				records[j].NameField().setString(previousNames[j])
+			}
+			return fmt.Errorf("%s: %s", rename.OldName, err.Error())
+		}
+	}
+
+	renameRecordsChange(records, previousNames).Complete()
+
+	return nil
+}
+
+// renameCase returns name converted to the given case.
+func renameCase(name string, nameCase string) string {
+	switch nameCase {
+	case "upper":
+		return strings.ToUpper(name)
+
+	case "lower":
+		return strings.ToLower(name)
+
+	case "title":
+		words := strings.Fields(name)
+		for i, word := range words {
+			runes := []rune(strings.ToLower(word))
+			runes[0] = unicode.ToUpper(runes[0])
+			words[i] = string(runes)
+		}
+		return strings.Join(words, " ")
+	}
+
+	return name
+}
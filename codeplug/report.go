@@ -0,0 +1,163 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// UsersRegionSize is the size, in bytes, of the region of SPI flash that
+// holds the user database: everything above the first megabyte of the
+// 16 MB flash.
+const UsersRegionSize = 16*1024*1024 - 0x100000
+
+// Usage is the use of one kind of codeplug storage.
+type Usage struct {
+	Name    string  `json:"name"`
+	Count   int     `json:"count"`
+	Max     int     `json:"max"`
+	Percent float64 `json:"percent"`
+
+	// For records holding lists of members, such as the channels
+	// of a zone, Largest is the name of the record with the most
+	// members, which number Members out of at most MaxMembers.
+	Largest    string `json:"largest,omitempty"`
+	Members    int    `json:"members,omitempty"`
+	MaxMembers int    `json:"maxMembers,omitempty"`
+}
+
+// Report describes how much of a codeplug's capacity is used.
+type Report struct {
+	Model          string  `json:"model"`
+	FrequencyRange string  `json:"frequencyRange"`
+	Records        []Usage `json:"records"`
+	Users          *Usage  `json:"users,omitempty"`
+}
+
+func newUsage(name string, count int, max int) Usage {
+	u := Usage{Name: name, Count: count, Max: max}
+	if max > 0 {
+		u.Percent = 100 * float64(count) / float64(max)
+	}
+	return u
+}
+
+// Report returns the number of records of each type in the codeplug
+// and the percentage of the radio's capacity they use.
+func (cp *Codeplug) Report() *Report {
+	report := &Report{
+		Model:          cp.Model(),
+		FrequencyRange: cp.FrequencyRange(),
+	}
+
+	for _, rType := range cp.RecordTypes() {
+		rd := cp.rDesc[rType]
+		if rd.max <= 1 {
+			continue
+		}
+
+		records := cp.records(rType)
+		u := newUsage(rd.typeName, len(records), rd.max)
+		for _, fi := range rd.fieldInfos {
+			if fi.max <= 1 || fi.listRecordType == "" {
+				continue
+			}
+			u.MaxMembers = fi.max
+			for _, r := range records {
+				n := len(r.Fields(fi.fType))
+				if u.Largest == "" || n > u.Members {
+					u.Largest = r.Name()
+					u.Members = n
+				}
+			}
+		}
+		report.Records = append(report.Records, u)
+	}
+
+	return report
+}
+
+// AddUsers adds the use of the user database region by a user
+// database image of the given size to the report.
+func (report *Report) AddUsers(size int64) {
+	u := newUsage("User Database (bytes)", int(size), UsersRegionSize)
+	report.Users = &u
+}
+
+// Fits returns true if everything in the report fits in the radio.
+func (report *Report) Fits() bool {
+	usages := report.Records
+	if report.Users != nil {
+		usages = append(usages[:len(usages):len(usages)], *report.Users)
+	}
+	for _, u := range usages {
+		if u.Count > u.Max || u.Members > u.MaxMembers {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the report as text.
+func (report *Report) String() string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "%s %s\n", report.Model, report.FrequencyRange)
+
+	usages := report.Records
+	if report.Users != nil {
+		usages = append(usages[:len(usages):len(usages)], *report.Users)
+	}
+	for _, u := range usages {
+		fmt.Fprintf(&buf, "%-24s %8d of %8d %6.1f%%\n",
+			u.Name+":", u.Count, u.Max, u.Percent)
+		if u.MaxMembers != 0 && u.Largest != "" {
+			fmt.Fprintf(&buf, "%-24s %8d of %8d in %s\n",
+				"  largest:", u.Members, u.MaxMembers, u.Largest)
+		}
+	}
+
+	if report.Fits() {
+		fmt.Fprintf(&buf, "Fits in the radio.\n")
+	} else {
+		fmt.Fprintf(&buf, "Does not fit in the radio.\n")
+	}
+
+	return buf.String()
+}
+
+// WriteJSON writes the report as JSON.
+func (report *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(struct {
+		*Report
+		Fits bool `json:"fits"`
+	}{report, report.Fits()})
+}
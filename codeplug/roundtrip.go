@@ -0,0 +1,160 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A ByteDifference describes a byte of a codeplug image that changed
+// when the image was parsed into records and stored again.
+type ByteDifference struct {
+	// Offset is the byte's offset in the image.
+	Offset int
+
+	// Record names the record slot containing the byte, as in
+	// "Channels 12", or is empty if the byte is outside any record.
+	Record string
+
+	// Fields names the fields sharing the byte, if any.
+	Fields []string
+
+	Original byte
+	Stored   byte
+}
+
+func (d ByteDifference) String() string {
+	location := d.Record
+	if location == "" {
+		location = "(no record)"
+	}
+	if len(d.Fields) != 0 {
+		location += ": " + strings.Join(d.Fields, ", ")
+	}
+
+	return fmt.Sprintf("0x%06x %s: 0x%02x became 0x%02x",
+		d.Offset, location, d.Original, d.Stored)
+}
+
+// VerifyRoundTrip parses a raw rdt codeplug image into records and
+// stores them again, returning the bytes that differ from the image.
+// A radio model's definitions must round-trip every valid image
+// without differences; an image that doesn't round-trip would be
+// changed by loading and saving it.
+func VerifyRoundTrip(image []byte) ([]ByteDifference, error) {
+	err := checkRdt(image)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cpi := range codeplugInfos {
+		if cpi.RdtSize != len(image) {
+			continue
+		}
+
+		cp := newImageCodeplug(cpi, image, 0)
+		cp.loadHeader()
+		if containsString(cpi.Models, cp.Model()) {
+			return cp.roundTrip(image, 0), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown codeplug model or size")
+}
+
+// VerifyBinRoundTrip is like VerifyRoundTrip, but for a bin image as
+// read from a radio.  Bin images don't name their model, so it must be
+// given.
+func VerifyBinRoundTrip(image []byte, model string) ([]ByteDifference, error) {
+	for _, cpi := range codeplugInfos {
+		if !containsString(cpi.Models, model) {
+			continue
+		}
+		if cpi.BinSize != len(image) {
+			return nil, fmt.Errorf("%s bin images are %d bytes, not %d", model, cpi.BinSize, len(image))
+		}
+
+		cp := newImageCodeplug(cpi, image, cpi.BinOffset)
+		return cp.roundTrip(image, cpi.BinOffset), nil
+	}
+
+	return nil, fmt.Errorf("unknown model: %s", model)
+}
+
+// newImageCodeplug returns a codeplug of the given type holding a copy
+// of image at offset.  The codeplug is not added to Codeplugs().
+func newImageCodeplug(cpi *CodeplugInfo, image []byte, offset int) *Codeplug {
+	cp := new(Codeplug)
+	cp.fileType = FileTypeRdt
+	if offset != 0 {
+		cp.fileType = FileTypeBin
+	}
+	cp.codeplugInfo = cpi
+	cp.rDesc = make(map[RecordType]*rDesc)
+	cp.changeList = []*Change{&Change{}}
+	cp.bytes = make([]byte, cpi.RdtSize)
+	copy(cp.bytes[offset:], image)
+
+	return cp
+}
+
+// roundTrip loads and stores the codeplug's records, returning the
+// bytes of image, found at offset in the codeplug, that changed.
+func (cp *Codeplug) roundTrip(image []byte, offset int) []ByteDifference {
+	cp.load()
+	cp.loadFields()
+	cp.store()
+
+	fieldNames := make(map[int][]string)
+	cp.fieldBits(func(fi *fieldInfo, fOffset int, bits byte) {
+		name := "deleted record indicator"
+		if fi != nil {
+			name = fi.typeName
+		}
+		names := fieldNames[fOffset]
+		if len(names) == 0 || names[len(names)-1] != name {
+			fieldNames[fOffset] = append(names, name)
+		}
+	})
+
+	var diffs []ByteDifference
+	for i, b := range image {
+		stored := cp.bytes[offset+i]
+		if stored == b {
+			continue
+		}
+		diffs = append(diffs, ByteDifference{
+			Offset:   i,
+			Record:   cp.recordSlotName(offset + i),
+			Fields:   fieldNames[offset+i],
+			Original: b,
+			Stored:   stored,
+		})
+	}
+
+	return diffs
+}
@@ -0,0 +1,389 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// A Rule is a house rule, such as a club's naming convention or its
+// list of allowed talkgroups, that records must follow.  A rule applies
+// to the records of its record type meeting all of its conditions and
+// constrains the values of one of their fields.  Rules are read from
+// JSON files holding a list of rules:
+//
+//	[
+//		{
+//			"name": "channel names",
+//			"field": "Name",
+//			"pattern": "^[A-Z0-9][A-Za-z0-9 ./-]*$",
+//			"message": "channel names start with a capital or digit"
+//		},
+//		{
+//			"name": "club talkgroups",
+//			"recordType": "Contacts",
+//			"match": {"CallType": "^Group$"},
+//			"field": "CallID",
+//			"oneOf": ["9", "91", "3100", "310997"],
+//			"message": "talkgroup not on the club list"
+//		}
+//	]
+type Rule struct {
+	Name string `json:"name"`
+
+	// RecordType is the name, as used in text files, of the type of
+	// the records the rule applies to.  The default is "Channels".
+	RecordType string `json:"recordType,omitempty"`
+
+	// Match maps field names, as used in text files, to regular
+	// expressions the fields' values must match for the rule to
+	// apply.  A field having several values matches if any does.
+	Match map[string]string `json:"match,omitempty"`
+
+	// Tagged, if non-empty, is a tag filter, as accepted by
+	// ParseTagFilter, selecting the records the rule applies to.
+	Tagged string `json:"tagged,omitempty"`
+
+	// Field is the name of the field constrained.  Each value of a
+	// field having several values is checked separately.
+	Field string `json:"field"`
+
+	// Pattern, if non-empty, is a regular expression the field's
+	// value must match.
+	Pattern string `json:"pattern,omitempty"`
+
+	// OneOf, if non-empty, lists the values the field may have, and
+	// NoneOf lists values it may not have.
+	OneOf  []string `json:"oneOf,omitempty"`
+	NoneOf []string `json:"noneOf,omitempty"`
+
+	// Min and Max, if non-zero, limit the field's numeric value.
+	Min float64 `json:"min,omitempty"`
+	Max float64 `json:"max,omitempty"`
+
+	// MaxLength, if non-zero, is the maximum number of characters
+	// in the field's value.
+	MaxLength int `json:"maxLength,omitempty"`
+
+	// Message describes a violation of the rule.  If empty, the
+	// constraint violated is described.
+	Message string `json:"message,omitempty"`
+
+	rType   RecordType
+	fType   FieldType
+	matches map[FieldType]*regexp.Regexp
+	filter  *TagFilter
+	pattern *regexp.Regexp
+}
+
+// A RuleViolation is a record, or one of its fields, breaking a rule.
+// Field is nil if the violation is not of a particular field, and
+// Record is nil if it is not of a particular record.
+type RuleViolation struct {
+	Rule    string
+	Record  *Record
+	Field   *Field
+	Message string
+}
+
+// String returns a description of the violation.
+func (v *RuleViolation) String() string {
+	if v.Field != nil {
+		return fmt.Sprintf("%s: is %s, rule '%s': %s",
+			v.Field.FullTypeName(), quoteString(v.Field.String()), v.Rule, v.Message)
+	}
+
+	if v.Record != nil {
+		return fmt.Sprintf("%s: rule '%s': %s", v.Record.lockName(), v.Rule, v.Message)
+	}
+
+	return fmt.Sprintf("rule '%s': %s", v.Rule, v.Message)
+}
+
+// A Validator checks a codeplug against rules too involved for a rules
+// file, returning the violations found.
+type Validator func(cp *Codeplug) []RuleViolation
+
+var validators = make(map[string]Validator)
+
+// RegisterValidator adds a validator, replacing any previously
+// registered validator of the same name.  Registered validators are
+// applied to every codeplug along with its rules.
+func RegisterValidator(name string, validator Validator) error {
+	if name == "" {
+		return fmt.Errorf("validator has no name")
+	}
+	if validator == nil {
+		return fmt.Errorf("validator %s: no function", name)
+	}
+
+	validators[name] = validator
+
+	return nil
+}
+
+// ParseRules returns the rules described by the JSON in data.
+func ParseRules(data []byte) ([]*Rule, error) {
+	var rules []*Rule
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&rules)
+	if err != nil {
+		return nil, fmt.Errorf("bad rules: %s", err.Error())
+	}
+
+	for i, rule := range rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("%d", i+1)
+		}
+		if rule.RecordType == "" {
+			rule.RecordType = string(RtChannels_md380)
+		}
+	}
+
+	return rules, nil
+}
+
+// LoadRules returns the rules in the named JSON file.
+func LoadRules(filename string) ([]*Rule, error) {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := ParseRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return rules, nil
+}
+
+// SetRules sets the rules the codeplug's records must follow.
+// Violations are reported as warnings when the codeplug is validated,
+// as when it is saved.
+func (cp *Codeplug) SetRules(rules []*Rule) error {
+	for _, rule := range rules {
+		err := rule.compile(cp)
+		if err != nil {
+			return fmt.Errorf("rule '%s': %s", rule.Name, err.Error())
+		}
+	}
+
+	cp.rules = rules
+
+	return nil
+}
+
+// Rules returns the codeplug's rules.
+func (cp *Codeplug) Rules() []*Rule {
+	return cp.rules
+}
+
+// compile checks the rule's record type, conditions and constraints,
+// saving them in the forms used to apply the rule.
+func (rule *Rule) compile(cp *Codeplug) error {
+	rType, err := cp.nameToRt(rule.RecordType)
+	if err != nil {
+		return err
+	}
+	rule.rType = rType
+
+	names := make([]string, 0, len(rule.Match))
+	for name := range rule.Match {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rule.matches = make(map[FieldType]*regexp.Regexp)
+	for _, name := range names {
+		fType, err := cp.nameToFt(rType, name)
+		if err != nil {
+			return err
+		}
+		pattern, err := regexp.Compile(rule.Match[name])
+		if err != nil {
+			return fmt.Errorf("bad match for %s: %s", name, err.Error())
+		}
+		rule.matches[fType] = pattern
+	}
+
+	rule.filter = nil
+	if rule.Tagged != "" {
+		filter, err := ParseTagFilter(rule.Tagged)
+		if err != nil {
+			return err
+		}
+		rule.filter = filter
+	}
+
+	if rule.Field == "" {
+		return fmt.Errorf("no field")
+	}
+	rule.fType, err = cp.nameToFt(rType, rule.Field)
+	if err != nil {
+		return err
+	}
+
+	rule.pattern = nil
+	if rule.Pattern != "" {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return fmt.Errorf("bad pattern: %s", err.Error())
+		}
+		rule.pattern = pattern
+	}
+
+	if rule.pattern == nil && len(rule.OneOf) == 0 && len(rule.NoneOf) == 0 &&
+		rule.Min == 0 && rule.Max == 0 && rule.MaxLength == 0 {
+		return fmt.Errorf("no constraint")
+	}
+
+	return nil
+}
+
+// appliesTo returns true if the record meets the rule's conditions.
+func (rule *Rule) appliesTo(r *Record) bool {
+	for fType, pattern := range rule.matches {
+		matched := false
+		for _, f := range r.Fields(fType) {
+			if pattern.MatchString(f.String()) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if rule.filter != nil && !rule.filter.Match(r) {
+		return false
+	}
+
+	return true
+}
+
+// check returns a description of the constraint the value breaks, or
+// the empty string if it breaks none.
+func (rule *Rule) check(value string) string {
+	if rule.pattern != nil && !rule.pattern.MatchString(value) {
+		return fmt.Sprintf("must match %s", rule.Pattern)
+	}
+
+	if len(rule.OneOf) != 0 && !stringInSlice(value, rule.OneOf) {
+		return fmt.Sprintf("must be one of %s", strings.Join(rule.OneOf, ", "))
+	}
+
+	if stringInSlice(value, rule.NoneOf) {
+		return fmt.Sprintf("must not be %s", value)
+	}
+
+	if rule.Min != 0 || rule.Max != 0 {
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "must be a number"
+		}
+		if rule.Min != 0 && n < rule.Min {
+			return fmt.Sprintf("must be at least %g", rule.Min)
+		}
+		if rule.Max != 0 && n > rule.Max {
+			return fmt.Sprintf("must be at most %g", rule.Max)
+		}
+	}
+
+	if rule.MaxLength != 0 && utf8.RuneCountInString(value) > rule.MaxLength {
+		return fmt.Sprintf("must be at most %d characters", rule.MaxLength)
+	}
+
+	return ""
+}
+
+// RuleViolations returns the violations of the codeplug's rules and
+// of the registered validators.
+func (cp *Codeplug) RuleViolations() []RuleViolation {
+	var violations []RuleViolation
+	for _, rule := range cp.rules {
+		for _, r := range cp.records(rule.rType) {
+			if !rule.appliesTo(r) {
+				continue
+			}
+			for _, f := range r.Fields(rule.fType) {
+				msg := rule.check(f.String())
+				if msg == "" {
+					continue
+				}
+				if rule.Message != "" {
+					msg = rule.Message
+				}
+				violations = append(violations, RuleViolation{
+					Rule:    rule.Name,
+					Record:  r,
+					Field:   f,
+					Message: msg,
+				})
+			}
+		}
+	}
+
+	names := make([]string, 0, len(validators))
+	for name := range validators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, v := range validators[name](cp) {
+			if v.Rule == "" {
+				v.Rule = name
+			}
+			violations = append(violations, v)
+		}
+	}
+
+	return violations
+}
+
+// rulesValid returns a description of the codeplug's rule violations,
+// one per line.
+func (cp *Codeplug) rulesValid() string {
+	var buf bytes.Buffer
+	for _, v := range cp.RuleViolations() {
+		fmt.Fprintf(&buf, "%s\n", v.String())
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,203 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+)
+
+// ScanListSync reports what SyncScanListsWithZones did.
+type ScanListSync struct {
+	// Added holds the names of the scan lists that were added.
+	Added []string
+
+	// Updated holds the names of existing scan lists whose channels
+	// were changed.
+	Updated []string
+
+	// Assigned holds the names of the channels that were given a
+	// scan list.
+	Assigned []string
+
+	// Truncated holds the names of the scan lists that could not
+	// hold all of their zone's channels.
+	Truncated []string
+
+	// Locked holds the names of the locked scan lists, which were
+	// left alone.
+	Locked []string
+}
+
+// SyncScanListsWithZones makes each zone's channels the channels of
+// the scan list with the same name as the zone, adding that scan list
+// if there is none.  Scan lists that aren't named after a zone are
+// left alone.  A zone with more channels than a scan list can hold
+// gets a scan list of its first channels.  If assign is set, each
+// channel without a scan list is given the scan list of the first
+// zone containing it.  Locked scan lists and locked channel scan list
+// fields are left alone.
+func (cp *Codeplug) SyncScanListsWithZones(assign bool) (*ScanListSync, error) {
+	sync := new(ScanListSync)
+	zones := cp.records(RtZones_md380)
+	if len(zones) == 0 {
+		return sync, nil
+	}
+
+	maxChannels := cp.maxFields(RtScanLists_md380, FtSlChannel_md380)
+	maxLists := cp.MaxRecords(RtScanLists_md380)
+
+	added := 0
+	for _, zone := range zones {
+		if cp.FindRecordByName(RtScanLists_md380, zone.Name()) == nil {
+			added++
+		}
+	}
+	if len(cp.records(RtScanLists_md380))+added > maxLists {
+		return nil, fmt.Errorf("too many scan lists: %d zones, room for %d scan lists",
+			len(zones), maxLists)
+	}
+
+	for _, zone := range zones {
+		name := zone.Name()
+		var channelNames []string
+		for _, f := range zone.Fields(FtZiChannel_md380) {
+			channelNames = append(channelNames, f.String())
+		}
+		if len(channelNames) > maxChannels {
+			channelNames = channelNames[:maxChannels]
+			sync.Truncated = append(sync.Truncated, name)
+		}
+
+		r := cp.FindRecordByName(RtScanLists_md380, name)
+		switch {
+		case r != nil && r.CheckUnlocked() != nil:
+			sync.Locked = append(sync.Locked, name)
+
+		case r != nil:
+			updated, err := setScanListChannels(r, channelNames)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", name, err.Error())
+			}
+			if updated {
+				sync.Updated = append(sync.Updated, name)
+			}
+
+		default:
+			fvs := []fieldValue{
+				{FtSlName, name},
+				{FtSlPriorityChannel1_md380, "None"},
+				{FtSlPriorityChannel2_md380, "None"},
+			}
+			for _, chName := range channelNames {
+				fvs = append(fvs, fieldValue{FtSlChannel_md380, chName})
+			}
+			_, err := cp.addRecord(RtScanLists_md380, fvs)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", name, err.Error())
+			}
+			sync.Added = append(sync.Added, name)
+		}
+
+		if !assign {
+			continue
+		}
+		for _, chName := range channelNames {
+			ch := cp.FindRecordByName(RtChannels_md380, chName)
+			if ch == nil {
+				continue
+			}
+			f := ch.Field(FtCiScanList_md380)
+			if f != nil && f.String() == "None" && f.CheckUnlocked() == nil {
+				err := f.setString(name)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s", chName, err.Error())
+				}
+				sync.Assigned = append(sync.Assigned, chName)
+			}
+		}
+	}
+
+	if len(sync.Added)+len(sync.Updated)+len(sync.Assigned) != 0 {
+		cp.changed = true
+	}
+
+	return sync, nil
+}
+
+// setScanListChannels replaces the channels of the scan list record r
+// with the named channels.  Priority channels that are no longer
+// members of the scan list are cleared.  It returns true if the
+// channels were changed.
+func setScanListChannels(r *Record, channelNames []string) (bool, error) {
+	fields := r.Fields(FtSlChannel_md380)
+	if len(fields) == len(channelNames) {
+		same := true
+		for i, f := range fields {
+			if f.String() != channelNames[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return false, nil
+		}
+	}
+
+	for _, f := range append([]*Field{}, fields...) {
+		r.RemoveField(f)
+	}
+	for _, chName := range channelNames {
+		f, err := r.NewFieldWithValue(FtSlChannel_md380, 0, chName)
+		if err != nil {
+			return false, err
+		}
+		err = r.addField(f)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	member := make(map[string]bool)
+	for _, chName := range channelNames {
+		member[chName] = true
+	}
+	for _, fType := range []FieldType{FtSlPriorityChannel1_md380, FtSlPriorityChannel2_md380} {
+		f := r.Field(fType)
+		if f == nil {
+			continue
+		}
+		switch s := f.String(); {
+		case s == "None", s == "Selected", member[s]:
+		default:
+			err := f.setString("None")
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
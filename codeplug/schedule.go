@@ -0,0 +1,205 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// scheduleDateLayout is the layout of the dates in schedules.
+const scheduleDateLayout = "2006-01-02"
+
+// A ScheduledOverlay is an overlay, such as one adding special-event
+// talkgroups or an emergency net, applied only between two dates.
+// Schedules are read from JSON files holding a list of scheduled
+// overlays, applied in order:
+//
+//	[
+//		{
+//			"name": "Field Day",
+//			"overlay": "fieldday.txt",
+//			"start": "2026-06-27",
+//			"end": "2026-06-28"
+//		},
+//		{
+//			"name": "JOTA",
+//			"overlay": "jota.json",
+//			"start": "2026-10-16",
+//			"end": "2026-10-18"
+//		}
+//	]
+type ScheduledOverlay struct {
+	Name string `json:"name"`
+
+	// Overlay is the name of the overlay file.  A relative name is
+	// relative to the directory of the schedule file.
+	Overlay string `json:"overlay"`
+
+	// Start and End, if non-empty, are the first and last dates,
+	// as in 2006-01-02, on which the overlay is applied.
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+// String returns the overlay's name and dates.
+func (o *ScheduledOverlay) String() string {
+	switch {
+	case o.Start == "" && o.End == "":
+		return fmt.Sprintf("%s (always)", o.Name)
+	case o.Start == "":
+		return fmt.Sprintf("%s (until %s)", o.Name, o.End)
+	case o.End == "":
+		return fmt.Sprintf("%s (from %s)", o.Name, o.Start)
+	}
+
+	return fmt.Sprintf("%s (%s to %s)", o.Name, o.Start, o.End)
+}
+
+// ParseSchedule returns the scheduled overlays described by the JSON
+// in data.
+func ParseSchedule(data []byte) ([]*ScheduledOverlay, error) {
+	var schedule []*ScheduledOverlay
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&schedule)
+	if err != nil {
+		return nil, fmt.Errorf("bad schedule: %s", err.Error())
+	}
+
+	for i, o := range schedule {
+		if o.Overlay == "" {
+			return nil, fmt.Errorf("scheduled overlay %d: no overlay", i+1)
+		}
+		if o.Name == "" {
+			o.Name = strings.TrimSuffix(filepath.Base(o.Overlay), filepath.Ext(o.Overlay))
+		}
+		for _, date := range []string{o.Start, o.End} {
+			if date == "" {
+				continue
+			}
+			_, err := time.Parse(scheduleDateLayout, date)
+			if err != nil {
+				return nil, fmt.Errorf("%s: bad date: %s", o.Name, date)
+			}
+		}
+		if o.Start != "" && o.End != "" && o.End < o.Start {
+			return nil, fmt.Errorf("%s: ends before it starts", o.Name)
+		}
+	}
+
+	return schedule, nil
+}
+
+// LoadSchedule returns the scheduled overlays in the named JSON file.
+func LoadSchedule(filename string) ([]*ScheduledOverlay, error) {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := ParseSchedule(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	dir := filepath.Dir(filename)
+	for _, o := range schedule {
+		if !filepath.IsAbs(o.Overlay) {
+			o.Overlay = filepath.Join(dir, o.Overlay)
+		}
+	}
+
+	return schedule, nil
+}
+
+// Active returns true if the overlay is applied on the date of t.
+func (o *ScheduledOverlay) Active(t time.Time) bool {
+	date := t.Format(scheduleDateLayout)
+	if o.Start != "" && date < o.Start {
+		return false
+	}
+	if o.End != "" && date > o.End {
+		return false
+	}
+
+	return true
+}
+
+// ApplySchedule applies the overlays of the schedule active on the
+// date of t, in order, returning those applied.  Applied to a base
+// codeplug, as by a build each day, overlays appear on their start
+// dates and are gone once their end dates have passed.
+func (cp *Codeplug) ApplySchedule(schedule []*ScheduledOverlay, t time.Time) ([]*ScheduledOverlay, error) {
+	var applied []*ScheduledOverlay
+	for _, o := range schedule {
+		if !o.Active(t) {
+			continue
+		}
+		err := cp.ApplyOverlayFile(o.Overlay)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", o.Name, err.Error())
+		}
+		applied = append(applied, o)
+	}
+
+	return applied, nil
+}
+
+// NextScheduleChange returns the first date after that of t on which
+// the schedule's active overlays differ, as when a codeplug built from
+// it should be rebuilt.  It returns false if they never change.
+func NextScheduleChange(schedule []*ScheduledOverlay, t time.Time) (time.Time, bool) {
+	date := t.Format(scheduleDateLayout)
+	var next string
+	for _, o := range schedule {
+		candidates := []string{o.Start}
+		if o.End != "" {
+			end, _ := time.ParseInLocation(scheduleDateLayout, o.End, t.Location())
+			candidates = append(candidates, end.AddDate(0, 0, 1).Format(scheduleDateLayout))
+		}
+		for _, c := range candidates {
+			if c > date && (next == "" || c < next) {
+				next = c
+			}
+		}
+	}
+
+	if next == "" {
+		return time.Time{}, false
+	}
+
+	nextTime, _ := time.ParseInLocation(scheduleDateLayout, next, t.Location())
+
+	return nextTime, true
+}
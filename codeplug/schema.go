@@ -0,0 +1,134 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+// A RecordSchema describes a record type of a codeplug.
+type RecordSchema struct {
+	Type          RecordType
+	Name          string
+	MaxRecords    int
+	Offset        int
+	Size          int
+	NameFieldType FieldType `json:",omitempty"`
+	Fields        []FieldSchema
+	Groups        []FieldGroup `json:",omitempty"`
+}
+
+// A FieldSchema describes a field type of a record type.
+type FieldSchema struct {
+	Type           FieldType
+	Name           string
+	MaxFields      int
+	BitOffset      int
+	BitSize        int
+	ValueType      ValueType
+	DefaultValue   string          `json:",omitempty"`
+	Minimum        *int            `json:",omitempty"`
+	Maximum        *int            `json:",omitempty"`
+	Step           *int            `json:",omitempty"`
+	MinString      string          `json:",omitempty"`
+	Strings        []string        `json:",omitempty"`
+	IndexedStrings []IndexedString `json:",omitempty"`
+	ListRecordType RecordType      `json:",omitempty"`
+	Enabler        FieldType       `json:",omitempty"`
+	Disabler       FieldType       `json:",omitempty"`
+	EnablingValue  string          `json:",omitempty"`
+}
+
+// Schema returns a description of each of the codeplug's record types
+// and their fields, in the order returned by RecordTypes.  The fields
+// of record types divided into groups are also listed by group, as
+// returned by FieldGroups.
+func (cp *Codeplug) Schema() []RecordSchema {
+	rTypes := cp.RecordTypes()
+	schema := make([]RecordSchema, len(rTypes))
+	for i, rType := range rTypes {
+		schema[i] = recordSchema(cp.rDesc[rType].recordInfo)
+		schema[i].Groups = cp.FieldGroups(rType)
+	}
+
+	return schema
+}
+
+// recordSchema returns the RecordSchema for the given recordInfo.
+func recordSchema(ri *recordInfo) RecordSchema {
+	rs := RecordSchema{
+		Type:          ri.rType,
+		Name:          ri.typeName,
+		MaxRecords:    ri.max,
+		Offset:        ri.offset,
+		Size:          ri.size,
+		NameFieldType: ri.nameFieldType,
+		Fields:        make([]FieldSchema, len(ri.fieldInfos)),
+	}
+
+	for i, fi := range ri.fieldInfos {
+		rs.Fields[i] = fieldSchema(fi)
+	}
+
+	return rs
+}
+
+// fieldSchema returns the FieldSchema for the given fieldInfo.
+func fieldSchema(fi *fieldInfo) FieldSchema {
+	fs := FieldSchema{
+		Type:           fi.fType,
+		Name:           fi.typeName,
+		MaxFields:      fi.max,
+		BitOffset:      fi.bitOffset,
+		BitSize:        fi.bitSize,
+		ValueType:      fi.valueType,
+		DefaultValue:   fi.defaultValue,
+		ListRecordType: fi.listRecordType,
+		Enabler:        fi.enabler,
+		Disabler:       fi.disabler,
+		EnablingValue:  fi.enablingValue,
+	}
+
+	if fi.span != nil {
+		min := fi.span.Minimum()
+		max := fi.span.Maximum()
+		step := fi.span.Step()
+		fs.Minimum = &min
+		fs.Maximum = &max
+		fs.Step = &step
+		fs.MinString = fi.span.MinString()
+	}
+
+	if fi.strings != nil {
+		fs.Strings = append([]string{}, *fi.strings...)
+	}
+
+	if fi.indexedStrings != nil {
+		fs.IndexedStrings = append([]IndexedString{}, *fi.indexedStrings...)
+	}
+
+	if fi.valueType == VtCtcssDcs {
+		fs.Strings = ctcssDcsStrings()
+	}
+
+	return fs
+}
@@ -0,0 +1,160 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// A sheet is a condensed view of a codeplug's zones, channels and
+// contacts, as printed by the cheat sheet exporters.
+type sheet struct {
+	Title    string
+	Zones    []sheetZone
+	Contacts []sheetContact
+}
+
+type sheetZone struct {
+	Name     string
+	Channels []sheetChannel
+}
+
+type sheetChannel struct {
+	Name        string
+	Mode        string
+	RxFrequency string
+	TxFrequency string
+
+	// Access is the color code and time slot of a digital channel,
+	// or the CTCSS/DCS tones of an analog one.
+	Access string
+
+	// Talkgroup is the contact of a digital channel.
+	Talkgroup string
+}
+
+type sheetContact struct {
+	Name     string
+	CallID   string
+	CallType string
+}
+
+// sheetChannelsTitle names the zone listing the channels that are in
+// no zone.
+const sheetChannelsTitle = "Channels in no zone"
+
+// recordString returns the value of the record's field of type fType,
+// or "" if it has none.
+func recordString(r *Record, fType FieldType) string {
+	f := r.Field(fType)
+	if f == nil {
+		return ""
+	}
+
+	return f.String()
+}
+
+// newSheet returns the cheat sheet of the codeplug.
+func (cp *Codeplug) newSheet() *sheet {
+	s := &sheet{
+		Title: strings.TrimSuffix(filepath.Base(cp.Filename()), filepath.Ext(cp.Filename())),
+	}
+	if s.Title == "" || s.Title == "." {
+		s.Title = cp.Model()
+	}
+
+	contactIDs := make(map[string]string)
+	for _, r := range cp.records(RtContacts) {
+		c := sheetContact{
+			Name:     r.Name(),
+			CallID:   recordString(r, FtDcCallID),
+			CallType: recordString(r, FtDcCallType),
+		}
+		contactIDs[c.Name] = c.CallID
+		s.Contacts = append(s.Contacts, c)
+	}
+
+	channels := make(map[string]sheetChannel)
+	var channelNames []string
+	for _, r := range cp.records(RtChannels_md380) {
+		ch := sheetChannel{
+			Name:        r.Name(),
+			Mode:        recordString(r, FtCiChannelMode),
+			RxFrequency: recordString(r, FtCiRxFrequency),
+			TxFrequency: recordString(r, FtCiTxFrequency),
+		}
+		if ch.Mode == "Digital" {
+			ch.Access = "CC" + recordString(r, FtCiColorCode)
+			if slot := recordString(r, FtCiRepeaterSlot); slot != "" {
+				ch.Access += " TS" + slot
+			}
+			contact := recordString(r, FtCiContactName)
+			if id := contactIDs[contact]; id != "" {
+				ch.Talkgroup = contact + " (" + id + ")"
+			}
+		} else {
+			var tones []string
+			if tone := recordString(r, FtCiCtcssEncode); tone != "" && tone != "None" {
+				tones = append(tones, "Tx "+tone)
+			}
+			if tone := recordString(r, FtCiCtcssDecode); tone != "" && tone != "None" {
+				tones = append(tones, "Rx "+tone)
+			}
+			ch.Access = strings.Join(tones, " ")
+		}
+		channels[ch.Name] = ch
+		channelNames = append(channelNames, ch.Name)
+	}
+
+	inZone := make(map[string]bool)
+	for _, r := range cp.records(RtZones_md380) {
+		zone := sheetZone{Name: r.Name()}
+		for _, f := range r.Fields(FtZiChannel_md380) {
+			ch, ok := channels[f.String()]
+			if !ok {
+				continue
+			}
+			zone.Channels = append(zone.Channels, ch)
+			inZone[ch.Name] = true
+		}
+		if len(zone.Channels) > 0 {
+			s.Zones = append(s.Zones, zone)
+		}
+	}
+
+	others := sheetZone{Name: sheetChannelsTitle}
+	for _, name := range channelNames {
+		if !inZone[name] {
+			others.Channels = append(others.Channels, channels[name])
+		}
+	}
+	if len(others.Channels) > 0 {
+		s.Zones = append(s.Zones, others)
+	}
+
+	return s
+}
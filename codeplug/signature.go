@@ -0,0 +1,367 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// The provenance block appended to text and JSON codeplug files starts
+// and ends with these lines.
+const (
+	provenanceBegin = "-----BEGIN CODEPLUG PROVENANCE-----\n"
+	provenanceEnd   = "-----END CODEPLUG PROVENANCE-----\n"
+)
+
+// Provenance describes who made a codeplug file, and with what.  It is
+// kept in a block at the end of a text or JSON codeplug file.
+type Provenance struct {
+	Author string
+	Tool   string
+	Built  time.Time
+
+	// Key is the public key of the file's signer, or nil if the
+	// file is not signed.
+	Key ed25519.PublicKey
+
+	// Trusted is true if the file was signed with a trusted key.
+	// See Codeplug.SetTrustedKeys.
+	Trusted bool
+}
+
+// String returns a one-line description of the provenance.
+func (p *Provenance) String() string {
+	var strs []string
+	if p.Author != "" {
+		strs = append(strs, "by "+p.Author)
+	}
+	if p.Tool != "" {
+		strs = append(strs, "with "+p.Tool)
+	}
+	if !p.Built.IsZero() {
+		strs = append(strs, "at "+p.Built.Format(time.RFC3339))
+	}
+	switch {
+	case p.Key == nil:
+		strs = append(strs, "unsigned")
+	case p.Trusted:
+		strs = append(strs, "signed with trusted key "+EncodeKey(p.Key))
+	default:
+		strs = append(strs, "signed with key "+EncodeKey(p.Key))
+	}
+
+	return "made " + strings.Join(strs, ", ")
+}
+
+// EncodeKey returns the base64 encoding of an ed25519 key.
+func EncodeKey(key []byte) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// GenerateSigningKey returns a new ed25519 key pair.
+func GenerateSigningKey() (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	return ed25519.GenerateKey(rand.Reader)
+}
+
+// WriteKeyFile writes a base64 encoded ed25519 key to a file.  Private
+// keys are only readable by their owner.
+func WriteKeyFile(filename string, key []byte) error {
+	perm := fs.FileMode(0644)
+	if len(key) == ed25519.PrivateKeySize {
+		perm = 0600
+	}
+	return vfs.WriteFile(vfs.OS, filename, []byte(EncodeKey(key)+"\n"), perm)
+}
+
+// readKeyFile reads a base64 encoded key of the given size from a file.
+func readKeyFile(filename string, size int) ([]byte, error) {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != size {
+		return nil, fmt.Errorf("%s: bad key file", filename)
+	}
+	return key, nil
+}
+
+// ReadPrivateKeyFile reads an ed25519 private key written by WriteKeyFile.
+func ReadPrivateKeyFile(filename string) (ed25519.PrivateKey, error) {
+	key, err := readKeyFile(filename, ed25519.PrivateKeySize)
+	return ed25519.PrivateKey(key), err
+}
+
+// ReadPublicKeyFile reads an ed25519 public key written by WriteKeyFile.
+func ReadPublicKeyFile(filename string) (ed25519.PublicKey, error) {
+	key, err := readKeyFile(filename, ed25519.PublicKeySize)
+	return ed25519.PublicKey(key), err
+}
+
+// SignFile appends a provenance block to a text or JSON codeplug file,
+// replacing any it already has.  If key is not nil, the block includes
+// an ed25519 signature of the file's contents and provenance.
+func SignFile(filename string, p Provenance, key ed25519.PrivateKey) error {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return err
+	}
+	content, _, err := splitProvenance(data)
+	if err != nil {
+		return err
+	}
+
+	if p.Built.IsZero() {
+		p.Built = time.Now()
+	}
+
+	var buf bytes.Buffer
+	buf.Write(content)
+	if len(content) > 0 && content[len(content)-1] != '\n' {
+		buf.WriteString("\n")
+	}
+	buf.WriteString(provenanceBegin)
+	if p.Author != "" {
+		fmt.Fprintf(&buf, "Author: %s\n", oneLine(p.Author))
+	}
+	if p.Tool != "" {
+		fmt.Fprintf(&buf, "Tool: %s\n", oneLine(p.Tool))
+	}
+	fmt.Fprintf(&buf, "Built: %s\n", p.Built.UTC().Format(time.RFC3339))
+	if key != nil {
+		fmt.Fprintf(&buf, "Key: %s\n", EncodeKey(key.Public().(ed25519.PublicKey)))
+		signature := ed25519.Sign(key, buf.Bytes())
+		fmt.Fprintf(&buf, "Signature: %s\n", EncodeKey(signature))
+	}
+	buf.WriteString(provenanceEnd)
+
+	return vfs.WriteFile(vfs.OS, filename, buf.Bytes(), 0644)
+}
+
+// oneLine replaces the line breaks in s with spaces.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// splitProvenance separates the contents of a codeplug file from its
+// provenance block, if any, verifying the block's signature.  The
+// returned provenance is nil if the file has no provenance block.
+func splitProvenance(data []byte) ([]byte, *Provenance, error) {
+	start := bytes.LastIndex(data, []byte(provenanceBegin))
+	if start < 0 || (start > 0 && data[start-1] != '\n') {
+		return data, nil, nil
+	}
+	content := data[:start]
+
+	p := new(Provenance)
+	var signature []byte
+	signed := data
+	scanner := bufio.NewScanner(bytes.NewReader(data[start+len(provenanceBegin):]))
+	offset := start + len(provenanceBegin)
+	ended := false
+	seen := make(map[string]bool)
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineStart := offset
+		offset += len(line) + 1
+		if line+"\n" == provenanceEnd {
+			ended = true
+			break
+		}
+
+		// Only the lines before the signature are signed, so
+		// nothing may follow it.
+		if seen["Signature"] {
+			return nil, nil, fmt.Errorf("provenance line after Signature: %s", line)
+		}
+
+		colon := strings.Index(line, ":")
+		if colon < 0 {
+			return nil, nil, fmt.Errorf("bad provenance line: %s", line)
+		}
+		name := line[:colon]
+		value := strings.TrimSpace(line[colon+1:])
+
+		if seen[name] {
+			return nil, nil, fmt.Errorf("duplicate provenance %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "Author":
+			p.Author = value
+		case "Tool":
+			p.Tool = value
+		case "Built":
+			p.Built, err = time.Parse(time.RFC3339, value)
+		case "Key":
+			var key []byte
+			key, err = base64.StdEncoding.DecodeString(value)
+			if err == nil && len(key) != ed25519.PublicKeySize {
+				err = fmt.Errorf("bad size")
+			}
+			p.Key = ed25519.PublicKey(key)
+		case "Signature":
+			signature, err = base64.StdEncoding.DecodeString(value)
+			signed = data[:lineStart]
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("bad provenance %s: %s", name, err.Error())
+		}
+	}
+	if !ended {
+		return nil, nil, fmt.Errorf("unterminated provenance block")
+	}
+	if offset < len(data) && len(bytes.TrimSpace(data[offset:])) != 0 {
+		return nil, nil, fmt.Errorf("data after provenance block")
+	}
+
+	switch {
+	case p.Key == nil && signature == nil:
+
+	case p.Key == nil || signature == nil:
+		return nil, nil, fmt.Errorf("incomplete codeplug signature")
+
+	case !ed25519.Verify(p.Key, signed, signature):
+		return nil, nil, fmt.Errorf("bad codeplug signature: the file has been modified")
+	}
+
+	return content, p, nil
+}
+
+// VerifyFile returns the provenance of a text or JSON codeplug file,
+// verifying its signature, if it has one.  The provenance is nil if
+// the file has none.
+func VerifyFile(filename string, trustedKeys []ed25519.PublicKey) (*Provenance, error) {
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	_, p, err := VerifyData(data, trustedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return p, nil
+}
+
+// VerifyData is like VerifyFile, but verifies the contents of a file
+// held in data.  It also returns the contents without the provenance
+// block.
+func VerifyData(data []byte, trustedKeys []ed25519.PublicKey) ([]byte, *Provenance, error) {
+	content, p, err := splitProvenance(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p != nil {
+		p.Trusted = keyTrusted(p.Key, trustedKeys)
+	}
+
+	return content, p, nil
+}
+
+// keyTrusted returns true if key is one of the trusted keys.
+func keyTrusted(key ed25519.PublicKey, trustedKeys []ed25519.PublicKey) bool {
+	if key == nil {
+		return false
+	}
+	for _, trusted := range trustedKeys {
+		if bytes.Equal(key, trusted) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetTrustedKeys sets the keys trusted to sign the text and JSON files
+// the codeplug is loaded from.  Once set, loading fails unless the file
+// is signed with one of the keys.  It must be called before Load.
+func (cp *Codeplug) SetTrustedKeys(keys []ed25519.PublicKey) {
+	cp.trustedKeys = keys
+}
+
+// Provenance returns the provenance of the text or JSON file the
+// codeplug was loaded from, or nil if it had none.
+func (cp *Codeplug) Provenance() *Provenance {
+	return cp.provenance
+}
+
+// openImportFile returns a reader of the contents of the text or JSON
+// file the codeplug is imported from, or of its decrypted contents,
+// without its provenance block.  The block's signature is verified,
+// and the file's provenance recorded.
+func (cp *Codeplug) openImportFile(filename string) (io.Reader, error) {
+	data := cp.importData
+	if data == nil {
+		var err error
+		data, err = fs.ReadFile(cp.fsys, filename)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content, p, err := splitProvenance(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+	if p != nil {
+		p.Trusted = keyTrusted(p.Key, cp.trustedKeys)
+	}
+	if cp.trustedKeys != nil && (p == nil || !p.Trusted) {
+		return nil, fmt.Errorf("%s: not signed with a trusted key", filename)
+	}
+	cp.provenance = p
+
+	return bytes.NewReader(content), nil
+}
+
+// withoutProvenance returns a reader of the contents read from r,
+// without any provenance block.  The block is not verified.
+func withoutProvenance(r io.Reader) (io.Reader, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	start := bytes.LastIndex(data, []byte(provenanceBegin))
+	if start < 0 || (start > 0 && data[start-1] != '\n') {
+		return bytes.NewReader(data), nil
+	}
+	return bytes.NewReader(data[:start]), nil
+}
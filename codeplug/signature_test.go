@@ -0,0 +1,89 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+package codeplug
+
+import (
+	"crypto/ed25519"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// signedText returns a text codeplug signed by author with key.
+func signedText(t *testing.T, author string, key ed25519.PrivateKey) string {
+	filename := filepath.Join(t.TempDir(), "signed.txt")
+	err := vfs.WriteFile(vfs.OS, filename, []byte("Model: MD380\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := Provenance{Author: author, Built: time.Unix(0, 0)}
+	err = SignFile(filename, p, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := fs.ReadFile(vfs.OS, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+func TestVerifyProvenance(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trusted := []ed25519.PublicKey{public}
+	signed := signedText(t, "Alice", private)
+
+	_, p, err := VerifyData([]byte(signed), trusted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Author != "Alice" || !p.Trusted {
+		t.Errorf("got author %q, trusted %v", p.Author, p.Trusted)
+	}
+
+	// None of the changes are covered by the signature, so each
+	// must be rejected rather than reported as trusted.
+	tampered := map[string]string{
+		"line after signature": strings.Replace(signed, provenanceEnd, "Author: Mallory\n"+provenanceEnd, 1),
+		"duplicate field":      strings.Replace(signed, "Author: Alice\n", "Author: Alice\nAuthor: Mallory\n", 1),
+		"data after block":     signed + "Author: Mallory\n",
+		"modified contents":    strings.Replace(signed, "MD380", "MD390", 1),
+	}
+	for name, data := range tampered {
+		_, p, err := VerifyData([]byte(data), trusted)
+		if err == nil {
+			t.Errorf("%s: accepted, made by %s", name, p.Author)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// A SimplexRegion lists the DMR simplex frequencies commonly used in a
+// region.  Local band plans vary, so the lists are only a starting
+// point.
+type SimplexRegion struct {
+	Name        string
+	Description string
+	Frequencies []float64
+}
+
+// simplexRegions holds the built-in simplex frequency lists.
+var simplexRegions = []SimplexRegion{
+	{
+		Name:        "na",
+		Description: "North America (DMR-MARC simplex list)",
+		Frequencies: []float64{
+			441.0000, 446.5000, 446.0750, 433.4500, 145.7900, 145.5100,
+		},
+	},
+	{
+		Name:        "eu",
+		Description: "Europe (IARU Region 1 digital voice simplex)",
+		Frequencies: []float64{
+			433.4500, 433.6125, 433.6250, 433.6375, 433.6500,
+			145.2875, 145.3000, 145.3125, 145.3250, 145.3375,
+		},
+	},
+	{
+		Name:        "uk",
+		Description: "United Kingdom (RSGB band plan)",
+		Frequencies: []float64{
+			438.5875, 438.6000, 438.6125, 438.6250, 438.6375,
+			438.6500, 438.6625, 438.6750, 144.8375,
+		},
+	},
+}
+
+// SimplexTalkgroup is the talkgroup conventionally used on DMR simplex
+// channels, in time slot 1 with color code 1.
+var SimplexTalkgroup = Talkgroup{ID: 99, Name: "Simplex"}
+
+// ParrotTalkgroup is the private call ID of the Brandmeister Parrot
+// echo test service.
+var ParrotTalkgroup = Talkgroup{ID: 9990, Name: "Parrot", Private: true}
+
+// SimplexRegions returns the built-in simplex frequency lists.
+func SimplexRegions() []SimplexRegion {
+	return append([]SimplexRegion{}, simplexRegions...)
+}
+
+// LookupSimplexRegion returns the built-in simplex frequency list of
+// the named region.
+func LookupSimplexRegion(name string) (SimplexRegion, error) {
+	for _, region := range simplexRegions {
+		if region.Name == name {
+			return region, nil
+		}
+	}
+
+	return SimplexRegion{}, fmt.Errorf("unknown simplex region: %s", name)
+}
+
+// AddSimplexChannels adds a channel for each of the region's simplex
+// frequencies that the radio supports, and a "Simplex" zone holding
+// them.  The channels use talkgroup 99, time slot 1 and color code 1.
+func (cp *Codeplug) AddSimplexChannels(region SimplexRegion) error {
+	var freqs []float64
+	for _, freq := range region.Frequencies {
+		if cp.frequencyValid(freq) == nil {
+			freqs = append(freqs, freq)
+		}
+	}
+	if len(freqs) == 0 {
+		return fmt.Errorf("the radio supports none of the %s simplex frequencies", region.Name)
+	}
+
+	channelPolicy, err := cp.NewNamingPolicy(RtChannels_md380, "Simplex {freq}")
+	if err != nil {
+		return err
+	}
+	zonePolicy, err := cp.NewNamingPolicy(RtZones_md380, "Simplex")
+	if err != nil {
+		return err
+	}
+
+	newContacts := 0
+	if cp.findContact(SimplexTalkgroup.ID, SimplexTalkgroup.Private) == nil {
+		newContacts = 1
+	}
+	maxZoneChannels := cp.maxFields(RtZones_md380, FtZiChannel_md380)
+
+	// Check that everything fits before changing the codeplug.
+	needed := []struct {
+		rType RecordType
+		count int
+	}{
+		{RtChannels_md380, len(freqs)},
+		{RtContacts, newContacts},
+		{RtZones_md380, (len(freqs) + maxZoneChannels - 1) / maxZoneChannels},
+	}
+	for _, n := range needed {
+		if len(cp.records(n.rType))+n.count > cp.MaxRecords(n.rType) {
+			return fmt.Errorf("too many %s", n.rType)
+		}
+	}
+
+	contact, err := cp.talkgroupContact(SimplexTalkgroup)
+	if err != nil {
+		return err
+	}
+
+	channelNames := make([]string, len(freqs))
+	for i, freq := range freqs {
+		vars := map[string]string{
+			"freq": strconv.FormatFloat(freq, 'f', -1, 64),
+		}
+		name, err := channelPolicy.Name(vars)
+		if err != nil {
+			return err
+		}
+		channelNames[i] = name
+
+		err = cp.addDigitalChannel(digitalChannel{
+			name:          name,
+			rxFrequency:   freq,
+			txFrequency:   freq,
+			colorCode:     1,
+			slot:          1,
+			admitCriteria: "Channel free",
+			contact:       contact.Name(),
+			groupList:     "None",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cp.addZones(zonePolicy, nil, channelNames)
+	if err != nil {
+		return err
+	}
+
+	cp.changed = true
+
+	return nil
+}
+
+// A Parrot describes the repeater or hotspot through which the
+// Brandmeister Parrot echo test is reached.
+type Parrot struct {
+	RxFrequency float64
+	TxFrequency float64
+	ColorCode   int
+	Slot        int
+}
+
+// AddParrotChannel adds a channel, named "Parrot", that makes private
+// calls to the Brandmeister Parrot echo test service.  TxFrequency
+// defaults to RxFrequency and Slot defaults to 2.
+func (cp *Codeplug) AddParrotChannel(p *Parrot) error {
+	txFrequency := p.TxFrequency
+	if txFrequency == 0 {
+		txFrequency = p.RxFrequency
+	}
+	slot := p.Slot
+	if slot == 0 {
+		slot = 2
+	}
+
+	if p.ColorCode < 0 || p.ColorCode > 15 {
+		return fmt.Errorf("bad color code: %d", p.ColorCode)
+	}
+	if slot != 1 && slot != 2 {
+		return fmt.Errorf("bad time slot: %d", slot)
+	}
+	for _, freq := range []float64{p.RxFrequency, txFrequency} {
+		err := cp.frequencyValid(freq)
+		if err != nil {
+			return fmt.Errorf("%s: %s", frequencyToString(freq), err.Error())
+		}
+	}
+
+	newContacts := 0
+	if cp.findContact(ParrotTalkgroup.ID, ParrotTalkgroup.Private) == nil {
+		newContacts = 1
+	}
+	if len(cp.records(RtChannels_md380))+1 > cp.MaxRecords(RtChannels_md380) {
+		return fmt.Errorf("too many %s", RtChannels_md380)
+	}
+	if len(cp.records(RtContacts))+newContacts > cp.MaxRecords(RtContacts) {
+		return fmt.Errorf("too many %s", RtContacts)
+	}
+
+	channelPolicy, err := cp.NewNamingPolicy(RtChannels_md380, "Parrot")
+	if err != nil {
+		return err
+	}
+	name, err := channelPolicy.Name(nil)
+	if err != nil {
+		return err
+	}
+
+	contact, err := cp.talkgroupContact(ParrotTalkgroup)
+	if err != nil {
+		return err
+	}
+
+	err = cp.addDigitalChannel(digitalChannel{
+		name:          name,
+		rxFrequency:   p.RxFrequency,
+		txFrequency:   txFrequency,
+		colorCode:     p.ColorCode,
+		slot:          slot,
+		admitCriteria: "Color code",
+		contact:       contact.Name(),
+		groupList:     "None",
+	})
+	if err != nil {
+		return err
+	}
+
+	cp.changed = true
+
+	return nil
+}
+
+// talkgroupContact returns the contact for the talkgroup, adding one
+// if the codeplug has none.
+func (cp *Codeplug) talkgroupContact(tg Talkgroup) (*Record, error) {
+	r := cp.findContact(tg.ID, tg.Private)
+	if r != nil {
+		return r, nil
+	}
+
+	policy, err := cp.NewNamingPolicy(RtContacts, "{tg.name}")
+	if err != nil {
+		return nil, err
+	}
+	tg = cp.localTalkgroup(tg)
+	name, err := policy.Name(map[string]string{"tg.name": tg.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.addContact(name, tg)
+}
@@ -0,0 +1,91 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+)
+
+// A Starter describes the contents of a starter codeplug: enough to
+// get a new radio on the air.  Each part is optional.
+type Starter struct {
+	// Identity is set in the general settings.
+	Identity Identity
+
+	// SimplexRegion names the built-in simplex frequency list to add,
+	// as returned by SimplexRegions.
+	SimplexRegion string
+
+	// Repeaters within RepeaterRadius kilometers of Home are added
+	// to a "Local" zone.  RepeaterRadius is 30 by default.
+	Home           Location
+	Repeaters      []Repeater
+	RepeaterRadius float64
+
+	// Hotspot, if not nil, is added as by AddHotspot.
+	Hotspot *Hotspot
+}
+
+// AddStarter adds the parts of a starter codeplug to a newly created
+// codeplug.
+func (cp *Codeplug) AddStarter(s *Starter) error {
+	err := cp.SetIdentity(s.Identity)
+	if err != nil {
+		return err
+	}
+
+	if s.SimplexRegion != "" {
+		region, err := LookupSimplexRegion(s.SimplexRegion)
+		if err != nil {
+			return err
+		}
+		err = cp.AddSimplexChannels(region)
+		if err != nil {
+			return fmt.Errorf("simplex channels: %s", err.Error())
+		}
+	}
+
+	if len(s.Repeaters) > 0 {
+		home := Waypoint{Location: s.Home, Name: "Local"}
+		opts := TravelOptions{
+			Radius:           s.RepeaterRadius,
+			ZoneNameTemplate: "Local",
+		}
+		err = cp.AddTravelZones([]Waypoint{home, home}, s.Repeaters, opts)
+		if err != nil {
+			return fmt.Errorf("local repeaters: %s", err.Error())
+		}
+	}
+
+	if s.Hotspot != nil {
+		err = cp.AddHotspot(s.Hotspot)
+		if err != nil {
+			return fmt.Errorf("hotspot: %s", err.Error())
+		}
+	}
+
+	return nil
+}
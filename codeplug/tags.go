@@ -0,0 +1,315 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Tags are not stored in the radio's codeplug.  They are saved in, and
+// read from, text and JSON files as the pseudo-field named by
+// TagsFieldName, whose value is a comma-separated list of the record's
+// tags.
+const TagsFieldName = "Tags"
+
+// A TagFilter selects records by their tags.  A record matches if it
+// has every tag in Include and none of the tags in Exclude.
+type TagFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// ParseTagFilter returns the TagFilter described by text, a
+// comma-separated list of tags.  A tag preceded by "!" is excluded.
+// Tags may be written between colons, as in ":sota:,!:hotspot:".
+func ParseTagFilter(text string) (*TagFilter, error) {
+	filter := new(TagFilter)
+	for _, s := range strings.Split(text, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		exclude := strings.HasPrefix(s, "!")
+		tag, err := normalizeTag(strings.TrimPrefix(s, "!"))
+		if err != nil {
+			return nil, err
+		}
+
+		if exclude {
+			filter.Exclude = append(filter.Exclude, tag)
+		} else {
+			filter.Include = append(filter.Include, tag)
+		}
+	}
+
+	if len(filter.Include) == 0 && len(filter.Exclude) == 0 {
+		return nil, fmt.Errorf("empty tag filter")
+	}
+
+	return filter, nil
+}
+
+// String returns the filter in the form accepted by ParseTagFilter.
+func (filter *TagFilter) String() string {
+	strs := append([]string{}, filter.Include...)
+	for _, tag := range filter.Exclude {
+		strs = append(strs, "!"+tag)
+	}
+
+	return strings.Join(strs, ",")
+}
+
+// Match returns true if the record's tags satisfy the filter.
+func (filter *TagFilter) Match(r *Record) bool {
+	for _, tag := range filter.Include {
+		if !r.HasTag(tag) {
+			return false
+		}
+	}
+	for _, tag := range filter.Exclude {
+		if r.HasTag(tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeTag returns tag without surrounding colons and in lower
+// case.  A tag may contain letters, digits, '-', '_' and '.'.
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.Trim(strings.TrimSpace(tag), ":"))
+	if tag == "" {
+		return "", fmt.Errorf("empty tag")
+	}
+	for _, c := range tag {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && !strings.ContainsRune("-_.", c) {
+			return "", fmt.Errorf("bad tag: %s", tag)
+		}
+	}
+
+	return tag, nil
+}
+
+// Tags returns the record's tags, sorted.
+func (r *Record) Tags() []string {
+	return append([]string{}, r.tags...)
+}
+
+// HasTag returns true if the record has the given tag.
+func (r *Record) HasTag(tag string) bool {
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return false
+	}
+	i := sort.SearchStrings(r.tags, tag)
+	return i < len(r.tags) && r.tags[i] == tag
+}
+
+// AddTag adds a tag to the record.
+func (r *Record) AddTag(tag string) error {
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return err
+	}
+
+	i := sort.SearchStrings(r.tags, tag)
+	if i < len(r.tags) && r.tags[i] == tag {
+		return nil
+	}
+	r.tags = append(r.tags, "")
+	copy(r.tags[i+1:], r.tags[i:])
+	r.tags[i] = tag
+
+	return nil
+}
+
+// RemoveTag removes a tag from the record, if it has the tag.
+func (r *Record) RemoveTag(tag string) {
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return
+	}
+
+	i := sort.SearchStrings(r.tags, tag)
+	if i < len(r.tags) && r.tags[i] == tag {
+		r.tags = append(r.tags[:i], r.tags[i+1:]...)
+	}
+}
+
+// TaggedRecords returns the records of type rType matching filter, in
+// codeplug order.
+func (cp *Codeplug) TaggedRecords(rType RecordType, filter *TagFilter) []*Record {
+	var records []*Record
+	for _, r := range cp.records(rType) {
+		if filter.Match(r) {
+			records = append(records, r)
+		}
+	}
+
+	return records
+}
+
+// Tags returns all of the tags used by records of type rType, sorted.
+func (cp *Codeplug) Tags(rType RecordType) []string {
+	seen := make(map[string]bool)
+	var tags []string
+	for _, r := range cp.records(rType) {
+		for _, tag := range r.tags {
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// SetTags adds, or if add is false removes, the given tags on the
+// records of type rType.  Records are selected by the names given, or
+// by filter if no names are given.  Record type names are those used in
+// text files.  Tags may not be changed on locked records.  The number
+// of records selected is returned.
+func (cp *Codeplug) SetTags(rTypeName string, names []string, filter *TagFilter, tags []string, add bool) (int, error) {
+	rType, err := cp.nameToRt(rTypeName)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, tag := range tags {
+		tags[i], err = normalizeTag(tag)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var records []*Record
+	switch {
+	case len(names) != 0:
+		for _, name := range names {
+			r := cp.FindRecordByName(rType, name)
+			if r == nil {
+				return 0, fmt.Errorf("no %s record named '%s'", rType, name)
+			}
+			records = append(records, r)
+		}
+
+	case filter != nil:
+		records = cp.TaggedRecords(rType, filter)
+
+	default:
+		return 0, fmt.Errorf("no %s records selected", rType)
+	}
+
+	for _, r := range records {
+		if r.locked && r.locksApply() {
+			return 0, fmt.Errorf("%s is locked", r.lockName())
+		}
+	}
+
+	for _, r := range records {
+		for _, tag := range tags {
+			if add {
+				r.AddTag(tag)
+			} else {
+				r.RemoveTag(tag)
+			}
+		}
+	}
+
+	cp.changed = true
+
+	return len(records), nil
+}
+
+// AddTagZones adds zones, named from the given name template, holding
+// the channels matching filter, in codeplug order.  The template's
+// {tags} variable is replaced by the filter.  As many zones as are
+// needed to hold the channels are added.
+func (cp *Codeplug) AddTagZones(nameTemplate string, filter *TagFilter) error {
+	records := cp.TaggedRecords(RtChannels_md380, filter)
+	if len(records) == 0 {
+		return fmt.Errorf("no channels match tags %s", filter.String())
+	}
+
+	policy, err := cp.NewNamingPolicy(RtZones_md380, nameTemplate)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(records))
+	for i, r := range records {
+		names[i] = r.Name()
+	}
+
+	vars := map[string]string{"tags": filter.String()}
+	err = cp.addZones(policy, vars, names)
+	if err != nil {
+		return err
+	}
+
+	cp.changed = true
+
+	return nil
+}
+
+// isTagsFieldName returns true if name names the tags pseudo-field.
+func isTagsFieldName(name string) bool {
+	return name == TagsFieldName
+}
+
+// setTagsField sets the record's tags from the value of a tags
+// pseudo-field.
+func (r *Record) setTagsField(value string) error {
+	r.tags = nil
+	for _, tag := range strings.Split(value, ",") {
+		if strings.TrimSpace(tag) == "" {
+			continue
+		}
+		err := r.AddTag(tag)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tagsFields returns the names and values of the record's tags
+// pseudo-field, if it has any tags.
+func (r *Record) tagsFields() (names []string, values []string) {
+	if len(r.tags) == 0 {
+		return nil, nil
+	}
+
+	return []string{TagsFieldName}, []string{strings.Join(r.tags, ",")}
+}
@@ -0,0 +1,197 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// Talkgroup names from upstream sources are often too long for a
+// radio's display, or in the wrong language.  A talkgroup name override
+// gives the names a user prefers for a talkgroup, longest first.  The
+// first name that fits the radio is used in place of the upstream name
+// when contacts and channels are generated for the talkgroup.
+
+// A TalkgroupName holds the preferred names of a group talkgroup.
+type TalkgroupName struct {
+	ID    DmrID
+	Names []string
+}
+
+// ReadTalkgroupNames reads talkgroup name overrides from CSV rows of
+// the form "id,name[,shorter name]...".  A first row whose ID is not a
+// number is taken as a header and skipped.
+func ReadTalkgroupNames(r io.Reader) ([]TalkgroupName, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var tgNames []TalkgroupName
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) == 0 || len(row) == 1 && strings.TrimSpace(row[0]) == "" {
+			continue
+		}
+
+		id, err := ParseDmrID(strings.TrimSpace(row[0]))
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: bad talkgroup ID '%s': %s", line, row[0], err.Error())
+		}
+
+		tgName := TalkgroupName{ID: id}
+		for _, name := range row[1:] {
+			name = strings.TrimSpace(name)
+			if name != "" {
+				tgName.Names = append(tgName.Names, name)
+			}
+		}
+		if len(tgName.Names) == 0 {
+			return nil, fmt.Errorf("line %d: talkgroup %s has no name", line, id)
+		}
+		tgNames = append(tgNames, tgName)
+	}
+
+	return tgNames, nil
+}
+
+// LoadTalkgroupNames reads talkgroup name overrides from the named CSV
+// file.
+func LoadTalkgroupNames(filename string) ([]TalkgroupName, error) {
+	file, err := vfs.OS.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	tgNames, err := ReadTalkgroupNames(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return tgNames, nil
+}
+
+// SetTalkgroupNames sets the talkgroup name overrides used when
+// contacts and channels are added to the codeplug.  An error is
+// returned if none of a talkgroup's names fits the radio's contact
+// names.  A nil tgNames removes the overrides.
+func (cp *Codeplug) SetTalkgroupNames(tgNames []TalkgroupName) error {
+	maxLen, err := cp.nameMaxLength(RtContacts)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[DmrID]string)
+	var errs []string
+	for _, tgName := range tgNames {
+		name := ""
+		for _, n := range tgName.Names {
+			if utf8.RuneCountInString(n) <= maxLen {
+				name = n
+				break
+			}
+		}
+		if name == "" {
+			errs = append(errs, fmt.Sprintf("talkgroup %s: no name of at most %d characters",
+				tgName.ID, maxLen))
+			continue
+		}
+		names[tgName.ID] = name
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	cp.talkgroupNames = names
+	if len(names) == 0 {
+		cp.talkgroupNames = nil
+	}
+
+	return nil
+}
+
+// TalkgroupName returns the overriding name of the group talkgroup
+// with the given ID, if any.
+func (cp *Codeplug) TalkgroupName(id DmrID) (string, bool) {
+	name, ok := cp.talkgroupNames[id]
+	return name, ok
+}
+
+// localTalkgroup returns tg with its name replaced by any override.
+func (cp *Codeplug) localTalkgroup(tg Talkgroup) Talkgroup {
+	if tg.Private {
+		return tg
+	}
+	if name, ok := cp.talkgroupNames[tg.ID]; ok {
+		tg.Name = name
+	}
+
+	return tg
+}
+
+// PreviewTalkgroupRenames returns the renames giving the codeplug's
+// existing group contacts their overriding names, for ApplyRenames.
+func (cp *Codeplug) PreviewTalkgroupRenames() []Rename {
+	var renames []Rename
+	for _, r := range cp.records(RtContacts) {
+		if r.Field(FtDcCallType).String() != "Group" {
+			continue
+		}
+		id, err := ParseDmrID(r.Field(FtDcCallID).String())
+		if err != nil {
+			continue
+		}
+		name, ok := cp.talkgroupNames[id]
+		if !ok || name == r.Name() {
+			continue
+		}
+
+		renames = append(renames, Rename{
+			Record:  r,
+			OldName: r.Name(),
+			NewName: name,
+			Err:     r.NameField().CheckUnlocked(),
+		})
+	}
+	cp.checkRenameDuplicates(RtContacts, renames)
+
+	return renames
+}
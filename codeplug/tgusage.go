@@ -0,0 +1,235 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// UnusedContact is a digital contact that no channel transmits to.
+type UnusedContact struct {
+	Name string
+
+	// GroupLists holds the names of the RX group lists holding the
+	// contact, through which it may still be heard.
+	GroupLists []string
+}
+
+// UnheardChannel is a digital channel whose talkgroup is missing from
+// the channel's RX group list, so that replies on the talkgroup
+// transmitted are not heard.  A channel without an RX group list hears
+// its talkgroup.
+type UnheardChannel struct {
+	Channel   string
+	Contact   string
+	GroupList string
+}
+
+// TalkgroupUsage is the result of AnalyzeTalkgroupUsage.
+type TalkgroupUsage struct {
+	// Unused holds the digital contacts referenced by no channel.
+	Unused []UnusedContact
+
+	// Unheard holds the channels transmitting on a talkgroup that is
+	// not in their RX group list.
+	Unheard []UnheardChannel
+}
+
+// AnalyzeTalkgroupUsage reports the codeplug's digital contacts that
+// no channel uses, and the digital channels whose talkgroup is not in
+// their RX group list.
+func (cp *Codeplug) AnalyzeTalkgroupUsage() *TalkgroupUsage {
+	u := new(TalkgroupUsage)
+
+	listed := make(map[string][]string)
+	for _, gl := range cp.records(RtGroupLists) {
+		for _, f := range gl.Fields(FtGlContact) {
+			name := f.String()
+			listed[name] = append(listed[name], gl.Name())
+		}
+	}
+
+	used := make(map[string]bool)
+	for _, ch := range cp.records(RtChannels_md380) {
+		if ch.Field(FtCiChannelMode).String() != "Digital" {
+			continue
+		}
+		name := ch.Field(FtCiContactName).String()
+		used[name] = true
+
+		contact := cp.FindRecordByName(RtContacts, name)
+		if contact == nil || contact.Field(FtDcCallType).String() != "Group" {
+			continue
+		}
+		glName := ch.Field(FtCiGroupList).String()
+		if cp.FindRecordByName(RtGroupLists, glName) == nil {
+			continue
+		}
+		if containsString(listed[name], glName) {
+			continue
+		}
+		u.Unheard = append(u.Unheard, UnheardChannel{
+			Channel:   ch.Name(),
+			Contact:   name,
+			GroupList: glName,
+		})
+	}
+
+	for _, contact := range cp.records(RtContacts) {
+		name := contact.Name()
+		if used[name] {
+			continue
+		}
+		u.Unused = append(u.Unused, UnusedContact{
+			Name:       name,
+			GroupLists: listed[name],
+		})
+	}
+
+	return u
+}
+
+// RemoveUnusedContacts removes the digital contacts referenced by no
+// channel.  If keepListed is true, contacts held by an RX group list
+// are kept.  Locked contacts are kept.  It returns the names of the
+// removed contacts.
+func (cp *Codeplug) RemoveUnusedContacts(keepListed bool) ([]string, error) {
+	u := cp.AnalyzeTalkgroupUsage()
+
+	var unused []*Record
+	var names []string
+	for _, uc := range u.Unused {
+		if keepListed && len(uc.GroupLists) != 0 {
+			continue
+		}
+		r := cp.FindRecordByName(RtContacts, uc.Name)
+		if r.CheckUnlocked() != nil {
+			continue
+		}
+		if len(unused) == len(cp.records(RtContacts))-1 {
+			return names, fmt.Errorf("the last contact may not be removed")
+		}
+		unused = append(unused, r)
+		names = append(names, uc.Name)
+	}
+
+	if len(unused) == 0 {
+		return nil, nil
+	}
+
+	err := cp.checkReferencesUnlocked(RtContacts, stringSet(names))
+	if err != nil {
+		return nil, err
+	}
+
+	change := cp.RemoveRecordsChange(unused)
+	for _, r := range unused {
+		cp.RemoveRecord(r)
+	}
+	change.Complete()
+
+	cp.changed = true
+
+	return names, nil
+}
+
+// AddUnheardTalkgroups adds the talkgroup of each channel found by
+// AnalyzeTalkgroupUsage to the channel's RX group list.  Channels whose
+// group list is locked or full are left alone.  It returns the
+// channels whose talkgroups were added.
+func (cp *Codeplug) AddUnheardTalkgroups() ([]UnheardChannel, error) {
+	u := cp.AnalyzeTalkgroupUsage()
+
+	var added []UnheardChannel
+	for _, uh := range u.Unheard {
+		gl := cp.FindRecordByName(RtGroupLists, uh.GroupList)
+		if gl == nil || gl.CheckFieldsUnlocked(FtGlContact) != nil {
+			continue
+		}
+
+		contacts := fieldStrings(gl.Fields(FtGlContact))
+		if containsString(contacts, uh.Contact) {
+			// An earlier channel added it.
+			added = append(added, uh)
+			continue
+		}
+		if len(contacts) >= gl.MaxFields(FtGlContact) {
+			continue
+		}
+
+		f, err := gl.NewFieldWithValue(FtGlContact, len(contacts), uh.Contact)
+		if err != nil {
+			return added, fmt.Errorf("%s: %s", uh.GroupList, err.Error())
+		}
+		err = gl.addField(f)
+		if err != nil {
+			return added, fmt.Errorf("%s: %s", uh.GroupList, err.Error())
+		}
+		added = append(added, uh)
+	}
+
+	if len(added) != 0 {
+		cp.changed = true
+	}
+
+	return added, nil
+}
+
+// String returns a report of the analysis.
+func (u *TalkgroupUsage) String() string {
+	var buf bytes.Buffer
+
+	for _, uc := range u.Unused {
+		fmt.Fprintf(&buf, "contact '%s' is used by no channel", uc.Name)
+		if len(uc.GroupLists) != 0 {
+			fmt.Fprintf(&buf, ", but is in RX group lists %s",
+				strings.Join(uc.GroupLists, ", "))
+		}
+		fmt.Fprintf(&buf, "\n")
+	}
+
+	for _, uh := range u.Unheard {
+		fmt.Fprintf(&buf, "channel '%s' transmits to '%s', which is not in its RX group list (%s)\n",
+			uh.Channel, uh.Contact, uh.GroupList)
+	}
+
+	if len(u.Unused) == 0 && len(u.Unheard) == 0 {
+		fmt.Fprintf(&buf, "Every contact is used, and every talkgroup is heard.\n")
+	}
+
+	return buf.String()
+}
+
+// stringSet returns a set holding strs.
+func stringSet(strs []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, s := range strs {
+		set[s] = true
+	}
+	return set
+}
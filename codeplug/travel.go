@@ -0,0 +1,397 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A Waypoint is a named Location.
+type Waypoint struct {
+	Location
+	Name string
+}
+
+// gpxPoint is a point of a GPX file, as found in its wpt, rtept and
+// trkpt elements.
+type gpxPoint struct {
+	Lat  float64 `xml:"lat,attr"`
+	Lon  float64 `xml:"lon,attr"`
+	Name string  `xml:"name"`
+}
+
+type gpxFile struct {
+	Waypoints []gpxPoint `xml:"wpt"`
+	Routes    []struct {
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+	Tracks []struct {
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+// ReadGPX reads the route from a GPX file.  The file's first route is
+// used.  If it has none, the points of its first track are used, and
+// failing that, its waypoints.
+func ReadGPX(rdr io.Reader) ([]Waypoint, error) {
+	var gpx gpxFile
+	err := xml.NewDecoder(rdr).Decode(&gpx)
+	if err != nil {
+		return nil, fmt.Errorf("bad GPX file: %s", err.Error())
+	}
+
+	var points []gpxPoint
+	switch {
+	case len(gpx.Routes) > 0:
+		points = gpx.Routes[0].Points
+
+	case len(gpx.Tracks) > 0:
+		for _, seg := range gpx.Tracks[0].Segments {
+			points = append(points, seg.Points...)
+		}
+
+	default:
+		points = gpx.Waypoints
+	}
+
+	if len(points) == 0 {
+		return nil, fmt.Errorf("GPX file contains no route")
+	}
+
+	waypoints := make([]Waypoint, len(points))
+	for i, p := range points {
+		if math.Abs(p.Lat) > 90 || math.Abs(p.Lon) > 180 {
+			return nil, fmt.Errorf("bad GPX point: %g,%g", p.Lat, p.Lon)
+		}
+		waypoints[i] = Waypoint{
+			Location: Location{Latitude: p.Lat, Longitude: p.Lon},
+			Name:     strings.TrimSpace(p.Name),
+		}
+	}
+
+	return waypoints, nil
+}
+
+// A Repeater describes a repeater, as listed by RepeaterBook.
+type Repeater struct {
+	Callsign    string
+	City        string
+	Location    Location
+	Frequency   float64 // repeater output, the radio's receive frequency
+	Input       float64 // repeater input, the radio's transmit frequency
+	Tone        string  // CTCSS tone or DCS code needed to access it
+	Analog      bool
+	DMR         bool
+	ColorCode   int
+	Operational bool
+}
+
+// ReadRepeaterBook reads repeaters from RepeaterBook's JSON export
+// format, as returned by its API.
+func ReadRepeaterBook(rdr io.Reader) ([]Repeater, error) {
+	var rb struct {
+		Results []map[string]interface{} `json:"results"`
+	}
+	err := json.NewDecoder(rdr).Decode(&rb)
+	if err != nil {
+		return nil, fmt.Errorf("bad RepeaterBook file: %s", err.Error())
+	}
+
+	str := func(m map[string]interface{}, key string) string {
+		switch v := m[key].(type) {
+		case string:
+			return strings.TrimSpace(v)
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+		return ""
+	}
+	float := func(m map[string]interface{}, key string) float64 {
+		f, _ := strconv.ParseFloat(str(m, key), 64)
+		return f
+	}
+
+	var repeaters []Repeater
+	for _, m := range rb.Results {
+		rptr := Repeater{
+			Callsign:    str(m, "Callsign"),
+			City:        str(m, "Nearest City"),
+			Frequency:   float(m, "Frequency"),
+			Input:       float(m, "Input Freq"),
+			Tone:        str(m, "PL"),
+			Analog:      str(m, "FM Analog") == "Yes",
+			DMR:         str(m, "DMR") == "Yes",
+			Operational: str(m, "Operational Status") == "On-air",
+			Location: Location{
+				Latitude:  float(m, "Lat"),
+				Longitude: float(m, "Long"),
+			},
+		}
+		rptr.ColorCode, _ = strconv.Atoi(str(m, "DMR Color Code"))
+		if rptr.Frequency == 0 {
+			continue
+		}
+		if rptr.Input == 0 {
+			rptr.Input = rptr.Frequency
+		}
+		repeaters = append(repeaters, rptr)
+	}
+
+	return repeaters, nil
+}
+
+// TravelOptions controls the zones built by AddTravelZones.
+type TravelOptions struct {
+	// Radius is the distance in kilometers from the route within
+	// which repeaters are considered reachable.  It is 30 by default.
+	Radius float64
+
+	// Analog and DMR select the kinds of repeater included.  If
+	// neither is set, both are included.
+	Analog bool
+	DMR    bool
+
+	// Talkgroup is used by the channels of DMR repeaters, in time
+	// slot 2.  It is talkgroup 9, "Local", by default.
+	Talkgroup Talkgroup
+
+	// ZoneNameTemplate is the NameTemplate for zone names.  The
+	// variables {leg}, {from} and {to} are available; {from} and
+	// {to} are the names of the leg's waypoints.  It is "Leg {leg}"
+	// by default.
+	ZoneNameTemplate string
+
+	// ChannelNameTemplate is the NameTemplate for channel names.
+	// The variables {callsign}, {city} and {freq} are available.
+	// It is "{callsign} {city}" by default.
+	ChannelNameTemplate string
+}
+
+// AddTravelZones adds a zone for each leg of the route, holding
+// channels for the operational repeaters reachable from that leg, in
+// the order they are passed.  Repeaters outside the radio's frequency
+// range are skipped, and a repeater near several legs has a single
+// channel, placed in each of their zones.
+func (cp *Codeplug) AddTravelZones(route []Waypoint, repeaters []Repeater, opts TravelOptions) error {
+	if len(route) < 2 {
+		return fmt.Errorf("a route needs at least two waypoints")
+	}
+
+	radius := opts.Radius
+	if radius == 0 {
+		radius = 30
+	}
+	analog, dmr := opts.Analog, opts.DMR
+	if !analog && !dmr {
+		analog, dmr = true, true
+	}
+	tg := opts.Talkgroup
+	if tg.ID == 0 {
+		tg = Talkgroup{ID: 9, Name: "Local"}
+	}
+	zoneTemplate := opts.ZoneNameTemplate
+	if zoneTemplate == "" {
+		zoneTemplate = "Leg {leg}"
+	}
+	channelTemplate := opts.ChannelNameTemplate
+	if channelTemplate == "" {
+		channelTemplate = "{callsign} {city}"
+	}
+
+	channelPolicy, err := cp.NewNamingPolicy(RtChannels_md380, channelTemplate)
+	if err != nil {
+		return err
+	}
+	zonePolicy, err := cp.NewNamingPolicy(RtZones_md380, zoneTemplate)
+	if err != nil {
+		return err
+	}
+
+	var usable []*Repeater
+	for i := range repeaters {
+		rptr := &repeaters[i]
+		if !rptr.Operational || !(analog && rptr.Analog || dmr && rptr.DMR) {
+			continue
+		}
+		if cp.frequencyValid(rptr.Frequency) != nil || cp.frequencyValid(rptr.Input) != nil {
+			continue
+		}
+		usable = append(usable, rptr)
+	}
+
+	// Find the repeaters near each leg, and check that their channels,
+	// contact and zones fit before changing the codeplug.
+	type travelLeg struct {
+		leg      int
+		from, to Waypoint
+		near     []*Repeater
+	}
+	var legs []travelLeg
+	nearAny := make(map[*Repeater]bool)
+	newContacts := 0
+	zones := 0
+	maxZoneChannels := cp.maxFields(RtZones_md380, FtZiChannel_md380)
+	for leg := 1; leg < len(route); leg++ {
+		from, to := route[leg-1], route[leg]
+
+		type nearRepeater struct {
+			rptr  *Repeater
+			along float64
+		}
+		var near []nearRepeater
+		for _, rptr := range usable {
+			if legDistance(from.Location, to.Location, rptr.Location) > radius {
+				continue
+			}
+			near = append(near, nearRepeater{rptr, from.DistanceTo(rptr.Location)})
+		}
+		if len(near) == 0 {
+			continue
+		}
+		sort.SliceStable(near, func(i, j int) bool {
+			return near[i].along < near[j].along
+		})
+
+		tl := travelLeg{leg: leg, from: from, to: to}
+		for _, nr := range near {
+			rptr := nr.rptr
+			tl.near = append(tl.near, rptr)
+			nearAny[rptr] = true
+			digital := rptr.DMR && (dmr || !rptr.Analog)
+			if digital && cp.findContact(tg.ID, tg.Private) == nil {
+				newContacts = 1
+			}
+		}
+		legs = append(legs, tl)
+		zones += (len(near) + maxZoneChannels - 1) / maxZoneChannels
+	}
+
+	if len(legs) == 0 {
+		return fmt.Errorf("no repeaters found within %g km of the route", radius)
+	}
+
+	needed := []struct {
+		rType RecordType
+		count int
+	}{
+		{RtChannels_md380, len(nearAny)},
+		{RtContacts, newContacts},
+		{RtZones_md380, zones},
+	}
+	for _, n := range needed {
+		if len(cp.records(n.rType))+n.count > cp.MaxRecords(n.rType) {
+			return fmt.Errorf("too many %s", n.rType)
+		}
+	}
+
+	var contactName string
+	channelNames := make(map[*Repeater]string)
+
+	for _, tl := range legs {
+		names := make([]string, len(tl.near))
+		for i, rptr := range tl.near {
+			name, ok := channelNames[rptr]
+			if !ok {
+				name, err = channelPolicy.Name(map[string]string{
+					"callsign": rptr.Callsign,
+					"city":     rptr.City,
+					"freq":     strconv.FormatFloat(rptr.Frequency, 'f', -1, 64),
+				})
+				if err != nil {
+					return err
+				}
+
+				digital := rptr.DMR && (dmr || !rptr.Analog)
+				if digital && contactName == "" {
+					contact, err := cp.talkgroupContact(tg)
+					if err != nil {
+						return err
+					}
+					contactName = contact.Name()
+				}
+				err = cp.addRepeaterChannel(rptr, name, digital, contactName)
+				if err != nil {
+					return fmt.Errorf("%s: %s", rptr.Callsign, err.Error())
+				}
+				channelNames[rptr] = name
+			}
+			names[i] = name
+		}
+
+		vars := map[string]string{
+			"leg":  strconv.Itoa(tl.leg),
+			"from": tl.from.Name,
+			"to":   tl.to.Name,
+		}
+		err = cp.addZones(zonePolicy, vars, names)
+		if err != nil {
+			return err
+		}
+	}
+
+	cp.changed = true
+
+	return nil
+}
+
+// addRepeaterChannel adds a channel named name for the repeater, a
+// digital channel in time slot 2 using the named contact, or an analog
+// channel using the repeater's tone.
+func (cp *Codeplug) addRepeaterChannel(rptr *Repeater, name string, digital bool, contactName string) error {
+	if digital {
+		return cp.addDigitalChannel(digitalChannel{
+			name:          name,
+			rxFrequency:   rptr.Frequency,
+			txFrequency:   rptr.Input,
+			colorCode:     rptr.ColorCode,
+			slot:          2,
+			admitCriteria: "Color code",
+			contact:       contactName,
+			groupList:     "None",
+		})
+	}
+
+	tone := rptr.Tone
+	if tone == "" || ctcssDcsStringToBinary(tone) < 0 {
+		tone = "None"
+	}
+	return cp.addAnalogChannel(analogChannel{
+		name:        name,
+		rxFrequency: rptr.Frequency,
+		txFrequency: rptr.Input,
+		bandwidth:   "25",
+		ctcssEncode: tone,
+	})
+}
@@ -0,0 +1,258 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// An UnknownRegion is a run of codeplug bytes holding bits that no
+// field describes.  Such bytes are preserved unchanged when the
+// codeplug is saved.
+type UnknownRegion struct {
+	// Offset is the region's offset in the codeplug's rdt file.
+	Offset int
+
+	// Bytes holds the region's contents.
+	Bytes []byte
+
+	// Mask has a set bit for each unknown bit of Bytes.  Bytes
+	// shared with a field have only some bits set.
+	Mask []byte
+
+	// Record names the record slot containing the region, as in
+	// "Channels 12", or is empty if the region is outside any record.
+	Record string
+}
+
+// fieldBits calls fn for each byte of each field slot of the
+// codeplug, giving the byte's offset and the bits of it the field
+// occupies.  Deleted record indicators are passed with a nil fieldInfo.
+func (cp *Codeplug) fieldBits(fn func(fi *fieldInfo, offset int, bits byte)) {
+	for _, ri := range cp.codeplugInfo.RecordInfos {
+		for rIndex := 0; rIndex < ri.max; rIndex++ {
+			rOffset := ri.offset + rIndex*ri.size
+			for _, dd := range ri.delDescs {
+				for i := 0; i < int(dd.size); i++ {
+					fn(nil, rOffset+int(dd.offset)+i, 0xff)
+				}
+			}
+
+			for _, fi := range ri.fieldInfos {
+				for fIndex := 0; fIndex < fi.max; fIndex++ {
+					var offset int
+					if fi.extIndex == 0 || fIndex < fi.extIndex {
+						offset = rOffset + fi.offset(fIndex)
+					} else {
+						fExtOffset := (fIndex - fi.extIndex) * fi.size()
+						offset = fi.extOffset + rIndex*fi.extSize + fExtOffset
+					}
+
+					if fi.bitSize >= 8 {
+						for i := 0; i < fi.size(); i++ {
+							fn(fi, offset+i, 0xff)
+						}
+						continue
+					}
+
+					// As in fDesc.storeBytes.
+					rightOffset := uint((fi.bitOffset + fi.bitSize) % 8)
+					bits := byte(1<<uint(fi.bitSize)) - 1
+					if rightOffset != 0 {
+						bits <<= 8 - rightOffset
+					}
+					fn(fi, offset, bits)
+				}
+			}
+		}
+	}
+}
+
+// knownBits returns a mask of the codeplug's bytes, with a bit set for
+// each bit described by a field or deleted record indicator.
+func (cp *Codeplug) knownBits() []byte {
+	mask := make([]byte, len(cp.bytes))
+	cp.fieldBits(func(fi *fieldInfo, offset int, bits byte) {
+		mask[offset] |= bits
+	})
+
+	return mask
+}
+
+// recordSlotName returns the name of the record slot containing the
+// byte at offset, or the empty string if no record contains it.
+func (cp *Codeplug) recordSlotName(offset int) string {
+	for _, ri := range cp.codeplugInfo.RecordInfos {
+		if offset < ri.offset || offset >= ri.offset+ri.max*ri.size {
+			continue
+		}
+		if ri.max == 1 {
+			return string(ri.rType)
+		}
+		return fmt.Sprintf("%s %d", ri.rType, (offset-ri.offset)/ri.size+1)
+	}
+
+	return ""
+}
+
+// UnknownRegions returns the regions of the codeplug holding bits not
+// described by any field, in order of offset.  A region doesn't
+// extend beyond its record slot.
+func (cp *Codeplug) UnknownRegions() []UnknownRegion {
+	// Store into a copy, so that the known bits of shared bytes
+	// are current without changing the codeplug.
+	bytes := make([]byte, len(cp.bytes))
+	copy(bytes, cp.bytes)
+	saveBytes := cp.bytes
+	cp.bytes = bytes
+	cp.store()
+	cp.bytes = saveBytes
+
+	known := cp.knownBits()
+
+	var regions []UnknownRegion
+	var region *UnknownRegion
+	for offset, b := range bytes {
+		unknown := ^known[offset]
+		if unknown == 0 {
+			region = nil
+			continue
+		}
+
+		record := cp.recordSlotName(offset)
+		if region == nil || region.Record != record {
+			regions = append(regions, UnknownRegion{
+				Offset: offset,
+				Record: record,
+			})
+			region = &regions[len(regions)-1]
+		}
+		region.Bytes = append(region.Bytes, b)
+		region.Mask = append(region.Mask, unknown)
+	}
+
+	return regions
+}
+
+// unknownBytes returns a copy of the codeplug's bytes with all known
+// bits cleared.
+func (cp *Codeplug) unknownBytes() []byte {
+	known := cp.knownBits()
+	bytes := make([]byte, len(cp.bytes))
+	for i, b := range cp.bytes {
+		bytes[i] = b &^ known[i]
+	}
+
+	return bytes
+}
+
+// checkUnknownBytes returns an error if any bit not described by a
+// field has changed since the codeplug was loaded.
+func (cp *Codeplug) checkUnknownBytes() error {
+	if cp.loadedUnknownBytes == nil {
+		return nil
+	}
+
+	known := cp.knownBits()
+	for i, b := range cp.bytes {
+		if b&^known[i] != cp.loadedUnknownBytes[i] {
+			return fmt.Errorf("unknown codeplug byte at offset 0x%06x has changed", i)
+		}
+	}
+
+	return nil
+}
+
+// WriteUnknownRegions writes an annotated hex dump of the codeplug's
+// unknown regions to w.  Offsets are given within both the rdt and
+// the bin file.  Unknown bits of bytes shared with a field are shown
+// by a mask following the byte.
+func (cp *Codeplug) WriteUnknownRegions(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	regions := cp.UnknownRegions()
+	size := 0
+	for _, region := range regions {
+		size += len(region.Bytes)
+	}
+
+	binOffset := cp.codeplugInfo.BinOffset
+	fmt.Fprintf(bw, "# Unknown regions of %s %s codeplug %s\n",
+		cp.Model(), cp.FrequencyRange(), cp.filename)
+	fmt.Fprintf(bw, "# %d regions, %d bytes; bin offset = rdt offset - 0x%x\n",
+		len(regions), size, binOffset)
+
+	for _, region := range regions {
+		fmt.Fprintln(bw)
+		record := region.Record
+		if record == "" {
+			record = "(no record)"
+		}
+		bin := "not in bin"
+		if region.Offset >= binOffset && region.Offset < binOffset+cp.codeplugInfo.BinSize {
+			bin = fmt.Sprintf("bin 0x%06x", region.Offset-binOffset)
+		}
+		fmt.Fprintf(bw, "%s: rdt 0x%06x %s, %d bytes\n", record,
+			region.Offset, bin, len(region.Bytes))
+
+		for i := 0; i < len(region.Bytes); i += 16 {
+			end := i + 16
+			if end > len(region.Bytes) {
+				end = len(region.Bytes)
+			}
+			fmt.Fprintf(bw, "  %06x:", region.Offset+i)
+			for j := i; j < end; j++ {
+				fmt.Fprintf(bw, " %02x", region.Bytes[j])
+				if region.Mask[j] != 0xff {
+					fmt.Fprintf(bw, "/%02x", region.Mask[j])
+				}
+			}
+			fmt.Fprintln(bw)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ExportUnknownRegions writes an annotated hex dump of the codeplug's
+// unknown regions to the named file, for use in reverse-engineering
+// them.
+func (cp *Codeplug) ExportUnknownRegions(filename string) (err error) {
+	file, err := cp.fsys.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+	}()
+
+	return cp.WriteUnknownRegions(file)
+}
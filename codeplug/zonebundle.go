@@ -0,0 +1,408 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package codeplug implements access to MD380-style codeplug files.
+// It can read/update/write both .rdt files and .bin files.
+package codeplug
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// A zone bundle is a text overlay holding a zone, its channels, and the
+// RX group lists and contacts they use, so that a zone may be shared
+// between codeplugs.  References to other records, such as a channel's
+// scan list, are written as "None".
+//
+// zoneBundleTypes are the record types of a zone bundle, in the order
+// they are written and imported.
+var zoneBundleTypes = []RecordType{
+	RtContacts,
+	RtGroupLists,
+	RtChannels_md380,
+	RtZones_md380,
+}
+
+// A ZoneConflict says what ImportZoneBundle does with a record of the
+// bundle whose name is used by a different record of the codeplug.
+type ZoneConflict string
+
+const (
+	// ZoneConflictRename imports the bundle's record under a new name.
+	ZoneConflictRename ZoneConflict = "rename"
+
+	// ZoneConflictKeep uses the codeplug's record in place of the
+	// bundle's.
+	ZoneConflictKeep ZoneConflict = "keep"
+
+	// ZoneConflictReplace replaces the fields of the codeplug's record
+	// with those of the bundle's.
+	ZoneConflictReplace ZoneConflict = "replace"
+)
+
+// ZoneConflicts returns the valid ZoneConflict values.
+func ZoneConflicts() []string {
+	return []string{
+		string(ZoneConflictRename),
+		string(ZoneConflictKeep),
+		string(ZoneConflictReplace),
+	}
+}
+
+// isZoneBundleType returns true if records of type rType may be in a
+// zone bundle.
+func isZoneBundleType(rType RecordType) bool {
+	for _, t := range zoneBundleTypes {
+		if t == rType {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteZoneBundle writes the named zone as a zone bundle to w.
+func (cp *Codeplug) WriteZoneBundle(w io.Writer, zoneName string) error {
+	zone := cp.FindRecordByName(RtZones_md380, zoneName)
+	if zone == nil {
+		return fmt.Errorf("no zone named '%s'", zoneName)
+	}
+
+	included := make(map[*Record]bool)
+	var include func(r *Record)
+	include = func(r *Record) {
+		if included[r] {
+			return
+		}
+		included[r] = true
+		for _, fType := range r.FieldTypes() {
+			for _, f := range r.Fields(fType) {
+				if !isZoneBundleType(f.listRecordType) {
+					continue
+				}
+				ref := cp.FindRecordByName(f.listRecordType, f.String())
+				if ref != nil {
+					include(ref)
+				}
+			}
+		}
+	}
+	include(zone)
+
+	fmt.Fprintf(w, "# Zone bundle: %s\n", zoneName)
+	for _, rType := range zoneBundleTypes {
+		var records []*Record
+		for r := range included {
+			if r.rType == rType {
+				records = append(records, r)
+			}
+		}
+		sort.Slice(records, func(i, j int) bool {
+			return records[i].rIndex < records[j].rIndex
+		})
+
+		for _, r := range records {
+			fmt.Fprintln(w)
+			writeZoneBundleRecord(w, r)
+		}
+	}
+
+	return nil
+}
+
+// writeZoneBundleRecord writes r in the text format, without its index
+// or pseudo-fields, and with references to records outside the bundle
+// written as "None".
+func writeZoneBundleRecord(w io.Writer, r *Record) {
+	fmt.Fprintf(w, "%s:\n", r.rType)
+
+	for _, fType := range r.FieldTypes() {
+		for _, f := range r.Fields(fType) {
+			value := f.String()
+			if f.listRecordType != "" && !isZoneBundleType(f.listRecordType) {
+				none, ok := noneValue(f)
+				if !ok {
+					continue
+				}
+				value = none
+			}
+			ind := ""
+			if f.max > 1 {
+				ind = fmt.Sprintf("[%d]", f.fIndex+1)
+			}
+			fmt.Fprintf(w, "\t%s%s: %s\n", fType, ind, quoteString(value))
+		}
+	}
+}
+
+// noneValue returns the value of the list field f that refers to no
+// record, if it has one.
+func noneValue(f *Field) (string, bool) {
+	for _, is := range f.IndexedStrings() {
+		if is.Index == 0 {
+			return is.String, true
+		}
+	}
+	return "", false
+}
+
+// WriteZoneBundleFile writes the named zone as a zone bundle to the
+// named file.
+func (cp *Codeplug) WriteZoneBundleFile(filename string, zoneName string) error {
+	file, err := cp.fsys.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	err = cp.WriteZoneBundle(file, zoneName)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// zoneImport is a record of a zone bundle and how it is imported.
+type zoneImport struct {
+	or      *overlayRecord
+	name    string
+	record  *Record
+	replace bool
+}
+
+// ImportZoneBundle adds the records of a zone bundle read from rdr to
+// the codeplug.  A contact with the call ID and call type of one of
+// the bundle's is used in its place, as is a record having the same
+// name and fields.  Other records whose names are already in use are
+// handled as conflict says.  References to renamed records are
+// changed to match.  The renames are returned.  The codeplug is not
+// changed if the records don't fit.  A signed bundle's signature is
+// verified.
+func (cp *Codeplug) ImportZoneBundle(rdr io.Reader, conflict ZoneConflict) ([]Rename, error) {
+	switch conflict {
+	case ZoneConflictRename, ZoneConflictKeep, ZoneConflictReplace:
+	default:
+		return nil, fmt.Errorf("bad zone conflict: %s", conflict)
+	}
+
+	data, err := ioutil.ReadAll(rdr)
+	if err != nil {
+		return nil, err
+	}
+	content, _, err := splitProvenance(data)
+	if err != nil {
+		return nil, err
+	}
+
+	oRecs, err := cp.overlayRecords(cp.parseTextFile(bytes.NewReader(content), ""))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(oRecs, func(i, j int) bool {
+		return zoneBundleOrder(oRecs[i].rType) < zoneBundleOrder(oRecs[j].rType)
+	})
+
+	names := make(map[RecordType]map[string]string)
+	policies := make(map[RecordType]*NamingPolicy)
+	var imports []*zoneImport
+	var renames []Rename
+	added := make(map[RecordType]int)
+	for _, or := range oRecs {
+		if !isZoneBundleType(or.rType) {
+			return nil, fmt.Errorf("%s records are not allowed in zone bundles", or.rType)
+		}
+		if names[or.rType] == nil {
+			names[or.rType] = make(map[string]string)
+		}
+
+		listTypes := make(map[FieldType]RecordType)
+		for _, fi := range cp.rDesc[or.rType].fieldInfos {
+			listTypes[fi.fType] = fi.listRecordType
+		}
+		for i, fv := range or.fvs {
+			if name, ok := names[listTypes[fv.fType]][fv.value]; ok {
+				or.fvs[i].value = name
+			}
+		}
+
+		if or.rType == RtContacts {
+			if r := cp.findBundleContact(or.fvs); r != nil {
+				if r.Name() != or.name {
+					names[or.rType][or.name] = r.Name()
+				}
+				continue
+			}
+		}
+
+		existing := cp.FindRecordByName(or.rType, or.name)
+		switch {
+		case existing == nil:
+			imports = append(imports, &zoneImport{or: or, name: or.name})
+			added[or.rType]++
+
+		case existing.hasFieldValues(or.fvs), conflict == ZoneConflictKeep:
+
+		case conflict == ZoneConflictReplace:
+			imports = append(imports, &zoneImport{or: or, name: or.name, record: existing, replace: true})
+
+		default:
+			policy := policies[or.rType]
+			if policy == nil {
+				policy, err = cp.NewNamingPolicy(or.rType, "{name}")
+				if err != nil {
+					return nil, err
+				}
+				for _, imp := range imports {
+					if imp.or.rType == or.rType {
+						policy.Reserve(imp.name)
+					}
+				}
+				policies[or.rType] = policy
+			}
+			name, err := policy.Name(map[string]string{"name": or.name})
+			if err != nil {
+				return nil, err
+			}
+			names[or.rType][or.name] = name
+			imports = append(imports, &zoneImport{or: or, name: name})
+			renames = append(renames, Rename{OldName: or.name, NewName: name})
+			added[or.rType]++
+		}
+		if policy := policies[or.rType]; policy != nil {
+			policy.Reserve(or.name)
+		}
+	}
+
+	for rType, count := range added {
+		if len(cp.records(rType))+count > cp.MaxRecords(rType) {
+			return nil, fmt.Errorf("too many %s", rType)
+		}
+	}
+
+	// Add the new records first, so that the bundle's records may
+	// refer to each other.
+	for _, imp := range imports {
+		if imp.replace {
+			continue
+		}
+		nameFieldType := cp.rDesc[imp.or.rType].nameFieldType
+		imp.record, err = cp.addRecord(imp.or.rType, []fieldValue{{nameFieldType, imp.name}})
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s", imp.or.rType, imp.name, err.Error())
+		}
+	}
+
+	for _, imp := range imports {
+		err := imp.record.setFieldValues(imp.or.fvs)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s", imp.or.rType, imp.name, err.Error())
+		}
+		for i := range renames {
+			if renames[i].NewName == imp.name && imp.or.name == renames[i].OldName {
+				renames[i].Record = imp.record
+			}
+		}
+	}
+
+	cp.changed = true
+
+	return renames, nil
+}
+
+// ImportZoneBundleFile imports the zone bundle in the named file, as
+// ImportZoneBundle does.
+func (cp *Codeplug) ImportZoneBundleFile(filename string, conflict ZoneConflict) ([]Rename, error) {
+	file, err := cp.fsys.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	renames, err := cp.ImportZoneBundle(file, conflict)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return renames, nil
+}
+
+// zoneBundleOrder returns the position of rType in zoneBundleTypes.
+func zoneBundleOrder(rType RecordType) int {
+	for i, t := range zoneBundleTypes {
+		if t == rType {
+			return i
+		}
+	}
+	return len(zoneBundleTypes)
+}
+
+// findBundleContact returns the codeplug's contact having the call ID
+// and call type given by fvs, if any.
+func (cp *Codeplug) findBundleContact(fvs []fieldValue) *Record {
+	var callID, callType string
+	for _, fv := range fvs {
+		switch fv.fType {
+		case FtDcCallID:
+			callID = fv.value
+		case FtDcCallType:
+			callType = fv.value
+		}
+	}
+
+	for _, r := range cp.records(RtContacts) {
+		if r.Field(FtDcCallType).String() == callType &&
+			r.Field(FtDcCallID).String() == callID {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// hasFieldValues returns true if the record's fields have the values
+// given by fvs.  The values of a field type that may occur more than
+// once must match all of the record's fields of that type, in order.
+func (r *Record) hasFieldValues(fvs []fieldValue) bool {
+	values := make(map[FieldType][]string)
+	for _, fv := range fvs {
+		values[fv.fType] = append(values[fv.fType], fv.value)
+	}
+
+	for fType, strs := range values {
+		fields := r.Fields(fType)
+		if len(fields) != len(strs) {
+			return false
+		}
+		for i, f := range fields {
+			if f.String() != strs[i] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Dfu.
+//
+// Dfu is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Dfu is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Dfu.  If not, see <http://www.gnu.org/licenses/>.
+
+package dfu
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// The radio's calibration data is kept in its own 4KB sector of the
+// SPI flash.  Like the user database, it can only be reached when the
+// radio is running md380tools firmware.
+const (
+	calibrationAddress = 0x1000
+	CalibrationSize    = 0x1000
+)
+
+// DumpCalibration writes the radio's calibration data to file.
+func (dfu *Dfu) DumpCalibration(file *os.File) error {
+	dfu.setMaxProgressCount(100)
+
+	_, err := dfu.init()
+	if err != nil {
+		return wrapError("DumpCalibration", err)
+	}
+
+	err = dfu.readSPIFlashTo(calibrationAddress, CalibrationSize, file)
+	if err != nil {
+		return wrapError("DumpCalibration", err)
+	}
+
+	return nil
+}
+
+// WriteCalibration replaces the radio's calibration data with the
+// contents of filename, as written by DumpCalibration.  This is
+// dangerous: calibration data from another radio, or damaged data,
+// leaves the radio transmitting off frequency or at the wrong power.
+func (dfu *Dfu) WriteCalibration(filename string) error {
+	calibration, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return wrapError("WriteCalibration", err)
+	}
+	if len(calibration) != CalibrationSize {
+		return fmt.Errorf("WriteCalibration: %s: is %d bytes, not %d", filename, len(calibration), CalibrationSize)
+	}
+	if bytes.Count(calibration, []byte{0xff}) == len(calibration) {
+		return fmt.Errorf("WriteCalibration: %s: holds no calibration data", filename)
+	}
+
+	_, err = dfu.init()
+	if err != nil {
+		return wrapError("WriteCalibration", err)
+	}
+
+	// The SPI flash is erased in blocks larger than the calibration
+	// data, so the rest of its block is read and written back.
+	blockAddress := calibrationAddress - calibrationAddress%dfu.eraseBlockSize
+	block := make([]byte, dfu.eraseBlockSize)
+
+	err = dfu.md380Cmd([]md380Cmd{
+		md380Cmd{0x91, 0x01}, // Programming Mode
+	})
+	if err != nil {
+		return wrapError("WriteCalibration", err)
+	}
+
+	dfu.setMaxProgressCount(len(block) / dfu.blockSize)
+
+	for offset := 0; offset < len(block); offset += dfu.blockSize {
+		err := dfu.progressFunc()
+		if err != nil {
+			return err
+		}
+
+		err = dfu.readSPIFlash(blockAddress+offset, block[offset:offset+dfu.blockSize])
+		if err != nil {
+			return wrapError("WriteCalibration", err)
+		}
+	}
+
+	copy(block[calibrationAddress-blockAddress:], calibration)
+
+	err = dfu.writeSPIFlashFrom(blockAddress, len(block), bytes.NewReader(block))
+	if err != nil {
+		return wrapError("WriteCalibration", err)
+	}
+
+	return nil
+}
@@ -25,6 +25,9 @@
 // https://github.com/travisgoodspeed/md380tools.
 
 // Package dfu implements reading/writing from/to the md380 radio via usb.
+// It depends only on the stdfu USB layer.  Tools that need only to read
+// and write a radio's memory may use the Radio interface returned by
+// Open.
 package dfu
 
 import (
@@ -36,7 +39,7 @@ import (
 	"os"
 	"time"
 
-	"github.com/dalefarnsworth/codeplug/stdfu"
+	"github.com/dalefarnsworth/codeplug/internal/stdfu"
 )
 
 const (
@@ -54,6 +57,8 @@ const spiEraseSPIFlashBlockDelay = 500 // milliseconds
 
 type Dfu struct {
 	stDfu             *stdfu.StDfu
+	device            string
+	logStart          time.Time
 	blockSize         int
 	eraseBlockSize    int
 	progressCallback  func(progressCounter int) bool
@@ -62,8 +67,18 @@ type Dfu struct {
 	progressCounter   int
 }
 
+// Devices returns the USB port paths of the connected radios, for use
+// with NewDevice.  Several radios may be programmed at once, each by
+// its own Dfu.
+func Devices() ([]string, error) {
+	return stdfu.Devices()
+}
+
 func (dfu *Dfu) Close() {
-	dfu.stDfu.Close()
+	if dfu.stDfu != nil {
+		dfu.stDfu.Close()
+		dfu.stDfu = nil
+	}
 	dfu.progressCallback = nil
 }
 
@@ -163,6 +178,43 @@ func (dfu *Dfu) md380Reboot() error {
 	return nil
 }
 
+// rebootPollInterval is how often Reboot looks for the radio.
+const rebootPollInterval = 500 * time.Millisecond
+
+// Reboot restarts the radio and waits, for at most timeout, until it
+// is again connected.  The radio is then reopened, so the Dfu remains
+// usable.
+func (dfu *Dfu) Reboot(timeout time.Duration) error {
+	// A write already ends by restarting the radio, in which case
+	// it no longer answers.
+	_ = dfu.md380Reboot()
+	dfu.stDfu.Close()
+	dfu.stDfu = nil
+
+	deadline := time.Now().Add(timeout)
+
+	// Give the radio time to drop off the bus before looking for it.
+	time.Sleep(2 * time.Second)
+
+	for {
+		stDfu, err := stdfu.NewDevice(dfu.device)
+		if err == nil {
+			_, err = stDfu.GetState()
+			if err == nil {
+				dfu.stDfu = stDfu
+				dfu.logf("reconnected after reboot")
+				return nil
+			}
+			stDfu.Close()
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Reboot: radio did not return within %s: %s", timeout, err.Error())
+		}
+		time.Sleep(rebootPollInterval)
+	}
+}
+
 func (dfu *Dfu) waitUntilReady() error {
 	stDfu := dfu.stDfu
 
@@ -176,6 +228,7 @@ func (dfu *Dfu) waitUntilReady() error {
 			break
 		}
 
+		dfu.logf("wait until ready: radio is in state %s, status %s", dfuStatus.State, dfuStatus.Status)
 		err = stDfu.ClrStatus()
 		if err != nil {
 			return wrapError("waitUntilReady", err)
@@ -185,7 +238,11 @@ func (dfu *Dfu) waitUntilReady() error {
 	return nil
 }
 
-func (dfu *Dfu) setAddress(address int) error {
+func (dfu *Dfu) setAddress(address int) (err error) {
+	defer func(start time.Time) {
+		dfu.logResult(start, err, "set address 0x%08x", address)
+	}(time.Now())
+
 	a := byte(address)
 	b := byte((address >> 8))
 	c := byte((address >> 16))
@@ -194,7 +251,7 @@ func (dfu *Dfu) setAddress(address int) error {
 
 	stDfu := dfu.stDfu
 
-	err := stDfu.Dnload(controlBlock, addrCmd)
+	err = stDfu.Dnload(controlBlock, addrCmd)
 	if err != nil {
 		return wrapError("setAddress", err)
 	}
@@ -256,7 +313,11 @@ func (dfu *Dfu) eraseSPIFlashBlocks(addr int, size int) error {
 	return nil
 }
 
-func (dfu *Dfu) eraseBlock(address int) error {
+func (dfu *Dfu) eraseBlock(address int) (err error) {
+	defer func(start time.Time) {
+		dfu.logResult(start, err, "erase block 0x%08x", address)
+	}(time.Now())
+
 	addrCmd := []byte{
 		0x41,
 		byte(address),
@@ -267,7 +328,7 @@ func (dfu *Dfu) eraseBlock(address int) error {
 
 	stDfu := dfu.stDfu
 
-	err := stDfu.Dnload(controlBlock, addrCmd)
+	err = stDfu.Dnload(controlBlock, addrCmd)
 	if err != nil {
 		return wrapError("eraseBlock", err)
 	}
@@ -292,7 +353,11 @@ func (dfu *Dfu) eraseBlock(address int) error {
 	return nil
 }
 
-func (dfu *Dfu) eraseSPIFlashBlock(address int) error {
+func (dfu *Dfu) eraseSPIFlashBlock(address int) (err error) {
+	defer func(start time.Time) {
+		dfu.logResult(start, err, "erase SPI flash block 0x%08x", address)
+	}(time.Now())
+
 	addrCmd := []byte{
 		byte(0x03), // SPIFLASHWRITE
 		byte(address),
@@ -303,7 +368,7 @@ func (dfu *Dfu) eraseSPIFlashBlock(address int) error {
 
 	stDfu := dfu.stDfu
 
-	err := stDfu.Dnload(spiBlock, addrCmd)
+	err = stDfu.Dnload(spiBlock, addrCmd)
 	if err != nil {
 		return wrapError("eraseSPIFlashBlock", err)
 	}
@@ -398,6 +463,7 @@ func (dfu *Dfu) enterDfuMode() error {
 		if state == stdfu.DfuIdle {
 			break
 		}
+		dfu.logf("enter DFU mode: radio is in state %s", state)
 		err = actionMap[state]()
 		if err != nil {
 			return wrapError("enterDfuMode", err)
@@ -407,12 +473,16 @@ func (dfu *Dfu) enterDfuMode() error {
 	return nil
 }
 
-func (dfu *Dfu) md380Custom(acmd md380Cmd) error {
+func (dfu *Dfu) md380Custom(acmd md380Cmd) (err error) {
 	cmd := []byte{byte(acmd.a), byte(acmd.b)}
 
+	defer func(start time.Time) {
+		dfu.logResult(start, err, "command %02x%02x", cmd[0], cmd[1])
+	}(time.Now())
+
 	stDfu := dfu.stDfu
 
-	err := stDfu.Dnload(controlBlock, cmd)
+	err = stDfu.Dnload(controlBlock, cmd)
 	if err != nil {
 		return err
 	}
@@ -469,7 +539,10 @@ func (dfu *Dfu) readSPIFlashTo(address, size int, iWriter io.Writer) error {
 			bytes = make([]byte, remaining)
 		}
 
-		dfu.readSPIFlash(addr, bytes)
+		err = dfu.readSPIFlash(addr, bytes)
+		if err != nil {
+			return wrapError("readSPIFlashTo", err)
+		}
 
 		n, err := writer.Write(bytes)
 		if err != nil {
@@ -847,7 +920,9 @@ func (dfu *Dfu) readFlashTo(address, offset int, size int, iWriter io.Writer) er
 			return err
 		}
 
+		start := time.Now()
 		err = stDfu.Upload(blockNumber, bytes)
+		dfu.logResult(start, err, "read block %d at 0x%08x, %d bytes", blockNumber, address+i*dfu.blockSize, len(bytes))
 		if err != nil {
 			return wrapError("readFlashTo", err)
 		}
@@ -865,6 +940,11 @@ func (dfu *Dfu) readFlashTo(address, offset int, size int, iWriter io.Writer) er
 		blockNumber++
 	}
 
+	err = writer.Flush()
+	if err != nil {
+		return wrapError("readFlashTo", err)
+	}
+
 	err = dfu.md380Reboot()
 	if err != nil {
 		return wrapError("readFlashTo", err)
@@ -883,12 +963,12 @@ func (dfu *Dfu) writeFlashFrom(address, offset int, size int, iRdr io.Reader) er
 	rdr := bufio.NewReader(iRdr)
 	buf := make([]byte, dfu.blockSize)
 
-	err := dfu.eraseBlocks(0x00000000, size)
+	err := dfu.eraseBlocks(address, size)
 	if err != nil {
 		return wrapError("writeFlashFrom", err)
 	}
 
-	err = dfu.setAddress(0x00000000)
+	err = dfu.setAddress(address)
 	if err != nil {
 		return wrapError("writeFlashFrom", err)
 	}
@@ -919,14 +999,19 @@ func (dfu *Dfu) writeFlashFrom(address, offset int, size int, iRdr io.Reader) er
 			copy(buf[n:], padding)
 		}
 
+		start := time.Now()
 		err = stDfu.Dnload(blockNumber, buf)
 		if err != nil {
+			dfu.logResult(start, err, "write block %d at 0x%08x", blockNumber, address+i*dfu.blockSize)
 			return wrapError("writeFlashFrom", err)
 		}
 
+		polls := 0
 		for {
+			polls++
 			dfuStatus, err := stDfu.GetStatus()
 			if err != nil {
+				dfu.logResult(start, err, "write block %d at 0x%08x, status poll %d", blockNumber, address+i*dfu.blockSize, polls)
 				return wrapError("writeFlashFrom", err)
 			}
 
@@ -934,6 +1019,7 @@ func (dfu *Dfu) writeFlashFrom(address, offset int, size int, iRdr io.Reader) er
 				break
 			}
 		}
+		dfu.logResult(start, nil, "write block %d at 0x%08x, %d bytes, %d status polls", blockNumber, address+i*dfu.blockSize, len(buf), polls)
 		blockNumber++
 	}
 
@@ -1125,16 +1211,91 @@ func (dfu *Dfu) finalProgress() {
 	}
 }
 
+// RadioInfoSize is the size of the block returned by RadioInfo.
+const RadioInfoSize = 64
+
+// RadioInfo returns the start of the radio's information block, which
+// names its model and frequency range.  The vendor's CPS saves the
+// block before the codeplug's image in an .rdt file.
+func (dfu *Dfu) RadioInfo() (info []byte, err error) {
+	defer func(start time.Time) {
+		dfu.logResult(start, err, "read radio information")
+	}(time.Now())
+
+	_, err = dfu.init()
+	if err != nil {
+		return nil, wrapError("RadioInfo", err)
+	}
+
+	err = dfu.md380Cmd([]md380Cmd{
+		md380Cmd{0x91, 0x01}, // Programming Mode
+	})
+	if err != nil {
+		return nil, wrapError("RadioInfo", err)
+	}
+
+	stDfu := dfu.stDfu
+
+	cmd := []byte{0xa2, 0x01} // Radio information
+	err = stDfu.Dnload(controlBlock, cmd)
+	if err != nil {
+		return nil, wrapError("RadioInfo", err)
+	}
+
+	_, err = stDfu.GetStatus() // this changes state
+	if err != nil {
+		return nil, wrapError("RadioInfo", err)
+	}
+
+	err = dfu.sleepMilliseconds(100)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = stDfu.GetStatus() // this actually gets the state
+	if err != nil {
+		return nil, wrapError("RadioInfo", err)
+	}
+
+	info = make([]byte, RadioInfoSize)
+	err = stDfu.Upload(controlBlock, info)
+	if err != nil {
+		return nil, wrapError("RadioInfo", err)
+	}
+
+	err = dfu.enterDfuMode()
+	if err != nil {
+		return nil, wrapError("RadioInfo", err)
+	}
+
+	return info, nil
+}
+
 func (dfu *Dfu) ReadCodeplug(data []byte) error {
+	return dfu.ReadCodeplugRegion(data, 0, len(data))
+}
+
+// ReadCodeplugRegion reads size bytes at the given offset of the radio's
+// codeplug into the same offset of data, which holds the entire codeplug.
+// The region is extended to a multiple of the transfer block size.
+func (dfu *Dfu) ReadCodeplugRegion(data []byte, offset, size int) (err error) {
+	defer func(start time.Time) {
+		dfu.logResult(start, err, "read codeplug region %d+%d", offset, size)
+	}(time.Now())
+
+	start, end := alignRegion(offset, size, dfu.blockSize)
+	if start < 0 || end > len(data) {
+		return fmt.Errorf("ReadCodeplug: region %d+%d is outside the codeplug", offset, size)
+	}
+
 	dfu.setMaxProgressCount(620)
 
-	_, err := dfu.init()
+	_, err = dfu.init()
 	if err != nil {
 		return wrapError("ReadCodeplug", err)
 	}
 
-	size := len(data)
-	buffer := bytes.NewBuffer(data[0:0])
+	buffer := bytes.NewBuffer(data[start:start])
 
 	err = dfu.md380Cmd([]md380Cmd{
 		md380Cmd{0x91, 0x01}, // Programming Mode
@@ -1150,18 +1311,35 @@ func (dfu *Dfu) ReadCodeplug(data []byte) error {
 
 	dfu.finalProgress()
 
-	err = dfu.readFlashTo(0, 2048, size, buffer)
+	err = dfu.readFlashTo(start, 2048, end-start, buffer)
 	if err != nil {
 		return wrapError("ReadCodeplug", err)
 	}
 
+	if buffer.Len() != end-start {
+		err = fmt.Errorf("read %d of %d bytes", buffer.Len(), end-start)
+		return wrapError("ReadCodeplug", err)
+	}
+
 	return nil
 }
 
 func (dfu *Dfu) WriteCodeplug(data []byte) error {
+	return dfu.WriteCodeplugRegion(data, 0, len(data))
+}
+
+// WriteCodeplugRegion writes size bytes at the given offset of data,
+// which holds the entire codeplug, to the same offset of the radio's
+// codeplug.  Because the flash is erased in units of the erase block
+// size, the region is extended to a multiple of that size.
+func (dfu *Dfu) WriteCodeplugRegion(data []byte, offset, size int) (err error) {
+	defer func(start time.Time) {
+		dfu.logResult(start, err, "write codeplug region %d+%d", offset, size)
+	}(time.Now())
+
 	dfu.setMaxProgressCount(2750)
 
-	_, err := dfu.init()
+	_, err = dfu.init()
 	if err != nil {
 		return wrapError("WriteCodeplug", err)
 	}
@@ -1170,7 +1348,15 @@ func (dfu *Dfu) WriteCodeplug(data []byte) error {
 		return fmt.Errorf("WriteCodeplug: codeplug data size is not a multiple of blocksize %d", dfu.blockSize)
 	}
 
-	buffer := bytes.NewBuffer(data)
+	start, end := alignRegion(offset, size, dfu.eraseBlockSize)
+	if end > len(data) {
+		end = len(data)
+	}
+	if start < 0 || start >= end {
+		return fmt.Errorf("WriteCodeplug: region %d+%d is outside the codeplug", offset, size)
+	}
+
+	buffer := bytes.NewBuffer(data[start:end])
 
 	err = dfu.md380Cmd([]md380Cmd{
 		md380Cmd{0x91, 0x01}, // Programming Mode
@@ -1188,18 +1374,27 @@ func (dfu *Dfu) WriteCodeplug(data []byte) error {
 
 	dfu.finalProgress()
 
-	return dfu.writeFlashFrom(0, 2048, len(data), buffer)
+	return dfu.writeFlashFrom(start, 2048, end-start, buffer)
+}
+
+// alignRegion returns the start and end of the smallest region aligned
+// to alignment that contains size bytes at offset.
+func alignRegion(offset, size, alignment int) (start, end int) {
+	start = offset / alignment * alignment
+	end = (offset + size + alignment - 1) / alignment * alignment
+
+	return start, end
 }
 
 func (dfu *Dfu) WriteUsers(filename string) error {
 	fileInfo, err := os.Stat(filename)
 	if err != nil {
-		logFatalf("os.Stat: %s", err.Error())
+		return wrapError("WriteUsers", err)
 	}
 
 	file, err := os.Open(filename)
 	if err != nil {
-		logFatalf("WriteUsers: %s", err.Error())
+		return wrapError("WriteUsers", err)
 	}
 	defer file.Close()
 
@@ -1216,7 +1411,7 @@ func (dfu *Dfu) WriteUsers(filename string) error {
 func (dfu *Dfu) WriteFirmware(filename string) error {
 	file, err := os.Open(filename)
 	if err != nil {
-		logFatalf("WriteFirmware: %s", err.Error())
+		return wrapError("WriteFirmware", err)
 	}
 	defer file.Close()
 
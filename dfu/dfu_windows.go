@@ -28,17 +28,24 @@
 package dfu
 
 import (
-	"github.com/dalefarnsworth/codeplug/stdfu"
+	"github.com/dalefarnsworth/codeplug/internal/stdfu"
 )
 
 func New(progressCallback func(progressCounter int) bool) (*Dfu, error) {
-	stDfu, err := stdfu.New()
+	return NewDevice("", progressCallback)
+}
+
+// NewDevice connects to the radio at the USB port path device, as
+// returned by Devices, or to the first radio found if device is "".
+func NewDevice(device string, progressCallback func(progressCounter int) bool) (*Dfu, error) {
+	stDfu, err := stdfu.NewDevice(device)
 	if err != nil {
 		return nil, err
 	}
 
 	dfu := &Dfu{
 		stDfu:            stDfu,
+		device:           device,
 		progressCallback: progressCallback,
 		progressFunc:     func() error { return nil },
 	}
@@ -50,7 +57,9 @@ func New(progressCallback func(progressCounter int) bool) (*Dfu, error) {
 	}
 
 	dfu.blockSize = 1024
-	dfu.eraseBlockSize = 64 * 1024
+	dfu.eraseBlockSize = EraseBlockSize
+
+	dfu.logf("connected")
 
 	return dfu, nil
 }
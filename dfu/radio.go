@@ -0,0 +1,192 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Dfu.
+//
+// Dfu is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Dfu is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Dfu.  If not, see <http://www.gnu.org/licenses/>.
+
+package dfu
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// A Space is one of the memories of a radio that can be read and
+// written.
+type Space int
+
+const (
+	// CodeplugSpace is the radio's codeplug, in its internal flash.
+	CodeplugSpace Space = iota
+
+	// SPIFlashSpace is the radio's external SPI flash, which holds
+	// the md380tools users database.
+	SPIFlashSpace
+)
+
+func (space Space) String() string {
+	switch space {
+	case CodeplugSpace:
+		return "codeplug"
+	case SPIFlashSpace:
+		return "SPI flash"
+	}
+
+	return fmt.Sprintf("space %d", int(space))
+}
+
+// An Identity describes a connected radio.
+type Identity struct {
+	// Device is the radio's USB port path, or "" for the first
+	// radio found.
+	Device string
+
+	Manufacturer string
+	Product      string
+
+	// SPIFlash is the part number of the radio's SPI flash, and
+	// SPIFlashSize its size in bytes.
+	SPIFlash     string
+	SPIFlashSize int
+}
+
+// A Radio is a radio connected by USB DFU.  It depends on nothing but
+// the USB layer, so tools other than the codeplug editor may use it to
+// read and write a radio's memory.  It is implemented by *Dfu, whose
+// other methods provide the radio's remaining operations.
+type Radio interface {
+	// Identity returns a description of the radio.
+	Identity() (Identity, error)
+
+	// ReadRegion reads len(data) bytes at offset in space into data.
+	ReadRegion(space Space, offset int, data []byte) error
+
+	// WriteRegion writes data at offset in space.  Because flash is
+	// erased in blocks, offset and len(data) must be multiples of
+	// EraseBlockSize.
+	WriteRegion(space Space, offset int, data []byte) error
+
+	// Close disconnects from the radio.
+	Close()
+}
+
+// EraseBlockSize is the size of the flash blocks erased before writing.
+const EraseBlockSize = 64 * 1024
+
+var _ Radio = (*Dfu)(nil)
+
+// Open connects to the radio at the USB port path device, as returned
+// by Devices, or to the first radio found if device is "".  The
+// progress function, if not nil, is called with values between
+// MinProgress and MaxProgress as data is transferred, and ends the
+// transfer if it returns false.
+func Open(device string, progress func(progressCounter int) bool) (Radio, error) {
+	dfu, err := NewDevice(device, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	return dfu, nil
+}
+
+// Identity returns a description of the radio.
+func (dfu *Dfu) Identity() (Identity, error) {
+	id := Identity{Device: dfu.device}
+
+	mfg, err := dfu.init()
+	if err != nil {
+		return id, wrapError("Identity", err)
+	}
+	id.Manufacturer = mfg
+
+	id.Product, err = dfu.stDfu.GetStringDescriptor(2)
+	if err != nil {
+		return id, wrapError("Identity", err)
+	}
+
+	id.SPIFlash, err = dfu.spiFlashID()
+	if err != nil {
+		return id, wrapError("Identity", err)
+	}
+
+	id.SPIFlashSize, err = dfu.spiFlashSize()
+	if err != nil {
+		return id, wrapError("Identity", err)
+	}
+
+	return id, nil
+}
+
+// ReadRegion reads len(data) bytes at offset in space into data.
+func (dfu *Dfu) ReadRegion(space Space, offset int, data []byte) error {
+	if offset < 0 {
+		return fmt.Errorf("ReadRegion: negative %s offset %d", space, offset)
+	}
+
+	switch space {
+	case CodeplugSpace:
+		_, end := alignRegion(offset, len(data), dfu.blockSize)
+		buf := make([]byte, end)
+		err := dfu.ReadCodeplugRegion(buf, offset, len(data))
+		if err != nil {
+			return err
+		}
+		copy(data, buf[offset:])
+
+		return nil
+
+	case SPIFlashSpace:
+		_, err := dfu.init()
+		if err != nil {
+			return wrapError("ReadRegion", err)
+		}
+
+		return dfu.readSPIFlashTo(offset, len(data), bytes.NewBuffer(data[:0]))
+	}
+
+	return fmt.Errorf("ReadRegion: unknown %s", space)
+}
+
+// WriteRegion writes data at offset in space.  Offset and len(data)
+// must be multiples of EraseBlockSize.
+func (dfu *Dfu) WriteRegion(space Space, offset int, data []byte) error {
+	if offset < 0 || offset%dfu.eraseBlockSize != 0 || len(data)%dfu.eraseBlockSize != 0 {
+		return fmt.Errorf("WriteRegion: %s region %d+%d is not aligned to %d bytes",
+			space, offset, len(data), dfu.eraseBlockSize)
+	}
+
+	switch space {
+	case CodeplugSpace:
+		buf := make([]byte, offset+len(data))
+		copy(buf[offset:], data)
+
+		return dfu.WriteCodeplugRegion(buf, offset, len(data))
+
+	case SPIFlashSpace:
+		_, err := dfu.init()
+		if err != nil {
+			return wrapError("WriteRegion", err)
+		}
+
+		return dfu.writeSPIFlashFrom(offset, len(data), bytes.NewReader(data))
+	}
+
+	return fmt.Errorf("WriteRegion: unknown %s", space)
+}
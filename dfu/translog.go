@@ -0,0 +1,86 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Dfu.
+//
+// Dfu is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Dfu is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Dfu.  If not, see <http://www.gnu.org/licenses/>.
+
+package dfu
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+var transferLog struct {
+	sync.Mutex
+	w io.Writer
+}
+
+// SetTransferLog sets a writer to which a detailed log of transfers
+// with radios is written: the commands sent, flash addresses erased,
+// each block read or written with its timing and status polls, and
+// any USB errors.  Such a log helps diagnose failing transfers.  If w
+// is nil, as by default, nothing is logged.
+func SetTransferLog(w io.Writer) {
+	transferLog.Lock()
+	defer transferLog.Unlock()
+
+	transferLog.w = w
+}
+
+// logf writes a line to the transfer log, prefixed by the time, the
+// seconds since the radio was opened and the radio's USB port.
+func (dfu *Dfu) logf(format string, v ...interface{}) {
+	transferLog.Lock()
+	defer transferLog.Unlock()
+
+	if transferLog.w == nil {
+		return
+	}
+
+	now := time.Now()
+	if dfu.logStart.IsZero() {
+		dfu.logStart = now
+	}
+	device := dfu.device
+	if device == "" {
+		device = "radio"
+	}
+
+	fmt.Fprintf(transferLog.w, "%s %8.3f %s: %s\n",
+		now.Format("15:04:05.000"), now.Sub(dfu.logStart).Seconds(),
+		device, fmt.Sprintf(format, v...))
+}
+
+// logResult logs the outcome of an operation begun at start, returning
+// err.
+func (dfu *Dfu) logResult(start time.Time, err error, format string, v ...interface{}) error {
+	msg := fmt.Sprintf(format, v...)
+	elapsed := time.Since(start).Round(time.Microsecond)
+	if err != nil {
+		dfu.logf("%s: failed after %s: %s", msg, elapsed, err.Error())
+	} else {
+		dfu.logf("%s: %s", msg, elapsed)
+	}
+
+	return err
+}
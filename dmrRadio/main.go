@@ -24,40 +24,139 @@
 package main
 
 import (
+	"bufio"
+	"crypto/ed25519"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/dalefarnsworth/codeplug/brandmeister"
 	"github.com/dalefarnsworth/codeplug/codeplug"
 	"github.com/dalefarnsworth/codeplug/dfu"
+	"github.com/dalefarnsworth/codeplug/internal/i18n"
+	"github.com/dalefarnsworth/codeplug/internal/snippets"
+	"github.com/dalefarnsworth/codeplug/profile"
+	"github.com/dalefarnsworth/codeplug/service"
 	"github.com/dalefarnsworth/codeplug/userdb"
 )
 
+// auditAuthor is set by the -auditAuthor option.
+var auditAuthor string
+
+// parseMode is set by the -parseMode option.
+var parseMode codeplug.ParseMode
+
+// overrideLocks is set by the -overrideLocks option.
+var overrideLocks bool
+
+// policies are read from the file given by the -policies option.
+var policies []*codeplug.Policy
+
+// rules are read from the file given by the -rules option.
+var rules []*codeplug.Rule
+
+// talkgroupNames are read from the file given by the -talkgroupNames
+// option.
+var talkgroupNames []codeplug.TalkgroupName
+
+// abbreviations are read from the file given by the -abbreviations
+// option.
+var abbreviations map[string]string
+
 func errorf(s string, v ...interface{}) {
-	fmt.Fprintf(os.Stderr, s, v...)
+	fmt.Fprintf(os.Stderr, i18n.T(s), v...)
 }
 
 func usage() {
-	errorf("Usage %s <subCommand> args\n", os.Args[0])
+	errorf("Usage %s [-plugin <pluginFilename>]... [-transport <transport>] [-overrideLocks] [-lang <language>] [-transferLog <logFilename>] [-auditAuthor <name>] [-parseMode <normal|strict|lenient>] [-profile <name>] [-policies <policiesFilename>] [-rules <rulesFilename>] [-talkgroupNames <csvFilename>] [-abbreviations <csvFilename>] <subCommand> args\n", os.Args[0])
 	errorf("subCommands:\n")
 	errorf("\treadCodeplug -model <model> -freq <freqRange> <codeplugFilename>\n")
-	errorf("\twriteCodeplug <codeplugFilename>\n")
+	errorf("\tnewCodeplug -model <model> -freq <freqRange> [-radioID <dmrID>] <codeplugFilename>\n")
+	errorf("\tnewCodeplugWizard <codeplugFilename>\n")
+	errorf("\twriteCodeplug [-records <recordTypes> | -devices <ports>] [-reboot] [-force] [-backupDir <dir> | -noBackup] [-keepBackups <n>] <codeplugFilename>\n")
+	errorf("\tlistRadios\n")
 	errorf("\twriteFirmware <firmwareFilename>\n")
 	errorf("\tdumpUsers <usersFilename>\n")
 	errorf("\twriteUsers <usersFilename>\n")
 	errorf("\tdumpSPIFlash <filename>\n")
-	errorf("\tgetUsers <usersFilename>\n")
+	errorf("\tsplitFlashDump [-model <model> -freq <freqRange>] [-codeplug <codeplugFilename>] [-users <usersFilename>] <dumpFilename>\n")
+	errorf("\timportCPSArchive [-index <n>] <archiveFilename> [<codeplugFilename>]\n")
+	errorf("\tdumpCalibration <calibrationFilename>\n")
+	errorf("\twriteCalibration -dangerous <calibrationFilename>\n")
+	errorf("\tgetUsers [-states <abbrev|name>] [-countries <name|iso>] [-titleCase] [-elide] [-lastHeard <log> -activeMonths <months>] [-regions <regions>] [-only <countries>] [-custom <csvFilename>]... [-codeplug <codeplugFilename>]... [-blocklist <filename>] [-warnings] [-spill <dir>] [-staging <dir>] [-resolver <server>] [-ipv4] [-dialTimeout <seconds>] [-callsigns <warn|drop>] [-marcMirror <URL>]... [-record <dir> | -replay <dir>] <usersFilename>\n")
+	errorf("\tcontactsToUsers <codeplugFilename> <csvFilename>\n")
 	errorf("\tcodeplugToText <codeplugFilename> <textFilename>\n")
 	errorf("\ttextToCodeplug <textFilename> <codeplugFilename>\n")
 	errorf("\tcodeplugToJSON <codeplugFilename> <jsonFilename>\n")
 	errorf("\tjsonToCodeplug <jsonFilename> <codeplugFilename>\n")
 	errorf("\tcodeplugToXLSX <codeplugFilename> <xlsxFilename>\n")
 	errorf("\txlsxToCodeplug <xlsxFilename> <codeplugFilename>\n")
+	errorf("\tcodeplugToHTML <codeplugFilename> <htmlFilename>\n")
+	errorf("\tcodeplugToMarkdown <codeplugFilename> <markdownFilename>\n")
+	errorf("\tcodeplugToOpenRTX <codeplugFilename> <jsonFilename>\n")
+	errorf("\taddHotspot -freq <MHz> -cc <colorCode> -talkgroups <talkgroups> <codeplugFilename>\n")
+	errorf("\tbrandmeisterDevices [-key <apiKey>] <callsign>\n")
+	errorf("\taddBrandmeisterDevice [-key <apiKey>] -device <deviceID> [-name <name>] <codeplugFilename>\n")
+	errorf("\tplanTalkgroups -talkgroups <talkgroups> [-sites <sitesFilename>] [-devices <deviceIDs>] [-key <apiKey>] [<codeplugFilename>]\n")
+	errorf("\taddSimplex -region <region> <codeplugFilename>\n")
+	errorf("\taddParrot -freq <MHz> -cc <colorCode> <codeplugFilename>\n")
+	errorf("\tsortChannels -from <lat,lon> | -route <lat,lon;...> <inFilename> <outFilename>\n")
+	errorf("\taddDistanceZone -from <lat,lon> | -route <lat,lon;...> -radius <km> <inFilename> <outFilename>\n")
+	errorf("\taddTravelZones -gpx <gpxFilename> | -route <lat,lon;...> -repeaters <repeaterBookFilename> <inFilename> <outFilename>\n")
+	errorf("\taddPastedRepeaters [-listing <filename>] [-channelName <template>] [-talkgroup <id:name>] [-yes] <inFilename> <outFilename>\n")
+	errorf("\tsyncScanLists [-assign] <inFilename> <outFilename>\n")
+	errorf("\toptimizeGroupLists <inFilename> [<outFilename>]\n")
+	errorf("\ttalkgroupUsage [-removeUnused] [-keepListed] [-addUnheard] <inFilename> [<outFilename>]\n")
+	errorf("\tcheckPolicies [-fix] <policiesFilename> <inFilename> [<outFilename>]\n")
+	errorf("\tcheckRules <rulesFilename> <codeplugFilename>\n")
+	errorf("\tfix [-maxNameLength <n>] [-report <reportFilename>] <inFilename> [<outFilename>]\n")
+	errorf("\tmergeDuplicateChannels <inFilename> [<outFilename>]\n")
+	errorf("\tlockRecords [-unlock] [-fields <fieldTypes>] -type <recordType> <inFilename> <outFilename> [<name>...]\n")
+	errorf("\trenameRecords -type <recordType> [-tagged <tags>] [-match <regexp>] -replace <template> [-start <n>] [-step <n>] [-width <n>] [-case upper|lower|title] [-dryRun] <inFilename> <outFilename> [<name>...]\n")
+	errorf("\tget <path> <codeplugFilename>\n")
+	errorf("\tset <path> <value> <inFilename> [<outFilename>]\n")
+	errorf("\tcloneRecord [-type <recordType>] -field <fieldType> -values <values> [-name <template>] <inFilename> <outFilename> <name>\n")
+	errorf("\trenameTalkgroups [-dryRun] <csvFilename> <inFilename> <outFilename>\n")
+	errorf("\ttagRecords [-remove] -type <recordType> -tags <tags> [-tagged <tags>] <inFilename> <outFilename> [<name>...]\n")
+	errorf("\tlistTagged -type <recordType> [-tagged <tags>] <filename>\n")
+	errorf("\taddTagZone -tagged <tags> [-name <template>] <inFilename> <outFilename>\n")
+	errorf("\tquery [-r] [-c] <expression> <codeplugFilename>\n")
+	errorf("\treport [-json] [-users <usersFilename>] <codeplugFilename>\n")
+	errorf("\tbundle [-users <usersFilename>] <codeplugFilename> <bundleFilename>\n")
+	errorf("\tunknownRegions <codeplugFilename> [<dumpFilename>]\n")
+	errorf("\tverifyRoundTrip [-model <model>] <imageFilename>\n")
+	errorf("\tcheckAnalogChannels <codeplugFilename>\n")
+	errorf("\tcheckDigitalChannels <codeplugFilename>\n")
+	errorf("\timportContacts <contactsFilename> <inFilename> <outFilename>\n")
+	errorf("\twatch [-out <outDir>] [-interval <seconds>] [-once] <dir>\n")
+	errorf("\tapplyOverlays <baseFilename> <outFilename> <overlayFilename>...\n")
+	errorf("\tapplySchedule [-date <yyyy-mm-dd>] <scheduleFilename> <baseFilename> <outFilename>\n")
+	errorf("\texportZone <codeplugFilename> <zoneName> <bundleFilename>\n")
+	errorf("\timportZone [-conflict rename|keep|replace] <inFilename> <outFilename> <bundleFilename>...\n")
+	errorf("\tsearchSnippets [-index <URL>] [<word>...]\n")
+	errorf("\timportSnippets [-index <URL>] [-trust <publicKeyFilename>]... [-conflict rename|keep|replace] <inFilename> <outFilename> <snippetName>...\n")
+	errorf("\tgenSigningKey <privateKeyFilename> <publicKeyFilename>\n")
+	errorf("\tsignCodeplug [-key <privateKeyFilename>] [-author <author>] <filename>\n")
+	errorf("\tverifyCodeplug [-trust <publicKeyFilename>]... <filename>\n")
+	errorf("\tencryptCodeplug <inFilename> <encryptedFilename>\n")
+	errorf("\tdecryptCodeplug <encryptedFilename> <outFilename>\n")
+	errorf("\timportCodeplug -format <format> <filename> <codeplugFilename>\n")
+	errorf("\texportCodeplug -format <format> <codeplugFilename> <filename>\n")
+	errorf("\tserve [-addr <address>]\n")
+	errorf("\tlistProfiles\n")
+	errorf("\tshowProfile <profileName>\n")
+	errorf("\tsetProfileOption <profileName> <subCommand> <option> [<value>]\n")
 	errorf("\tversion\n")
 	errorf("Use '%s <subCommand> -h' for subCommand help\n", os.Args[0])
 	os.Exit(1)
@@ -84,6 +183,10 @@ func loadCodeplug(fType codeplug.FileType, filename string) (*codeplug.Codeplug,
 		return nil, err
 	}
 
+	return loadNewCodeplug(cp)
+}
+
+func loadNewCodeplug(cp *codeplug.Codeplug) (*codeplug.Codeplug, error) {
 	models, freqs := cp.ModelsFrequencyRanges()
 	if len(models) == 0 {
 		return nil, errors.New("unknown model in codeplug")
@@ -97,15 +200,87 @@ func loadCodeplug(fType codeplug.FileType, filename string) (*codeplug.Codeplug,
 
 	freq := freqs[model][0]
 
+	cp.SetParseMode(parseMode)
+
 	ignoreWarnings := true
-	err = cp.Load(model, freq, ignoreWarnings)
+	err := cp.Load(model, freq, ignoreWarnings)
 	if err != nil {
 		return nil, err
 	}
 
+	if parseMode == codeplug.ParseLenient {
+		for _, w := range cp.ParseWarnings() {
+			errorf("warning: %s\n", w)
+		}
+	}
+
+	cp.SetLockOverride(overrideLocks)
+	if auditAuthor != "" {
+		cp.SetAuditor(auditAuthor, "dmrRadio "+version)
+	}
+
+	if policies != nil {
+		err = cp.SetPolicies(policies)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rules != nil {
+		err = cp.SetRules(rules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if talkgroupNames != nil {
+		err = cp.SetTalkgroupNames(talkgroupNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if abbreviations != nil {
+		err = cp.SetAbbreviations(abbreviations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return cp, nil
 }
 
+// policyError returns an error listing the codeplug's violations of
+// the policies given by the -policies option and of the rules given by
+// the -rules option, if any.
+func policyError(cp *codeplug.Codeplug) error {
+	var msgs []string
+
+	violations := cp.PolicyViolations()
+	if len(violations) != 0 {
+		strs := make([]string, len(violations))
+		for i, v := range violations {
+			strs[i] = v.String()
+		}
+		msgs = append(msgs, fmt.Sprintf("policy violations (fix them with checkPolicies -fix):\n%s", strings.Join(strs, "\n")))
+	}
+
+	ruleViolations := cp.RuleViolations()
+	if len(ruleViolations) != 0 {
+		strs := make([]string, len(ruleViolations))
+		for i, v := range ruleViolations {
+			strs[i] = v.String()
+		}
+		msgs = append(msgs, fmt.Sprintf("rule violations:\n%s", strings.Join(strs, "\n")))
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(msgs, "\n"))
+}
+
 func progressFunc(aPrefixes []string) func(cur int) bool {
 	var prefixes []string
 	if aPrefixes != nil {
@@ -152,7 +327,7 @@ func readCodeplug() error {
 
 	modelFreqs := codeplug.AllFrequencyRanges()
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 1 {
 		flags.Usage()
@@ -195,351 +370,3859 @@ func readCodeplug() error {
 	return cp.SaveAs(filename, ignoreWarnings)
 }
 
-func writeCodeplug() error {
-	flags := flag.NewFlagSet("writeCodeplug", flag.ExitOnError)
+func newCodeplug() error {
+	var model string
+	var freq string
+	var radioID string
+
+	flags := flag.NewFlagSet("newCodeplug", flag.ExitOnError)
+	flags.StringVar(&model, "model", "", "<model name>")
+	flags.StringVar(&freq, "freq", "", "<frequency range>")
+	flags.StringVar(&radioID, "radioID", "", "<your DMR ID, looked up on radioid.net>")
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <codeplugFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s -model <modelName> -freq <freqRange> [-radioID <dmrID>] codePlugFilename\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
+		errorf("If -radioID is given, the radio ID, radio name and intro\n")
+		errorf("screen lines are filled in from its radioid.net registration.\n")
+		errorf("modelName must be chosen from the following list,\n")
+		errorf("and freqRange must be one of its associated values.\n")
+		models, freqs := allModelsFrequencyRanges()
+		for _, model := range models {
+			errorf("\t%s\n", model)
+			for _, freq := range freqs[model] {
+				errorf("\t\t%s\n", "\""+freq+"\"")
+			}
+		}
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 1 {
 		flags.Usage()
 	}
 	filename := args[0]
 
-	cp, err := loadCodeplug(codeplug.FileTypeNone, filename)
+	modelFreqs := codeplug.AllFrequencyRanges()
+	if modelFreqs[model] == nil {
+		errorf("bad modelName\n\n")
+		flags.Usage()
+	}
+	freqMap := make(map[string]bool)
+	for _, freq := range modelFreqs[model] {
+		freqMap[freq] = true
+	}
+	if !freqMap[freq] {
+		errorf("bad freqRange\n\n")
+		flags.Usage()
+	}
+
+	cp, err := codeplug.NewCodeplug(codeplug.FileTypeNew, "")
 	if err != nil {
 		return err
 	}
 
-	prefixes := []string{
-		"Preparing to write codeplug",
-		"Writing codeplug to radio.",
+	ignoreWarnings := true
+	err = cp.Load(model, freq, ignoreWarnings)
+	if err != nil {
+		return err
 	}
 
-	return cp.WriteRadio(progressFunc(prefixes))
-}
+	if radioID != "" {
+		identity, err := radioidIdentity(radioID)
+		if err != nil {
+			return err
+		}
 
-func dumpSPIFlash() (err error) {
-	flags := flag.NewFlagSet("dumpSPIFlash", flag.ExitOnError)
+		err = cp.SetIdentity(identity)
+		if err != nil {
+			return err
+		}
+	}
 
-	flags.Usage = func() {
-		errorf("Usage: %s %s <filename>\n", os.Args[0], os.Args[1])
-		flags.PrintDefaults()
-		os.Exit(1)
+	return cp.SaveAs(filename, ignoreWarnings)
+}
+
+// radioidIdentity returns the codeplug identity of the given DMR ID,
+// as registered at radioid.net.
+func radioidIdentity(radioID string) (codeplug.Identity, error) {
+	id, err := codeplug.ParseDmrID(radioID)
+	if err != nil {
+		return codeplug.Identity{}, fmt.Errorf("invalid DMR ID %q: %s", radioID, err.Error())
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
-	args := flags.Args()
-	if len(args) != 1 {
-		flags.Usage()
+	user, err := userdb.New().LookupUser(id)
+	if err != nil {
+		return codeplug.Identity{}, err
 	}
-	filename := args[0]
 
-	prefixes := []string{
-		"Preparing to dump flash",
-		"Dumping flash",
+	return codeplug.Identity{
+		RadioID:          user.ID,
+		RadioName:        user.Callsign,
+		IntroScreenLine1: user.Callsign,
+		IntroScreenLine2: user.FirstName(),
+	}, nil
+}
+
+// prompter asks the questions of an interactive subcommand on
+// standard output, and reads the answers from standard input.
+type prompter struct {
+	reader *bufio.Reader
+}
+
+// ask asks question and returns the answer, or def if the answer is
+// empty.
+func (p *prompter) ask(question string, def string) (string, error) {
+	if def != "" {
+		fmt.Printf("%s [%s]: ", question, def)
+	} else {
+		fmt.Printf("%s: ", question)
 	}
 
-	dfu, err := dfu.New(progressFunc(prefixes))
-	if err != nil {
-		return err
+	line, err := p.reader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", errors.New("no answer given")
 	}
-	defer dfu.Close()
 
-	file, err := os.Create(filename)
-	if err != nil {
-		return err
+	answer := strings.TrimSpace(line)
+	if answer == "" {
+		answer = def
 	}
-	defer func() {
-		cerr := file.Close()
-		if err == nil {
-			err = cerr
-		}
-	}()
 
-	return dfu.DumpSPIFlash(file)
+	return answer, nil
 }
 
-func dumpUsers() (err error) {
-	flags := flag.NewFlagSet("dumpUsers", flag.ExitOnError)
+// choose asks question until the answer is one of choices.
+func (p *prompter) choose(question string, choices []string, def string) (string, error) {
+	for {
+		answer, err := p.ask(question, def)
+		if err != nil {
+			return "", err
+		}
+		for _, choice := range choices {
+			if strings.EqualFold(answer, choice) {
+				return choice, nil
+			}
+		}
+		fmt.Printf("Please choose one of the above.\n")
+	}
+}
+
+func newCodeplugWizard() error {
+	flags := flag.NewFlagSet("newCodeplugWizard", flag.ExitOnError)
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <usersFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s <codeplugFilename>\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
+		errorf("Asks for the radio model, your DMR ID, a simplex region,\n")
+		errorf("local repeaters and a hotspot, and writes a starter codeplug.\n")
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 1 {
 		flags.Usage()
 	}
 	filename := args[0]
 
-	prefixes := []string{
-		"Preparing to dump users",
-		fmt.Sprintf("Dumping users to %s", filename),
-	}
+	p := &prompter{reader: bufio.NewReader(os.Stdin)}
+	var starter codeplug.Starter
 
-	dfu, err := dfu.New(progressFunc(prefixes))
+	models, freqs := allModelsFrequencyRanges()
+	for _, model := range models {
+		fmt.Printf("\t%s\n", model)
+	}
+	model, err := p.choose("Radio model", models, models[0])
 	if err != nil {
 		return err
 	}
-	defer dfu.Close()
-
-	file, err := os.Create(filename)
+	for _, freq := range freqs[model] {
+		fmt.Printf("\t%s\n", freq)
+	}
+	freq, err := p.choose("Frequency range", freqs[model], freqs[model][0])
 	if err != nil {
-		return fmt.Errorf("os.Create: %s", err.Error())
+		return err
 	}
-	defer func() {
-		cerr := file.Close()
+
+	for {
+		radioID, err := p.ask("Your DMR ID, to look up on radioid.net (empty to skip)", "")
+		if err != nil {
+			return err
+		}
+		if radioID == "" {
+			break
+		}
+		starter.Identity, err = radioidIdentity(radioID)
 		if err == nil {
-			err = cerr
+			fmt.Printf("Found %s.\n", starter.Identity.RadioName)
+			break
 		}
-	}()
+		errorf("%s\n", err.Error())
+	}
 
-	return dfu.DumpUsers(file)
-}
+	regionNames := []string{"none"}
+	for _, region := range codeplug.SimplexRegions() {
+		fmt.Printf("\t%s\t%s\n", region.Name, region.Description)
+		regionNames = append(regionNames, region.Name)
+	}
+	region, err := p.choose("Simplex region", regionNames, "none")
+	if err != nil {
+		return err
+	}
+	if region != "none" {
+		starter.SimplexRegion = region
+	}
 
-func writeUsers() error {
-	flags := flag.NewFlagSet("writeUsers", flag.ExitOnError)
+	for {
+		repeatersFilename, err := p.ask("RepeaterBook JSON file of local repeaters (empty to skip)", "")
+		if err != nil {
+			return err
+		}
+		if repeatersFilename == "" {
+			break
+		}
+		home, err := p.ask("Your location as latitude,longitude", "")
+		if err != nil {
+			return err
+		}
+		starter.Home, err = codeplug.ParseLocation(home)
+		if err != nil {
+			errorf("%s\n", err.Error())
+			continue
+		}
+		file, err := os.Open(repeatersFilename)
+		if err != nil {
+			errorf("%s\n", err.Error())
+			continue
+		}
+		starter.Repeaters, err = codeplug.ReadRepeaterBook(file)
+		file.Close()
+		if err != nil {
+			errorf("%s\n", err.Error())
+			continue
+		}
+		break
+	}
 
-	flags.Usage = func() {
-		errorf("Usage: %s %s <usersFilename>\n", os.Args[0], os.Args[1])
-		flags.PrintDefaults()
-		os.Exit(1)
+	for {
+		hotspotFreq, err := p.ask("Hotspot frequency in MHz (empty to skip)", "")
+		if err != nil {
+			return err
+		}
+		if hotspotFreq == "" {
+			break
+		}
+		hotspot := &codeplug.Hotspot{ColorCode: 1}
+		hotspot.RxFrequency, err = strconv.ParseFloat(hotspotFreq, 64)
+		if err != nil {
+			errorf("bad frequency: %s\n", hotspotFreq)
+			continue
+		}
+		talkgroups, err := p.ask("Hotspot talkgroups", "91:Worldwide,9990:Parrot:private")
+		if err != nil {
+			return err
+		}
+		hotspot.Talkgroups, err = codeplug.ParseTalkgroups(talkgroups)
+		if err != nil {
+			errorf("%s\n", err.Error())
+			continue
+		}
+		starter.Hotspot = hotspot
+		break
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
-	args := flags.Args()
-	if len(args) != 1 {
-		flags.Usage()
+	cp, err := codeplug.NewCodeplug(codeplug.FileTypeNew, "")
+	if err != nil {
+		return err
 	}
-	filename := args[0]
 
-	prefixes := []string{
-		"Erasing flash memory",
-		"Writing users",
+	ignoreWarnings := true
+	err = cp.Load(model, freq, ignoreWarnings)
+	if err != nil {
+		return err
 	}
 
-	dfu, err := dfu.New(progressFunc(prefixes))
+	err = cp.AddStarter(&starter)
 	if err != nil {
 		return err
 	}
-	defer dfu.Close()
 
-	return dfu.WriteUsers(filename)
+	return cp.SaveAs(filename, ignoreWarnings)
 }
 
-func getUsers() error {
-	flags := flag.NewFlagSet("getUsers", flag.ExitOnError)
+func writeCodeplug() error {
+	var records string
+	var devices string
+	var reboot bool
+	var force bool
+	var backupDir string
+	var noBackup bool
+	var keepBackups int
+
+	defaultBackupDir, _ := codeplug.DefaultRadioBackupDir()
+
+	flags := flag.NewFlagSet("writeCodeplug", flag.ExitOnError)
+	flags.StringVar(&records, "records", "", "<comma-separated record types>")
+	flags.StringVar(&devices, "devices", "", "<comma-separated USB ports> of radios, or \"all\"")
+	flags.BoolVar(&reboot, "reboot", false, "restart the radio after writing and wait for it to return")
+	flags.BoolVar(&force, "force", false, "write even if the radio's model or frequency range differs")
+	flags.StringVar(&backupDir, "backupDir", defaultBackupDir, "save the radio's codeplug in <dir> before writing")
+	flags.BoolVar(&noBackup, "noBackup", false, "do not save the radio's codeplug before writing")
+	flags.IntVar(&keepBackups, "keepBackups", codeplug.DefaultRadioBackupKeep, "keep only the <n> most recent backups, or all if 0")
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <usersFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s [-records <recordTypes> | -devices <ports>] [-reboot] [-force] [-backupDir <dir> | -noBackup] [-keepBackups <n>] <codeplugFilename>\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
+		errorf("If -records is given, only those record types are written,\n")
+		errorf("e.g. -records Contacts,GroupLists\n")
+		errorf("If -devices is given, the radios at those USB ports, as shown\n")
+		errorf("by listRadios, are written in parallel, e.g. -devices all\n")
+		errorf("Unless -noBackup is given, the codeplug in each radio is first\n")
+		errorf("saved in a timestamped file in the backup directory\n")
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 1 {
 		flags.Usage()
 	}
 	filename := args[0]
 
-	prefixes := []string{
-		"Retrieving Users file",
+	cp, err := loadCodeplug(codeplug.FileTypeNone, filename)
+	if err != nil {
+		return err
 	}
 
-	return userdb.WriteMD380ToolsFile(filename, progressFunc(prefixes))
-}
+	err = policyError(cp)
+	if err != nil {
+		return err
+	}
 
-func writeFirmware() error {
-	flags := flag.NewFlagSet("writeFirmware", flag.ExitOnError)
+	if noBackup {
+		backupDir = ""
+	}
+	err = cp.SetRadioBackup(backupDir, keepBackups)
+	if err != nil {
+		return err
+	}
 
-	flags.Usage = func() {
-		errorf("Usage: %s %s <firmwareFilename>\n", os.Args[0], os.Args[1])
-		flags.PrintDefaults()
-		os.Exit(1)
+	prefixes := []string{
+		"Preparing to write codeplug",
+		"Writing codeplug to radio.",
+	}
+	if backupDir != "" {
+		prefixes = []string{
+			"Preparing to write codeplug",
+			"Backing up codeplug from radio.",
+			"Writing codeplug to radio.",
+		}
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
-	args := flags.Args()
-	if len(args) != 1 {
-		flags.Usage()
+	if reboot {
+		cp.SetRebootAfterWrite(codeplug.DefaultRebootTimeout)
 	}
-	filename := args[0]
+	cp.SetIgnoreModelMismatch(force)
 
-	prefixes := []string{
-		"Erasing flash memory",
-		"Writing firmware",
+	if devices != "" {
+		if records != "" {
+			return errors.New("-records and -devices may not be given together")
+		}
+		return writeRadios(cp, devices)
 	}
 
-	dfu, err := dfu.New(progressFunc(prefixes))
-	if err != nil {
-		return err
+	if records != "" {
+		rTypes, err := recordTypes(cp, records)
+		if err != nil {
+			return err
+		}
+		err = cp.WriteRadioRecords(rTypes, progressFunc(prefixes))
+	} else {
+		err = cp.WriteRadio(progressFunc(prefixes))
+	}
+	if backupDir != "" && (err == nil || err == codeplug.ErrNoReboot) {
+		fmt.Println()
+		fmt.Printf("The radio's previous codeplug was saved in %s\n", backupDir)
+	}
+	if err == codeplug.ErrNoReboot {
+		errorf("%s\n", err.Error())
+		return nil
+	}
+	if _, ok := err.(*codeplug.ModelMismatchError); ok {
+		return fmt.Errorf("%s\nUse -force to write it anyway", err.Error())
 	}
-	defer dfu.Close()
 
-	return dfu.WriteFirmware(filename)
+	return err
 }
 
-func textToCodeplug() error {
-	flags := flag.NewFlagSet("textToCodeplug", flag.ExitOnError)
+// writeRadios writes cp to the radios at the comma-separated USB
+// ports, or to all connected radios.
+func writeRadios(cp *codeplug.Codeplug, ports string) error {
+	var devices []string
+	if ports == "all" {
+		var err error
+		devices, err = codeplug.RadioDevices()
+		if err != nil {
+			return err
+		}
+		if len(devices) == 0 {
+			return errors.New("no radios found")
+		}
+	} else {
+		for _, port := range strings.Split(ports, ",") {
+			devices = append(devices, strings.TrimSpace(port))
+		}
+	}
 
-	flags.Usage = func() {
-		errorf("Usage: %s %s <textFilename> <codeplugFilename>\n", os.Args[0], os.Args[1])
-		flags.PrintDefaults()
-		os.Exit(1)
+	errs, pruneErr := cp.WriteRadios(devices, multiProgressFunc(devices))
+	if errs == nil {
+		return pruneErr
 	}
+	fmt.Println()
 
-	flags.Parse(os.Args[2:len(os.Args)])
-	args := flags.Args()
-	if len(args) != 2 {
-		flags.Usage()
+	failed := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			fmt.Printf("%s: written\n", devices[i])
+		case err == codeplug.ErrNoReboot:
+			fmt.Printf("%s: written; %s\n", devices[i], err.Error())
+		default:
+			fmt.Printf("%s: failed: %s\n", devices[i], err.Error())
+			failed++
+		}
+	}
+	if dir := cp.RadioBackupDir(); dir != "" && failed != len(devices) {
+		fmt.Printf("The radios' previous codeplugs were saved in %s\n", dir)
+	}
+	if failed != 0 {
+		return fmt.Errorf("%d of %d radios failed", failed, len(devices))
+	}
+
+	return pruneErr
+}
+
+// multiProgressFunc returns a progress function that shows the
+// progress of writing to several radios on one line.
+func multiProgressFunc(devices []string) func(index, cur int) bool {
+	var mutex sync.Mutex
+	percents := make([]int, len(devices))
+	maxProgress := codeplug.MaxProgress
+	return func(index, cur int) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		percents[index] = cur * 100 / maxProgress
+		strs := make([]string, len(devices))
+		for i, device := range devices {
+			strs[i] = fmt.Sprintf("%s %3d%%", device, percents[i])
+		}
+		fmt.Printf("Writing codeplug to radios... %s\r", strings.Join(strs, "  "))
+		return true
+	}
+}
+
+func listRadios() error {
+	flags := flag.NewFlagSet("listRadios", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Lists the USB ports of the connected radios.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	if len(flags.Args()) != 0 {
+		flags.Usage()
+	}
+
+	devices, err := codeplug.RadioDevices()
+	if err != nil {
+		return err
+	}
+	for _, device := range devices {
+		if device == "" {
+			device = "(default)"
+		}
+		fmt.Println(device)
+	}
+
+	return nil
+}
+
+func recordTypes(cp *codeplug.Codeplug, names string) ([]codeplug.RecordType, error) {
+	var rTypes []codeplug.RecordType
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, rType := range cp.RecordTypes() {
+			if string(rType) == name {
+				rTypes = append(rTypes, rType)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown record type: %s", name)
+		}
+	}
+
+	return rTypes, nil
+}
+
+func dumpSPIFlash() (err error) {
+	flags := flag.NewFlagSet("dumpSPIFlash", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <filename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	prefixes := []string{
+		"Preparing to dump flash",
+		"Dumping flash",
+	}
+
+	dfu, err := dfu.New(progressFunc(prefixes))
+	if err != nil {
+		return err
+	}
+	defer dfu.Close()
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := file.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	return dfu.DumpSPIFlash(file)
+}
+
+func dumpCalibration() (err error) {
+	flags := flag.NewFlagSet("dumpCalibration", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <calibrationFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("The radio must be running md380tools firmware.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	prefixes := []string{
+		"Preparing to dump calibration",
+		fmt.Sprintf("Dumping calibration to %s", filename),
+	}
+
+	dfu, err := dfu.New(progressFunc(prefixes))
+	if err != nil {
+		return err
+	}
+	defer dfu.Close()
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := file.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	return dfu.DumpCalibration(file)
+}
+
+func writeCalibration() error {
+	var dangerous bool
+
+	flags := flag.NewFlagSet("writeCalibration", flag.ExitOnError)
+	flags.BoolVar(&dangerous, "dangerous", false, "confirm that the radio's calibration is to be replaced")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -dangerous <calibrationFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("DANGER: this replaces the radio's calibration with that written by\n")
+		errorf("dumpCalibration.  Calibration from another radio, or damaged\n")
+		errorf("calibration, leaves the radio off frequency or at the wrong power.\n")
+		errorf("The radio must be running md380tools firmware.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || !dangerous {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	prefixes := []string{
+		"Reading flash memory",
+		"Erasing flash memory",
+		"Writing calibration",
+	}
+
+	dfu, err := dfu.New(progressFunc(prefixes))
+	if err != nil {
+		return err
+	}
+	defer dfu.Close()
+
+	return dfu.WriteCalibration(filename)
+}
+
+func splitFlashDump() error {
+	var model string
+	var freq string
+	var codeplugFilename string
+	var usersFilename string
+
+	flags := flag.NewFlagSet("splitFlashDump", flag.ExitOnError)
+	flags.StringVar(&model, "model", "", "<model> of the radio dumped")
+	flags.StringVar(&freq, "freq", "", "<freqRange> of the radio dumped")
+	flags.StringVar(&codeplugFilename, "codeplug", "", "write the codeplug to <codeplugFilename>")
+	flags.StringVar(&usersFilename, "users", "", "write the md380tools users database to <usersFilename>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-model <model> -freq <freqRange>] [-codeplug <codeplugFilename>] [-users <usersFilename>] <dumpFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("dumpFilename is a raw SPI flash dump, as written by dumpSPIFlash\n")
+		errorf("or md380tools' flashdump.  A flash dump may also be given to\n")
+		errorf("other subcommands wherever a .bin codeplug file is accepted.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || (model == "") != (freq == "") {
+		flags.Usage()
+	}
+	if codeplugFilename == "" && usersFilename == "" {
+		return errors.New("-codeplug or -users must be given")
+	}
+	dumpFilename := args[0]
+
+	if usersFilename != "" {
+		dump, err := ioutil.ReadFile(dumpFilename)
+		if err != nil {
+			return err
+		}
+		users, err := codeplug.FlashDumpUsers(dump)
+		if err != nil {
+			return fmt.Errorf("%s: %s", dumpFilename, err.Error())
+		}
+		err = ioutil.WriteFile(usersFilename, users, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	if codeplugFilename == "" {
+		return nil
+	}
+
+	cp, err := codeplug.NewCodeplug(codeplug.FileTypeNone, dumpFilename)
+	if err != nil {
+		return err
+	}
+	if !cp.IsFlashDump() {
+		return fmt.Errorf("%s: not a flash dump", dumpFilename)
+	}
+
+	if model != "" {
+		ignoreWarnings := true
+		err = cp.Load(model, freq, ignoreWarnings)
+	} else {
+		cp, err = loadNewCodeplug(cp)
+	}
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, codeplugFilename)
+}
+
+func importCPSArchive() error {
+	var index int
+
+	flags := flag.NewFlagSet("importCPSArchive", flag.ExitOnError)
+	flags.IntVar(&index, "index", 0, "import the codeplug numbered <n> in the list")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-index <n>] <archiveFilename> [<codeplugFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("archiveFilename is a vendor CPS installer or zip archive.\n")
+		errorf("Without codeplugFilename, the codeplugs it holds are listed.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) < 1 || len(args) > 2 {
+		flags.Usage()
+	}
+	archiveFilename := args[0]
+
+	acs, err := codeplug.ReadArchiveCodeplugs(archiveFilename)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		for i, ac := range acs {
+			models := ""
+			cp, err := codeplug.NewCodeplugFromArchive(ac)
+			if err == nil {
+				m, _ := cp.ModelsFrequencyRanges()
+				models = strings.Join(m, ", ")
+			}
+			fmt.Printf("%d: %s (%s)\n", i+1, ac.Name, models)
+		}
+		return nil
 	}
-	textFilename := args[0]
 	codeplugFilename := args[1]
 
-	cp, err := loadCodeplug(codeplug.FileTypeText, textFilename)
+	if index == 0 {
+		if len(acs) > 1 {
+			return fmt.Errorf("%s holds %d codeplugs, -index must be given", archiveFilename, len(acs))
+		}
+		index = 1
+	}
+	if index < 1 || index > len(acs) {
+		return fmt.Errorf("-index must be between 1 and %d", len(acs))
+	}
+
+	cp, err := codeplug.NewCodeplugFromArchive(acs[index-1])
+	if err != nil {
+		return err
+	}
+
+	cp, err = loadNewCodeplug(cp)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, codeplugFilename)
+}
+
+func dumpUsers() (err error) {
+	flags := flag.NewFlagSet("dumpUsers", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <usersFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	prefixes := []string{
+		"Preparing to dump users",
+		fmt.Sprintf("Dumping users to %s", filename),
+	}
+
+	dfu, err := dfu.New(progressFunc(prefixes))
+	if err != nil {
+		return err
+	}
+	defer dfu.Close()
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("os.Create: %s", err.Error())
+	}
+	defer func() {
+		cerr := file.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	return dfu.DumpUsers(file)
+}
+
+func writeUsers() error {
+	flags := flag.NewFlagSet("writeUsers", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <usersFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	prefixes := []string{
+		"Erasing flash memory",
+		"Writing users",
+	}
+
+	dfu, err := dfu.New(progressFunc(prefixes))
+	if err != nil {
+		return err
+	}
+	defer dfu.Close()
+
+	return dfu.WriteUsers(filename)
+}
+
+func getUsers() error {
+	var states string
+	var countries string
+	var titleCase bool
+	var elide bool
+	var lastHeard string
+	var activeMonths int
+	var regions string
+	var countryFilter string
+	var custom stringsFlag
+	var codeplugs stringsFlag
+	var blocklist string
+	var warnings bool
+	var spillDir string
+	var stagingDir string
+	var resolver string
+	var preferIPv4 bool
+	var dialTimeout int
+	var callsigns string
+	var marcMirrors stringsFlag
+	var recordDir string
+	var replayDir string
+
+	flags := flag.NewFlagSet("getUsers", flag.ExitOnError)
+	flags.StringVar(&states, "states", "", "write US and Canadian states as <abbrev|name>")
+	flags.StringVar(&countries, "countries", "", "write countries as canonical <name> or <iso> code")
+	flags.BoolVar(&titleCase, "titleCase", false, "write all-caps names and cities in title case")
+	flags.BoolVar(&elide, "elide", false, "omit fields repeated from the previous user (compressed md380tools database)")
+	flags.StringVar(&lastHeard, "lastHeard", "", "<filename or URL> of a CSV log of id,time last heard")
+	flags.IntVar(&activeMonths, "activeMonths", 0, "drop users not heard in the last <months>, per -lastHeard")
+	flags.StringVar(&regions, "regions", "", "keep only users in <region,...>: "+strings.Join(userdb.Regions(), ", "))
+	flags.StringVar(&countryFilter, "only", "", "keep only users in <country,...>")
+	flags.Var(&custom, "custom", "<csvFilename> of local users (id,callsign,name,city,state,country) to add")
+	flags.Var(&codeplugs, "codeplug", "<codeplugFilename> whose contacts name talkgroups and alias users")
+	flags.StringVar(&blocklist, "blocklist", "", "<filename> of IDs and callsigns to exclude, one per line")
+	flags.BoolVar(&warnings, "warnings", false, "report skipped malformed records")
+	flags.StringVar(&spillDir, "spill", "", "limit memory use by sorting users in temporary files in <dir>")
+	flags.StringVar(&stagingDir, "staging", "", "save each source in <dir> so an interrupted run can resume")
+	flags.StringVar(&resolver, "resolver", "", "look up source hosts with DNS <server[:port]> or DNS-over-HTTPS <https://URL>")
+	flags.BoolVar(&preferIPv4, "ipv4", false, "connect to sources over IPv4 before trying IPv6")
+	flags.IntVar(&dialTimeout, "dialTimeout", 0, "give up each connection attempt after <seconds>")
+	flags.StringVar(&callsigns, "callsigns", "", "<warn> of, or <drop>, users with invalid callsigns")
+	flags.Var(&marcMirrors, "marcMirror", "<URL> of a DMR-MARC format users.csv to use if radioid.net fails")
+	flags.StringVar(&recordDir, "record", "", "record the raw source responses in <dir>")
+	flags.StringVar(&replayDir, "replay", "", "replay the source responses recorded in <dir> instead of downloading")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-states <abbrev|name>] [-countries <name|iso>] [-titleCase] [-elide] [-lastHeard <log> -activeMonths <months>] [-regions <regions>] [-only <countries>] [-custom <csvFilename>]... [-codeplug <codeplugFilename>]... [-blocklist <filename>] [-warnings] [-spill <dir>] [-staging <dir>] [-resolver <server>] [-ipv4] [-dialTimeout <seconds>] [-callsigns <warn|drop>] [-marcMirror <URL>]... [-record <dir> | -replay <dir>] <usersFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	db := userdb.New()
+
+	stateStyle, err := userdb.ParseStateStyle(states)
+	if err != nil {
+		return err
+	}
+	db.SetStateStyle(stateStyle)
+
+	countryStyle, err := userdb.ParseCountryStyle(countries)
+	if err != nil {
+		return err
+	}
+	db.SetCountryStyle(countryStyle)
+
+	callsignCheck, err := userdb.ParseCallsignCheck(callsigns)
+	if err != nil {
+		return err
+	}
+	db.SetCallsignCheck(callsignCheck)
+	db.SetMarcFallbackURLs(marcMirrors...)
+
+	switch {
+	case recordDir != "" && replayDir != "":
+		return errors.New("-record and -replay may not be given together")
+	case recordDir != "":
+		db.SetRecording(recordDir, false)
+	case replayDir != "":
+		db.SetRecording(replayDir, true)
+	}
+	db.SetTitleCase(titleCase)
+	db.SetElideRepeatedFields(elide)
+	db.SetSpillDir(spillDir)
+	db.SetStagingDir(stagingDir)
+
+	err = db.SetResolver(resolver)
+	if err != nil {
+		return err
+	}
+	db.SetDialOptions(time.Duration(dialTimeout)*time.Second, preferIPv4)
+
+	if regions != "" {
+		err := db.SetRegionFilter(strings.Split(regions, ",")...)
+		if err != nil {
+			return err
+		}
+	}
+	if countryFilter != "" {
+		db.SetCountryFilter(strings.Split(countryFilter, ",")...)
+	}
+
+	for _, filename := range custom {
+		file, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		users, err := userdb.ReadUsersCSV(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %s", filename, err.Error())
+		}
+		db.AddCustomUsers(users)
+	}
+
+	for _, filename := range codeplugs {
+		cp, err := loadCodeplugFile(filename)
+		if err != nil {
+			return err
+		}
+		db.AddCustomUsers(contactUsers(cp))
+	}
+
+	if blocklist != "" {
+		file, err := os.Open(blocklist)
+		if err != nil {
+			return err
+		}
+		entries, err := userdb.ReadBlocklist(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %s", blocklist, err.Error())
+		}
+		db.SetBlocklist(entries)
+	}
+
+	if (lastHeard == "") != (activeMonths == 0) {
+		return errors.New("-lastHeard and -activeMonths must be given together")
+	}
+	if activeMonths != 0 {
+		db.SetActivityFilter(lastHeard, time.Now().AddDate(0, -activeMonths, 0))
+	}
+
+	prefixes := []string{
+		"Retrieving Users file",
+	}
+
+	err = db.WriteMD380ToolsFile(filename, progressFunc(prefixes))
+	if err != nil {
+		return err
+	}
+
+	if blocklist != "" {
+		errorf("%d blocked users removed\n", db.Blocked())
+	}
+	if warnings || callsignCheck == userdb.CallsignsWarn {
+		for _, w := range db.Warnings() {
+			errorf("%s\n", w.String())
+		}
+	}
+
+	return nil
+}
+
+// contactUsers returns the users database extras for the codeplug's
+// contacts.
+func contactUsers(cp *codeplug.Codeplug) []*userdb.User {
+	var contacts []userdb.Contact
+	for _, tg := range cp.Contacts() {
+		contacts = append(contacts, userdb.Contact{
+			ID:    tg.ID,
+			Name:  tg.Name,
+			Group: !tg.Private,
+		})
+	}
+
+	return userdb.ContactUsers(contacts)
+}
+
+func contactsToUsers() error {
+	flags := flag.NewFlagSet("contactsToUsers", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <csvFilename>\n", os.Args[0], os.Args[1])
+		errorf("Writes the codeplug's contacts as md380tools users database\n")
+		errorf("extras: talkgroup names and private contact aliases, for use\n")
+		errorf("with getUsers -custom.\n")
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(args[1])
+	if err != nil {
+		return err
+	}
+
+	err = userdb.WriteUsersCSV(file, contactUsers(cp))
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func writeFirmware() error {
+	flags := flag.NewFlagSet("writeFirmware", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <firmwareFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	prefixes := []string{
+		"Erasing flash memory",
+		"Writing firmware",
+	}
+
+	dfu, err := dfu.New(progressFunc(prefixes))
+	if err != nil {
+		return err
+	}
+	defer dfu.Close()
+
+	return dfu.WriteFirmware(filename)
+}
+
+func textToCodeplug() error {
+	flags := flag.NewFlagSet("textToCodeplug", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <textFilename> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	textFilename := args[0]
+	codeplugFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeText, textFilename)
+	if err != nil {
+		return err
+	}
+
+	err = policyError(cp)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.SaveAs(codeplugFilename, ignoreWarnings)
+}
+
+func codeplugToText() error {
+	flags := flag.NewFlagSet("codeplugToText", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <textFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	codeplugFilename := args[0]
+	textFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
+	if err != nil {
+		return err
+	}
+
+	return cp.ExportText(textFilename)
+}
+
+func jsonToCodeplug() error {
+	flags := flag.NewFlagSet("jsonToCodeplug", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <jsonFilename> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	jsonFilename := args[0]
+	codeplugFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeJSON, jsonFilename)
+	if err != nil {
+		return err
+	}
+
+	err = policyError(cp)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.SaveAs(codeplugFilename, ignoreWarnings)
+}
+
+func codeplugToJSON() error {
+	flags := flag.NewFlagSet("codeplugToJSON", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <jsonFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	codeplugFilename := args[0]
+	jsonFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
+	if err != nil {
+		return err
+	}
+
+	return cp.ExportJSON(jsonFilename)
+}
+
+func xlsxToCodeplug() error {
+	flags := flag.NewFlagSet("xlsxToCodeplug", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <xlsxFilename> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	xlsxFilename := args[0]
+	codeplugFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeXLSX, xlsxFilename)
+	if err != nil {
+		return err
+	}
+
+	err = policyError(cp)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.SaveAs(codeplugFilename, ignoreWarnings)
+}
+
+func codeplugToXLSX() error {
+	flags := flag.NewFlagSet("codeplugToXLSX", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <xlsxFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	codeplugFilename := args[0]
+	xlsxFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
+	if err != nil {
+		return err
+	}
+
+	return cp.ExportXLSX(xlsxFilename)
+}
+
+func codeplugToHTML() error {
+	flags := flag.NewFlagSet("codeplugToHTML", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <htmlFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Writes a printable cheat sheet of the zones, channels and contacts.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	codeplugFilename := args[0]
+	htmlFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
+	if err != nil {
+		return err
+	}
+
+	return cp.ExportHTML(htmlFilename)
+}
+
+func codeplugToMarkdown() error {
+	flags := flag.NewFlagSet("codeplugToMarkdown", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <markdownFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("A markdownFilename of - writes to standard output.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	codeplugFilename := args[0]
+	markdownFilename := args[1]
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
+	if err != nil {
+		return err
+	}
+
+	if markdownFilename == "-" {
+		return cp.WriteMarkdown(os.Stdout)
+	}
+
+	return cp.ExportMarkdown(markdownFilename)
+}
+
+func codeplugToOpenRTX() error {
+	flags := flag.NewFlagSet("codeplugToOpenRTX", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <jsonFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Analog channels tagged %s are written as M17 channels, with\n", codeplug.OpenRTXTag)
+		errorf("tags m17-can-<n> and m17-dst-<callsign> giving their channel\n")
+		errorf("access number and destination.\n")
+		errorf("A jsonFilename of - writes to standard output.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	codeplugFilename := args[0]
+	jsonFilename := args[1]
+
+	cp, err := loadCodeplugFile(codeplugFilename)
+	if err != nil {
+		return err
+	}
+
+	if jsonFilename == "-" {
+		return cp.WriteOpenRTX(os.Stdout)
+	}
+
+	return cp.ExportOpenRTX(jsonFilename)
+}
+
+func addHotspot() error {
+	var hotspot codeplug.Hotspot
+	var talkgroups string
+
+	flags := flag.NewFlagSet("addHotspot", flag.ExitOnError)
+	flags.StringVar(&hotspot.Name, "name", "Hotspot", "<zone and RX group list name>")
+	flags.Float64Var(&hotspot.RxFrequency, "freq", 0, "<radio receive frequency in MHz>")
+	flags.Float64Var(&hotspot.TxFrequency, "txfreq", 0, "<radio transmit frequency in MHz, if not simplex>")
+	flags.IntVar(&hotspot.ColorCode, "cc", 1, "<color code>")
+	flags.IntVar(&hotspot.Slot, "slot", 2, "<time slot>")
+	flags.StringVar(&talkgroups, "talkgroups", "", "<id[:name[:private]],...>")
+	flags.StringVar(&hotspot.ChannelNameTemplate, "channelName", "{tg.name}", "<channel name template>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -freq <MHz> -cc <colorCode> -talkgroups <talkgroups> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("talkgroups is a comma-separated list, e.g. 91:Worldwide,9990:Parrot:private\n")
+		errorf("channelName may use {hotspot}, {tg.name}, {tg.id}, {slot} and {cc}\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || hotspot.RxFrequency == 0 || talkgroups == "" {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	tgs, err := codeplug.ParseTalkgroups(talkgroups)
+	if err != nil {
+		return err
+	}
+	hotspot.Talkgroups = tgs
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, filename)
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddHotspot(&hotspot)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.Save(ignoreWarnings)
+}
+
+func brandmeisterClient(key string) (*brandmeister.Client, error) {
+	if key == "" {
+		key = os.Getenv(brandmeister.APIKeyEnv)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("no Brandmeister API key: use -key or set %s", brandmeister.APIKeyEnv)
+	}
+
+	return brandmeister.NewClient(key), nil
+}
+
+func brandmeisterDevices() error {
+	var key string
+
+	flags := flag.NewFlagSet("brandmeisterDevices", flag.ExitOnError)
+	flags.StringVar(&key, "key", "", "<Brandmeister API key>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-key <apiKey>] <callsign>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("The API key is taken from $%s if -key is not given.\n", brandmeister.APIKeyEnv)
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+	callsign := args[0]
+
+	client, err := brandmeisterClient(key)
+	if err != nil {
+		return err
+	}
+
+	devices, err := client.Devices(callsign)
+	if err != nil {
+		return err
+	}
+	if len(devices) == 0 {
+		return fmt.Errorf("no Brandmeister devices for %s", callsign)
+	}
+
+	for _, d := range devices {
+		tgs, err := client.StaticTalkgroups(d.ID)
+		if err != nil {
+			return err
+		}
+		strs := make([]string, len(tgs))
+		for i, tg := range tgs {
+			strs[i] = fmt.Sprint(tg.ID)
+			if tg.Slot != 0 {
+				strs[i] += fmt.Sprintf("/TS%d", tg.Slot)
+			}
+		}
+		fmt.Printf("%d\t%s\ttx %.5f rx %.5f cc %d\t%s\n", d.ID, d.Callsign, d.TxFrequency, d.RxFrequency, d.ColorCode, strings.Join(strs, ","))
+	}
+
+	return nil
+}
+
+func addBrandmeisterDevice() error {
+	var key string
+	var deviceID int
+	var name string
+	var slot int
+	var channelTemplate string
+
+	flags := flag.NewFlagSet("addBrandmeisterDevice", flag.ExitOnError)
+	flags.StringVar(&key, "key", "", "<Brandmeister API key>")
+	flags.IntVar(&deviceID, "device", 0, "<Brandmeister device ID>")
+	flags.StringVar(&name, "name", "", "<zone and RX group list name, the device's callsign by default>")
+	flags.IntVar(&slot, "slot", 2, "<time slot of talkgroups not tied to one>")
+	flags.StringVar(&channelTemplate, "channelName", "{tg.name}", "<channel name template>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-key <apiKey>] -device <deviceID> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("The API key is taken from $%s if -key is not given.\n", brandmeister.APIKeyEnv)
+		errorf("channelName may use {hotspot}, {tg.name}, {tg.id}, {slot} and {cc}\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || deviceID == 0 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	client, err := brandmeisterClient(key)
+	if err != nil {
+		return err
+	}
+
+	names, err := client.TalkgroupNames()
+	if err != nil {
+		return err
+	}
+
+	hotspot, err := client.Hotspot(deviceID, names)
+	if err != nil {
+		return err
+	}
+	if name != "" {
+		hotspot.Name = name
+	}
+	hotspot.Slot = slot
+	hotspot.ChannelNameTemplate = channelTemplate
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, filename)
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddHotspot(hotspot)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.Save(ignoreWarnings)
+}
+
+func planTalkgroups() error {
+	var talkgroups string
+	var sitesFilename string
+	var devices string
+	var key string
+
+	flags := flag.NewFlagSet("planTalkgroups", flag.ExitOnError)
+	flags.StringVar(&talkgroups, "talkgroups", "", "<id[:name[:private]],...>")
+	flags.StringVar(&sitesFilename, "sites", "", "<JSON file of repeaters and hotspots>")
+	flags.StringVar(&devices, "devices", "", "<Brandmeister device IDs, comma-separated>")
+	flags.StringVar(&key, "key", "", "<Brandmeister API key>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -talkgroups <talkgroups> [-sites <sitesFilename>] [-devices <deviceIDs>] [-key <apiKey>] [<codeplugFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Prints the fewest sites needed to reach the talkgroups, and any\n")
+		errorf("talkgroups none of them carry.  If a codeplug is given, the\n")
+		errorf("channels, RX group lists and zones of the plan are added to it.\n")
+		errorf("Sites are read from the JSON file, with the static talkgroups of\n")
+		errorf("the Brandmeister devices added, and are preferred in that order.\n")
+		errorf("The API key is taken from $%s if -key is not given.\n", brandmeister.APIKeyEnv)
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) > 1 || talkgroups == "" || (sitesFilename == "" && devices == "") {
+		flags.Usage()
+	}
+
+	wanted, err := codeplug.ParseTalkgroups(talkgroups)
+	if err != nil {
+		return err
+	}
+
+	var sites []codeplug.Site
+	if sitesFilename != "" {
+		file, err := os.Open(sitesFilename)
+		if err != nil {
+			return err
+		}
+		sites, err = codeplug.ReadSites(file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if devices != "" {
+		client, err := brandmeisterClient(key)
+		if err != nil {
+			return err
+		}
+
+		names, err := client.TalkgroupNames()
+		if err != nil {
+			return err
+		}
+
+		for _, str := range strings.Split(devices, ",") {
+			id, err := strconv.Atoi(strings.TrimSpace(str))
+			if err != nil {
+				return fmt.Errorf("bad device ID: %s", str)
+			}
+			hotspot, err := client.Hotspot(id, names)
+			if err != nil {
+				return err
+			}
+			sites = append(sites, codeplug.Site{Hotspot: *hotspot})
+		}
+	}
+
+	plan := codeplug.PlanTalkgroups(wanted, sites)
+	fmt.Print(plan)
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, args[0])
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddTalkgroupPlan(plan)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.Save(ignoreWarnings)
+}
+
+func addSimplex() error {
+	var regionName string
+
+	flags := flag.NewFlagSet("addSimplex", flag.ExitOnError)
+	flags.StringVar(&regionName, "region", "", "<region>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -region <region> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("region must be chosen from the following list:\n")
+		for _, region := range codeplug.SimplexRegions() {
+			errorf("\t%s\t%s\n", region.Name, region.Description)
+		}
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || regionName == "" {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	region, err := codeplug.LookupSimplexRegion(regionName)
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, filename)
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddSimplexChannels(region)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.Save(ignoreWarnings)
+}
+
+func addParrot() error {
+	var parrot codeplug.Parrot
+
+	flags := flag.NewFlagSet("addParrot", flag.ExitOnError)
+	flags.Float64Var(&parrot.RxFrequency, "freq", 0, "<radio receive frequency in MHz>")
+	flags.Float64Var(&parrot.TxFrequency, "txfreq", 0, "<radio transmit frequency in MHz, if not simplex>")
+	flags.IntVar(&parrot.ColorCode, "cc", 1, "<color code>")
+	flags.IntVar(&parrot.Slot, "slot", 2, "<time slot>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -freq <MHz> -cc <colorCode> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || parrot.RxFrequency == 0 {
+		flags.Usage()
+	}
+	filename := args[0]
+
+	cp, err := loadCodeplug(codeplug.FileTypeNone, filename)
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddParrotChannel(&parrot)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.Save(ignoreWarnings)
+}
+
+// loadCodeplugFile loads a codeplug, text, JSON or spreadsheet file,
+// possibly gzip-compressed, detecting the file type from its contents.
+func loadCodeplugFile(filename string) (*codeplug.Codeplug, error) {
+	cp, err := codeplug.NewCodeplugFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadNewCodeplug(cp)
+}
+
+// saveCodeplugFile saves the codeplug as a codeplug, text, JSON or
+// spreadsheet file, or a gzip-compressed codeplug, text or JSON file,
+// choosing the file type by the filename's extension.
+func saveCodeplugFile(cp *codeplug.Codeplug, filename string) error {
+	err := policyError(cp)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".txt":
+		return cp.ExportText(filename)
+	case ".json":
+		return cp.ExportJSON(filename)
+	case ".xlsx":
+		return cp.ExportXLSX(filename)
+	case ".gz":
+		return cp.ExportFile(filename)
+	}
+
+	ignoreWarnings := true
+	return cp.SaveAs(filename, ignoreWarnings)
+}
+
+// locatorFlags adds the -from and -route flags to flags, returning a
+// function that returns the Locator they describe.
+func locatorFlags(flags *flag.FlagSet) func() (codeplug.Locator, error) {
+	var from string
+	var route string
+
+	flags.StringVar(&from, "from", "", "<latitude,longitude>")
+	flags.StringVar(&route, "route", "", "<latitude,longitude;latitude,longitude;...>")
+
+	return func() (codeplug.Locator, error) {
+		switch {
+		case from != "" && route != "":
+			return nil, errors.New("only one of -from and -route may be given")
+		case from != "":
+			return codeplug.ParseLocation(from)
+		case route != "":
+			return codeplug.ParseRoute(route)
+		}
+		return nil, errors.New("one of -from or -route is required")
+	}
+}
+
+func sortChannels() error {
+	flags := flag.NewFlagSet("sortChannels", flag.ExitOnError)
+	locator := locatorFlags(flags)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -from <lat,lon> | -route <lat,lon;...> <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Channel locations are kept only in text and JSON files.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	ref, err := locator()
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	cp.SortChannelsByDistance(ref)
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func addDistanceZone() error {
+	var name string
+	var radius float64
+
+	flags := flag.NewFlagSet("addDistanceZone", flag.ExitOnError)
+	locator := locatorFlags(flags)
+	flags.StringVar(&name, "name", "Nearby", "<zone name template>")
+	flags.Float64Var(&radius, "radius", 50, "<maximum distance in km>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -from <lat,lon> | -route <lat,lon;...> -radius <km> <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Channel locations are kept only in text and JSON files.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	ref, err := locator()
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddDistanceZones(name, ref, radius)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func addTravelZones() error {
+	var gpxFilename string
+	var routeString string
+	var repeatersFilename string
+	var opts codeplug.TravelOptions
+
+	flags := flag.NewFlagSet("addTravelZones", flag.ExitOnError)
+	flags.StringVar(&gpxFilename, "gpx", "", "<GPX route filename>")
+	flags.StringVar(&routeString, "route", "", "<latitude,longitude;latitude,longitude;...>")
+	flags.StringVar(&repeatersFilename, "repeaters", "", "<RepeaterBook JSON filename>")
+	flags.Float64Var(&opts.Radius, "radius", 30, "<maximum distance from the route in km>")
+	flags.BoolVar(&opts.Analog, "analog", false, "include analog repeaters")
+	flags.BoolVar(&opts.DMR, "dmr", false, "include DMR repeaters")
+	flags.StringVar(&opts.ZoneNameTemplate, "zoneName", "Leg {leg}", "<zone name template>")
+	flags.StringVar(&opts.ChannelNameTemplate, "channelName", "{callsign} {city}", "<channel name template>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -gpx <gpxFilename> | -route <lat,lon;...> -repeaters <repeaterBookFilename> <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("If neither -analog nor -dmr is given, both are included.\n")
+		errorf("zoneName may use {leg}, {from} and {to}.\n")
+		errorf("channelName may use {callsign}, {city} and {freq}.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 || repeatersFilename == "" || (gpxFilename == "") == (routeString == "") {
+		flags.Usage()
+	}
+
+	var waypoints []codeplug.Waypoint
+	if gpxFilename != "" {
+		file, err := os.Open(gpxFilename)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		waypoints, err = codeplug.ReadGPX(file)
+		if err != nil {
+			return err
+		}
+	} else {
+		route, err := codeplug.ParseRoute(routeString)
+		if err != nil {
+			return err
+		}
+		for _, loc := range route {
+			waypoints = append(waypoints, codeplug.Waypoint{Location: loc})
+		}
+	}
+
+	file, err := os.Open(repeatersFilename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	repeaters, err := codeplug.ReadRepeaterBook(file)
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddTravelZones(waypoints, repeaters, opts)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func addPastedRepeaters() error {
+	var listingFilename string
+	var channelTemplate string
+	var talkgroup string
+	var yes bool
+
+	flags := flag.NewFlagSet("addPastedRepeaters", flag.ExitOnError)
+	flags.StringVar(&listingFilename, "listing", "", "read the listing from <filename> rather than standard input")
+	flags.StringVar(&channelTemplate, "channelName", "{callsign} {city}", "<channel name template>")
+	flags.StringVar(&talkgroup, "talkgroup", "9:Local", "<id[:name]> used by DMR channels in time slot 2")
+	flags.BoolVar(&yes, "yes", false, "add every repeater without asking")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-listing <filename>] [-channelName <template>] [-talkgroup <id:name>] [-yes] <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Adds channels for the repeaters in text pasted from RepeaterBook\n")
+		errorf("or Brandmeister pages, or lines such as \"439.9875 -9.4 CC1\",\n")
+		errorf("asking to confirm or correct each before it is added.\n")
+		errorf("channelName may use {callsign}, {city} and {freq}.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	tg, err := codeplug.ParseTalkgroup(talkgroup)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	p := &prompter{reader: reader}
+
+	var listing string
+	if listingFilename != "" {
+		bytes, err := ioutil.ReadFile(listingFilename)
+		if err != nil {
+			return err
+		}
+		listing = string(bytes)
+	} else {
+		fmt.Printf("Paste the listing, followed by a line holding only a period:\n")
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			if strings.TrimSpace(line) == "." || err != nil && line == "" {
+				break
+			}
+			lines = append(lines, line)
+		}
+		listing = strings.Join(lines, "")
+	}
+
+	repeaters, err := codeplug.ParseRepeaterListing(listing)
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	added := 0
+	for i := 0; i < len(repeaters); i++ {
+		rptr := &repeaters[i]
+
+		name, err := cp.RepeaterChannelName(rptr, channelTemplate)
+		if err != nil {
+			return err
+		}
+
+		if !yes {
+			fmt.Printf("%s\n", rptr.String())
+			answer, err := p.choose("Add it? (yes, no, edit, quit)", []string{"yes", "no", "edit", "quit"}, "yes")
+			if err != nil {
+				return err
+			}
+			switch answer {
+			case "no":
+				continue
+			case "quit":
+				i = len(repeaters)
+				continue
+			case "edit":
+				line, err := p.ask("Repeater", rptr.String())
+				if err != nil {
+					return err
+				}
+				edited, err := codeplug.ParseRepeaterListing(line)
+				if err != nil {
+					errorf("%s\n", err.Error())
+				} else {
+					*rptr = edited[0]
+				}
+				i--
+				continue
+			}
+
+			name, err = p.ask("Channel name", name)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = cp.AddRepeaterChannel(rptr, name, tg)
+		if err != nil {
+			if yes {
+				return err
+			}
+			errorf("%s\n", err.Error())
+			continue
+		}
+		added++
+	}
+
+	fmt.Printf("%d channels added\n", added)
+	if added == 0 {
+		return nil
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func syncScanLists() error {
+	var assign bool
+
+	flags := flag.NewFlagSet("syncScanLists", flag.ExitOnError)
+	flags.BoolVar(&assign, "assign", false, "give channels without a scan list their zone's scan list")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-assign] <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Each zone gets a scan list of the same name holding its channels.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	sync, err := cp.SyncScanListsWithZones(assign)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sync.Truncated {
+		errorf("scan list '%s' holds only the first channels of its zone\n", name)
+	}
+	for _, name := range sync.Locked {
+		errorf("scan list '%s' is locked\n", name)
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func optimizeGroupLists() error {
+	flags := flag.NewFlagSet("optimizeGroupLists", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <inFilename> [<outFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Without outFilename, the proposed RX group lists are only reported.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 && len(args) != 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		a, err := cp.AnalyzeGroupLists()
+		if err != nil {
+			return err
+		}
+		fmt.Print(a.String())
+		return nil
+	}
+
+	a, err := cp.OptimizeGroupLists()
+	if err != nil {
+		return err
+	}
+	fmt.Print(a.String())
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func talkgroupUsage() error {
+	var removeUnused bool
+	var keepListed bool
+	var addUnheard bool
+
+	flags := flag.NewFlagSet("talkgroupUsage", flag.ExitOnError)
+	flags.BoolVar(&removeUnused, "removeUnused", false, "remove contacts used by no channel")
+	flags.BoolVar(&keepListed, "keepListed", false, "with -removeUnused, keep contacts in RX group lists")
+	flags.BoolVar(&addUnheard, "addUnheard", false, "add channels' talkgroups to their RX group lists")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-removeUnused] [-keepListed] [-addUnheard] <inFilename> [<outFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Reports contacts used by no channel, and channels whose talkgroup\n")
+		errorf("is not in their RX group list.  Without outFilename, nothing is fixed.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 && len(args) != 2 {
+		flags.Usage()
+	}
+	if len(args) == 2 && !removeUnused && !addUnheard {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(cp.AnalyzeTalkgroupUsage().String())
+
+	if len(args) == 1 {
+		return nil
+	}
+
+	if addUnheard {
+		added, err := cp.AddUnheardTalkgroups()
+		if err != nil {
+			return err
+		}
+		for _, uh := range added {
+			fmt.Printf("Added '%s' to RX group list '%s' for channel '%s'.\n", uh.Contact, uh.GroupList, uh.Channel)
+		}
+	}
+
+	if removeUnused {
+		removed, err := cp.RemoveUnusedContacts(keepListed)
+		if err != nil {
+			return err
+		}
+		if len(removed) != 0 {
+			fmt.Printf("Removed contacts: %s\n", strings.Join(removed, ", "))
+		}
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func checkPolicies() error {
+	var fix bool
+
+	flags := flag.NewFlagSet("checkPolicies", flag.ExitOnError)
+	flags.BoolVar(&fix, "fix", false, "set the fields violating the policies to the values required")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-fix] <policiesFilename> <inFilename> [<outFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Reports the channel fields violating the policies in the JSON\n")
+		errorf("policies file.  With -fix, the fixed codeplug is saved in outFilename.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 && len(args) != 3 {
+		flags.Usage()
+	}
+	if fix != (len(args) == 3) {
+		flags.Usage()
+	}
+
+	filePolicies, err := codeplug.LoadPolicies(args[0])
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	err = cp.SetPolicies(append(policies[:len(policies):len(policies)], filePolicies...))
+	if err != nil {
+		return err
+	}
+
+	violations := cp.PolicyViolations()
+	for _, v := range violations {
+		fmt.Println(v.String())
+	}
+
+	if !fix {
+		if len(violations) != 0 {
+			return fmt.Errorf("%d policy violations", len(violations))
+		}
+		return nil
+	}
+
+	remaining, err := cp.FixPolicyViolations()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Fixed %d policy violations.\n", len(violations)-len(remaining))
+	for _, v := range remaining {
+		fmt.Printf("Not fixed: %s\n", v.String())
+	}
+
+	return saveCodeplugFile(cp, args[2])
+}
+
+func checkRules() error {
+	flags := flag.NewFlagSet("checkRules", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <rulesFilename> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Reports the records violating the rules in the JSON rules file.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	fileRules, err := codeplug.LoadRules(args[0])
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	err = cp.SetRules(append(rules[:len(rules):len(rules)], fileRules...))
+	if err != nil {
+		return err
+	}
+
+	violations := cp.RuleViolations()
+	for _, v := range violations {
+		fmt.Println(v.String())
+	}
+
+	if len(violations) != 0 {
+		return fmt.Errorf("%d rule violations", len(violations))
+	}
+
+	return nil
+}
+
+func fix() error {
+	var maxNameLength int
+	var reportFilename string
+
+	flags := flag.NewFlagSet("fix", flag.ExitOnError)
+	flags.IntVar(&maxNameLength, "maxNameLength", 0, "shorten record names longer than <n> characters")
+	flags.StringVar(&reportFilename, "report", "", "write the fixes to <reportFilename>, as JSON if it ends in .json, instead of to standard output")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-maxNameLength <n>] [-report <reportFilename>] <inFilename> [<outFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Applies the fixes needing no judgment: references to missing\n")
+		errorf("records and invalid or unused tones are set to None, spaces are\n")
+		errorf("trimmed from names and policy violations are fixed.  Locked fields\n")
+		errorf("are left alone.  Without outFilename, the fixes are only reported.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 && len(args) != 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	fixes, err := cp.Fix(codeplug.FixOptions{MaxNameLength: maxNameLength})
+	if err != nil {
+		return err
+	}
+
+	w := os.Stdout
+	if reportFilename != "" {
+		w, err = os.Create(reportFilename)
+		if err != nil {
+			return err
+		}
+		defer w.Close()
+	}
+
+	if strings.ToLower(filepath.Ext(reportFilename)) == ".json" {
+		if fixes == nil {
+			fixes = []codeplug.Fix{}
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "\t")
+		err = encoder.Encode(fixes)
+	} else {
+		for _, fix := range fixes {
+			_, err = fmt.Fprintln(w, fix.String())
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		return nil
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func mergeDuplicateChannels() error {
+	flags := flag.NewFlagSet("mergeDuplicateChannels", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <inFilename> [<outFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Without outFilename, the duplicate channels are only reported.\n")
+		errorf("Each set of duplicates is merged into its first channel.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 && len(args) != 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		for _, names := range cp.FindDuplicateChannels() {
+			fmt.Println(strings.Join(names, ", "))
+		}
+		return nil
+	}
+
+	merged, err := cp.MergeDuplicateChannels()
+	if err != nil {
+		return err
+	}
+	for _, names := range merged {
+		fmt.Printf("merged %s into %s\n", strings.Join(names[1:], ", "), names[0])
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func lockRecords() error {
+	var unlock bool
+	var fields string
+	var recordType string
+
+	flags := flag.NewFlagSet("lockRecords", flag.ExitOnError)
+	flags.BoolVar(&unlock, "unlock", false, "unlock instead of lock")
+	flags.StringVar(&fields, "fields", "", "<fieldType,fieldType,...>")
+	flags.StringVar(&recordType, "type", "", "<recordType>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-unlock] [-fields <fieldTypes>] -type <recordType> <inFilename> <outFilename> [<name>...]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Record and field types are named as in text files, as in\n")
+		errorf("'-type Zones' or '-type Channels -fields RxFrequency,TxFrequency'.\n")
+		errorf("Locks are saved only in text (.txt) and JSON (.json) files.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) < 2 || recordType == "" {
+		flags.Usage()
+	}
+
+	switch strings.ToLower(filepath.Ext(args[1])) {
+	case ".txt", ".json":
+	default:
+		return errors.New("locks can only be saved in .txt or .json files")
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var fieldTypes []string
+	if fields != "" {
+		fieldTypes = strings.Split(fields, ",")
+	}
+
+	err = cp.SetLocks(recordType, args[2:], fieldTypes, !unlock)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func renameRecords() error {
+	var recordType string
+	var tagged string
+	var rule codeplug.RenameRule
+	var dryRun bool
+
+	flags := flag.NewFlagSet("renameRecords", flag.ExitOnError)
+	flags.StringVar(&recordType, "type", "", "<recordType>")
+	flags.StringVar(&tagged, "tagged", "", "<tag,!tag,...> rename only records with these tags")
+	flags.StringVar(&rule.Pattern, "match", "", "<regular expression matched against names>")
+	flags.StringVar(&rule.Replacement, "replace", "", "<new name template>")
+	flags.IntVar(&rule.Start, "start", 1, "number of the first renamed record")
+	flags.IntVar(&rule.Step, "step", 1, "increment between record numbers")
+	flags.IntVar(&rule.Width, "width", 0, "minimum digits in record numbers")
+	flags.StringVar(&rule.Case, "case", "", "<upper|lower|title>")
+	flags.BoolVar(&dryRun, "dryRun", false, "show the new names without saving them")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -type <recordType> [-tagged <tags>] [-match <regexp>] -replace <template> [options] <inFilename> <outFilename> [<name>...]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("The template's variables are {name}, the current name, {n}, the\n")
+		errorf("record's number, and the match's submatches, as in {1}.  For example,\n")
+		errorf("-match '^(.*) Rptr$' -replace '{1} R{n}'.\n")
+		errorf("If no names are given, all records of the type, or those\n")
+		errorf("having the tags given by -tagged, are renamed.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) < 2 || recordType == "" || rule.Replacement == "" {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	rTypes, err := recordTypes(cp, recordType)
+	if err != nil {
+		return err
+	}
+	if len(rTypes) != 1 {
+		return errors.New("only one record type may be renamed")
+	}
+	rType := rTypes[0]
+
+	records := cp.Records(rType)
+	if tagged != "" {
+		filter, err := codeplug.ParseTagFilter(tagged)
+		if err != nil {
+			return err
+		}
+		records = cp.TaggedRecords(rType, filter)
+	}
+	if len(args) > 2 {
+		records = nil
+		for _, name := range args[2:] {
+			r := cp.FindRecordByName(rType, name)
+			if r == nil {
+				return fmt.Errorf("%s: no record named %s", rType, name)
+			}
+			records = append(records, r)
+		}
+	}
+
+	renames, err := cp.PreviewRenames(records, &rule)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, rename := range renames {
+		if rename.Err != nil {
+			fmt.Printf("%s -> %s: %s\n", rename.OldName, rename.NewName, rename.Err.Error())
+			failed++
+			continue
+		}
+		fmt.Printf("%s -> %s\n", rename.OldName, rename.NewName)
+	}
+	if len(renames) == 0 {
+		fmt.Println("No records would be renamed.")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d records cannot be renamed", failed)
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	err = cp.ApplyRenames(renames)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+// pathUsage describes the paths given to get and set.
+func pathUsage() {
+	errorf("A path is a record type, an optional record selector in brackets\n")
+	errorf("and a field type, as in \"Channels[Name=='W1AW 2m'].Power\".  The\n")
+	errorf("selector is a record name, or conditions joined by &&, each\n")
+	errorf("comparing a field with ==, != or =~ (a regular expression match).\n")
+	errorf("Without a selector, every record of the type is selected.\n")
+	errorf("Record and field types are named as in text files.\n")
+}
+
+func getFields() error {
+	flags := flag.NewFlagSet("get", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <path> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Prints the name of each record selected by the path and the\n")
+		errorf("value of its field, separated by a tab.\n")
+		pathUsage()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	fields, err := cp.SelectFields(args[0])
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		fmt.Printf("%s\t%s\n", f.Record().Name(), f.String())
+	}
+
+	return nil
+}
+
+func setFields() error {
+	flags := flag.NewFlagSet("set", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <path> <value> <inFilename> [<outFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Sets the field of each record selected by the path to value,\n")
+		errorf("saving the codeplug in outFilename, or if none is given, in\n")
+		errorf("inFilename.\n")
+		pathUsage()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 3 && len(args) != 4 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[2])
+	if err != nil {
+		return err
+	}
+
+	changed, err := cp.SetFields(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Changed %d fields.\n", len(changed))
+
+	outFilename := args[2]
+	if len(args) == 4 {
+		outFilename = args[3]
+	}
+
+	return saveCodeplugFile(cp, outFilename)
+}
+
+func cloneRecord() error {
+	var recordType string
+	var field string
+	var values string
+	var nameTemplate string
+
+	flags := flag.NewFlagSet("cloneRecord", flag.ExitOnError)
+	flags.StringVar(&recordType, "type", "Channels", "<recordType>")
+	flags.StringVar(&field, "field", "", "<fieldType> varied by each copy")
+	flags.StringVar(&values, "values", "", "<value,value,...> one for each copy")
+	flags.StringVar(&nameTemplate, "name", "{name} {value}", "<name template of the copies>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-type <recordType>] -field <fieldType> -values <values> [-name <template>] <inFilename> <outFilename> <name>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Adds a copy of the named record for each value, with the field\n")
+		errorf("set to the value, as in '-field ContactName -values TAC1,TAC2'.\n")
+		errorf("Record and field types are named as in text files.  The name\n")
+		errorf("template's variables are {name}, the record's name, {value} and\n")
+		errorf("{n}, the copy's number.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 3 || field == "" || values == "" {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var valueList []string
+	for _, value := range strings.Split(values, ",") {
+		valueList = append(valueList, strings.TrimSpace(value))
+	}
+
+	clones, err := cp.CloneRecordByName(recordType, args[2], field, valueList, nameTemplate)
+	if err != nil {
+		return err
+	}
+	for _, r := range clones {
+		fmt.Println(r.Name())
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func renameTalkgroups() error {
+	var dryRun bool
+
+	flags := flag.NewFlagSet("renameTalkgroups", flag.ExitOnError)
+	flags.BoolVar(&dryRun, "dryRun", false, "only list the renames, without saving")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-dryRun] <csvFilename> <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Renames the group contacts named in csvFilename, whose rows\n")
+		errorf("are id,name[,shorter name]...  The first name that fits the\n")
+		errorf("radio is used.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 3 {
+		flags.Usage()
+	}
+
+	tgNames, err := codeplug.LoadTalkgroupNames(args[0])
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	err = cp.SetTalkgroupNames(tgNames)
+	if err != nil {
+		return err
+	}
+
+	renames := cp.PreviewTalkgroupRenames()
+	failed := 0
+	for _, rename := range renames {
+		if rename.Err != nil {
+			fmt.Printf("%s -> %s: %s\n", rename.OldName, rename.NewName, rename.Err.Error())
+			failed++
+			continue
+		}
+		fmt.Printf("%s -> %s\n", rename.OldName, rename.NewName)
+	}
+	if len(renames) == 0 {
+		fmt.Println("No contacts would be renamed.")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d contacts cannot be renamed", failed)
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	err = cp.ApplyRenames(renames)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, args[2])
+}
+
+func tagRecords() error {
+	var remove bool
+	var recordType string
+	var tags string
+	var tagged string
+
+	flags := flag.NewFlagSet("tagRecords", flag.ExitOnError)
+	flags.BoolVar(&remove, "remove", false, "remove the tags instead of adding them")
+	flags.StringVar(&recordType, "type", "", "<recordType>")
+	flags.StringVar(&tags, "tags", "", "<tag,tag,...>")
+	flags.StringVar(&tagged, "tagged", "", "<tag,!tag,...> select records having these tags")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-remove] -type <recordType> -tags <tags> [-tagged <tags>] <inFilename> <outFilename> [<name>...]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Records are selected by name, or by -tagged, as in\n")
+		errorf("'-type Channels -tags sota -tagged hills,!hotspot'.\n")
+		errorf("Tags are saved only in text (.txt) and JSON (.json) files.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) < 2 || recordType == "" || tags == "" {
+		flags.Usage()
+	}
+	if len(args) == 2 && tagged == "" {
+		flags.Usage()
+	}
+
+	switch strings.ToLower(filepath.Ext(args[1])) {
+	case ".txt", ".json":
+	default:
+		return errors.New("tags can only be saved in .txt or .json files")
+	}
+
+	var filter *codeplug.TagFilter
+	if tagged != "" {
+		var err error
+		filter, err = codeplug.ParseTagFilter(tagged)
+		if err != nil {
+			return err
+		}
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	n, err := cp.SetTags(recordType, args[2:], filter, strings.Split(tags, ","), !remove)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d records changed.\n", n)
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func listTagged() error {
+	var recordType string
+	var tagged string
+
+	flags := flag.NewFlagSet("listTagged", flag.ExitOnError)
+	flags.StringVar(&recordType, "type", "", "<recordType>")
+	flags.StringVar(&tagged, "tagged", "", "<tag,!tag,...> list only records having these tags")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -type <recordType> [-tagged <tags>] <filename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Without -tagged, the tags used by the records are listed.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || recordType == "" {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	rTypes, err := recordTypes(cp, recordType)
+	if err != nil {
+		return err
+	}
+
+	for _, rType := range rTypes {
+		if tagged == "" {
+			for _, tag := range cp.Tags(rType) {
+				fmt.Println(tag)
+			}
+			continue
+		}
+
+		filter, err := codeplug.ParseTagFilter(tagged)
+		if err != nil {
+			return err
+		}
+		for _, r := range cp.TaggedRecords(rType, filter) {
+			fmt.Printf("%s\t%s\n", r.Name(), strings.Join(r.Tags(), ","))
+		}
+	}
+
+	return nil
+}
+
+func addTagZone() error {
+	var name string
+	var tagged string
+
+	flags := flag.NewFlagSet("addTagZone", flag.ExitOnError)
+	flags.StringVar(&name, "name", "{tags}", "<zone name template>")
+	flags.StringVar(&tagged, "tagged", "", "<tag,!tag,...>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s -tagged <tags> [-name <template>] <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Adds zones holding the channels having the tags, as in\n")
+		errorf("'-tagged sota,!hotspot -name SOTA'.  The template's {tags}\n")
+		errorf("variable is replaced by the -tagged value.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 || tagged == "" {
+		flags.Usage()
+	}
+
+	filter, err := codeplug.ParseTagFilter(tagged)
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	err = cp.AddTagZones(name, filter)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func query() error {
+	var raw bool
+	var compact bool
+
+	flags := flag.NewFlagSet("query", flag.ExitOnError)
+	flags.BoolVar(&raw, "r", false, "print strings without quotes")
+	flags.BoolVar(&compact, "c", false, "print each output on a single line")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-r] [-c] <expression> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Prints the outputs of a jq-style expression over the codeplug's\n")
+		errorf("JSON form, as written by codeplugToJSON.  For example:\n")
+		errorf("\t-r '.Channels[] | [.Name, .RxFrequency] | @tsv'\n")
+		errorf("\t'.Zones[] | {Name, channels: (.Channel | length)}'\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	outputs, err := cp.Query(args[0])
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
+	if !compact {
+		encoder.SetIndent("", "\t")
+	}
+
+	for _, output := range outputs {
+		if s, ok := output.(string); ok && raw {
+			fmt.Println(s)
+			continue
+		}
+
+		err = encoder.Encode(output)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func report() error {
+	var jsonOutput bool
+	var usersFilename string
+
+	flags := flag.NewFlagSet("report", flag.ExitOnError)
+	flags.BoolVar(&jsonOutput, "json", false, "write the report as JSON")
+	flags.StringVar(&usersFilename, "users", "", "<user database filename>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-json] [-users <usersFilename>] <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	report := cp.Report()
+
+	if usersFilename != "" {
+		fileInfo, err := os.Stat(usersFilename)
+		if err != nil {
+			return err
+		}
+		report.AddUsers(fileInfo.Size())
+	}
+
+	if jsonOutput {
+		err = report.WriteJSON(os.Stdout)
+		if err != nil {
+			return err
+		}
+	} else {
+		fmt.Print(report.String())
+	}
+
+	if !report.Fits() {
+		return fmt.Errorf("%s does not fit in the radio", args[0])
+	}
+
+	return nil
+}
+
+func bundle() error {
+	var usersFilename string
+
+	flags := flag.NewFlagSet("bundle", flag.ExitOnError)
+	flags.StringVar(&usersFilename, "users", "", "<user database filename>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-users <usersFilename>] <codeplugFilename> <bundleFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Writes a zip file holding the codeplug, its report and any users database.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 {
+		flags.Usage()
+	}
+	codeplugFilename := args[0]
+	bundleFilename := args[1]
+
+	cp, err := loadCodeplugFile(codeplugFilename)
+	if err != nil {
+		return err
+	}
+
+	report := cp.Report()
+	files := make(map[string][]byte)
+	if usersFilename != "" {
+		users, err := ioutil.ReadFile(usersFilename)
+		if err != nil {
+			return err
+		}
+		files[codeplug.BundleUsersName] = users
+		report.AddUsers(int64(len(users)))
+	}
+	files[codeplug.BundleReportName] = []byte(report.String())
+
+	// The codeplug keeps its name and format, uncompressed, except
+	// that other files, such as spreadsheets, are bundled as rdt
+	// images.
+	name := filepath.Base(strings.TrimSuffix(codeplugFilename, ".gz"))
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".rdt", ".bin", ".txt", ".json":
+	default:
+		name = strings.TrimSuffix(name, filepath.Ext(name)) + ".rdt"
+	}
+
+	file, err := os.Create(bundleFilename)
+	if err != nil {
+		return err
+	}
+	err = cp.WriteBundle(file, name, files)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func verifyRoundTrip() error {
+	var model string
+
+	flags := flag.NewFlagSet("verifyRoundTrip", flag.ExitOnError)
+	flags.StringVar(&model, "model", "", "<model>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-model <model>] <imageFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Reports the bytes of an rdt or bin image that change when it is loaded and saved.\n")
+		errorf("The model must be given for bin images.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+
+	image, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var diffs []codeplug.ByteDifference
+	if model != "" {
+		diffs, err = codeplug.VerifyBinRoundTrip(image, model)
+	} else {
+		diffs, err = codeplug.VerifyRoundTrip(image)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", args[0], err.Error())
+	}
+
+	for _, diff := range diffs {
+		fmt.Println(diff)
+	}
+	if len(diffs) != 0 {
+		return fmt.Errorf("%s: %d bytes differ", args[0], len(diffs))
+	}
+
+	return nil
+}
+
+func unknownRegions() error {
+	flags := flag.NewFlagSet("unknownRegions", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> [<dumpFilename>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Writes a hex dump of the bytes not described by any field.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) < 1 || len(args) > 2 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 2 {
+		return cp.ExportUnknownRegions(args[1])
+	}
+
+	return cp.WriteUnknownRegions(os.Stdout)
+}
+
+func checkAnalogChannels() error {
+	flags := flag.NewFlagSet("checkAnalogChannels", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Reports likely errors in frequencies, tones and bandwidths.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range cp.AnalyzeAnalogChannels() {
+		fmt.Println(problem)
+	}
+
+	return nil
+}
+
+func checkDigitalChannels() error {
+	flags := flag.NewFlagSet("checkDigitalChannels", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Reports color codes and slots that differ between channels on a repeater.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range cp.AnalyzeDigitalChannels() {
+		fmt.Println(problem)
+	}
+
+	return nil
+}
+
+func importContacts() error {
+	flags := flag.NewFlagSet("importContacts", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <contactsFilename> <inFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("contactsFilename is a vCard (.vcf) or contacts CSV file.\n")
+		errorf("Contacts with a DMR ID are added as private-call contacts.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 3 {
+		flags.Usage()
+	}
+
+	tgs, err := codeplug.ReadContactsFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	names, err := cp.AddContacts(tgs)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("added %d of the %d contacts found\n", len(names), len(tgs))
+
+	return saveCodeplugFile(cp, args[2])
+}
+
+func applyOverlays() error {
+	flags := flag.NewFlagSet("applyOverlays", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <baseFilename> <outFilename> <overlayFilename>...\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Overlays are text or JSON (.json) files holding only the records\n")
+		errorf("and fields to change.  They are applied in order.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) < 3 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, filename := range args[2:] {
+		err = cp.ApplyOverlayFile(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func applySchedule() error {
+	var date string
+
+	flags := flag.NewFlagSet("applySchedule", flag.ExitOnError)
+	flags.StringVar(&date, "date", "", "apply the overlays scheduled on <yyyy-mm-dd> instead of today")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-date <yyyy-mm-dd>] <scheduleFilename> <baseFilename> <outFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Applies to the base codeplug the overlays of the JSON schedule\n")
+		errorf("file whose date ranges include the date, in order.  Rebuilding\n")
+		errorf("from the base drops the overlays whose events have ended.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 3 {
+		flags.Usage()
+	}
+
+	t := time.Now()
+	if date != "" {
+		var err error
+		t, err = time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil {
+			return fmt.Errorf("bad date: %s", date)
+		}
+	}
+
+	schedule, err := codeplug.LoadSchedule(args[0])
+	if err != nil {
+		return err
+	}
+
+	cp, err := loadCodeplugFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	applied, err := cp.ApplySchedule(schedule, t)
+	if err != nil {
+		return err
+	}
+	for _, o := range applied {
+		fmt.Printf("Applied %s\n", o.String())
+	}
+
+	next, ok := codeplug.NextScheduleChange(schedule, t)
+	if ok {
+		fmt.Printf("Rebuild on %s\n", next.Format("2006-01-02"))
+	}
+
+	return saveCodeplugFile(cp, args[2])
+}
+
+func exportZone() error {
+	flags := flag.NewFlagSet("exportZone", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <codeplugFilename> <zoneName> <bundleFilename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Writes the zone, with its channels and the RX group lists and\n")
+		errorf("contacts they use, to a text zone bundle for importZone.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 3 {
+		flags.Usage()
+	}
+
+	cp, err := loadCodeplugFile(args[0])
 	if err != nil {
 		return err
 	}
 
-	ignoreWarnings := true
-	return cp.SaveAs(codeplugFilename, ignoreWarnings)
+	return cp.WriteZoneBundleFile(args[2], args[1])
 }
 
-func codeplugToText() error {
-	flags := flag.NewFlagSet("codeplugToText", flag.ExitOnError)
+func importZone() error {
+	var conflict string
+
+	flags := flag.NewFlagSet("importZone", flag.ExitOnError)
+	flags.StringVar(&conflict, "conflict", string(codeplug.ZoneConflictRename), "<rename|keep|replace> records whose names are in use")
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <codeplugFilename> <textFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s [-conflict rename|keep|replace] <inFilename> <outFilename> <bundleFilename>...\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
+		errorf("Adds the records of zone bundles written by exportZone.  Contacts\n")
+		errorf("and identical records already in the codeplug are reused.  A\n")
+		errorf("bundle record whose name is in use by a different record is\n")
+		errorf("renamed, replaced by the codeplug's, or replaces the codeplug's.\n")
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
-	if len(args) != 2 {
+	if len(args) < 3 {
 		flags.Usage()
 	}
-	codeplugFilename := args[0]
-	textFilename := args[1]
 
-	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
+	cp, err := loadCodeplugFile(args[0])
 	if err != nil {
 		return err
 	}
 
-	return cp.ExportText(textFilename)
+	for _, filename := range args[2:] {
+		renames, err := cp.ImportZoneBundleFile(filename, codeplug.ZoneConflict(conflict))
+		if err != nil {
+			return err
+		}
+		for _, rename := range renames {
+			fmt.Printf("%s: %s -> %s\n", rename.Record.Type(), rename.OldName, rename.NewName)
+		}
+	}
+
+	return saveCodeplugFile(cp, args[1])
 }
 
-func jsonToCodeplug() error {
-	flags := flag.NewFlagSet("jsonToCodeplug", flag.ExitOnError)
+func snippetsClient(indexURL string) (*snippets.Client, error) {
+	if indexURL == "" {
+		indexURL = os.Getenv(snippets.IndexURLEnv)
+	}
+	if indexURL == "" {
+		return nil, fmt.Errorf("no snippet index: use -index or set %s", snippets.IndexURLEnv)
+	}
+
+	return snippets.NewClient(indexURL), nil
+}
+
+func searchSnippets() error {
+	var indexURL string
+
+	flags := flag.NewFlagSet("searchSnippets", flag.ExitOnError)
+	flags.StringVar(&indexURL, "index", "", "<snippet index URL>")
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <jsonFilename> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s [-index <URL>] [<word>...]\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
+		errorf("Lists the snippets whose name, description, author or tags\n")
+		errorf("contain all of the words, or all snippets if none are given.\n")
+		errorf("The index URL is taken from $%s if -index is not given.\n", snippets.IndexURLEnv)
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
-	if len(args) != 2 {
+
+	client, err := snippetsClient(indexURL)
+	if err != nil {
+		return err
+	}
+
+	matches, err := client.Search(args)
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		return errors.New("no snippets found")
+	}
+
+	for _, s := range matches {
+		fmt.Println(s.String())
+	}
+
+	return nil
+}
+
+func importSnippets() error {
+	var indexURL string
+	var trustFilenames stringsFlag
+	var conflict string
+
+	flags := flag.NewFlagSet("importSnippets", flag.ExitOnError)
+	flags.StringVar(&indexURL, "index", "", "<snippet index URL>")
+	flags.Var(&trustFilenames, "trust", "<public key filename>")
+	flags.StringVar(&conflict, "conflict", string(codeplug.ZoneConflictRename), "<rename|keep|replace> records whose names are in use")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-index <URL>] [-trust <publicKeyFilename>]... [-conflict rename|keep|replace] <inFilename> <outFilename> <snippetName>...\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Fetches the named snippets and imports their zone bundles, as\n")
+		errorf("importZone does.  Snippets must be signed, and with -trust, signed\n")
+		errorf("with one of the given keys.\n")
+		errorf("The index URL is taken from $%s if -index is not given.\n", snippets.IndexURLEnv)
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) < 3 {
 		flags.Usage()
 	}
-	jsonFilename := args[0]
-	codeplugFilename := args[1]
 
-	cp, err := loadCodeplug(codeplug.FileTypeJSON, jsonFilename)
+	client, err := snippetsClient(indexURL)
 	if err != nil {
 		return err
 	}
+	for _, filename := range trustFilenames {
+		key, err := codeplug.ReadPublicKeyFile(filename)
+		if err != nil {
+			return err
+		}
+		client.TrustedKeys = append(client.TrustedKeys, key)
+	}
 
-	ignoreWarnings := true
-	return cp.SaveAs(codeplugFilename, ignoreWarnings)
+	cp, err := loadCodeplugFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args[2:] {
+		renames, err := client.Import(cp, name, codeplug.ZoneConflict(conflict))
+		if err != nil {
+			return err
+		}
+		for _, rename := range renames {
+			fmt.Printf("%s: %s -> %s\n", rename.Record.Type(), rename.OldName, rename.NewName)
+		}
+	}
+
+	return saveCodeplugFile(cp, args[1])
 }
 
-func codeplugToJSON() error {
-	flags := flag.NewFlagSet("codeplugToJSON", flag.ExitOnError)
+func genSigningKey() error {
+	flags := flag.NewFlagSet("genSigningKey", flag.ExitOnError)
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <codeplugFilename> <jsonFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s <privateKeyFilename> <publicKeyFilename>\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 2 {
 		flags.Usage()
 	}
-	codeplugFilename := args[0]
-	jsonFilename := args[1]
 
-	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
+	public, private, err := codeplug.GenerateSigningKey()
 	if err != nil {
 		return err
 	}
 
-	return cp.ExportJSON(jsonFilename)
+	err = codeplug.WriteKeyFile(args[0], private)
+	if err != nil {
+		return err
+	}
+
+	return codeplug.WriteKeyFile(args[1], public)
 }
 
-func xlsxToCodeplug() error {
-	flags := flag.NewFlagSet("xlsxToCodeplug", flag.ExitOnError)
+func signCodeplug() error {
+	var keyFilename string
+	var provenance codeplug.Provenance
+
+	flags := flag.NewFlagSet("signCodeplug", flag.ExitOnError)
+	flags.StringVar(&keyFilename, "key", "", "<private key filename>")
+	flags.StringVar(&provenance.Author, "author", "", "<author>")
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <xlsxFilename> <codeplugFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s [-key <privateKeyFilename>] [-author <author>] <filename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Adds provenance to a text or JSON codeplug file, signed if -key is given.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+
+	var key ed25519.PrivateKey
+	if keyFilename != "" {
+		var err error
+		key, err = codeplug.ReadPrivateKeyFile(keyFilename)
+		if err != nil {
+			return err
+		}
+	}
+
+	provenance.Tool = "dmrRadio " + version
+
+	return codeplug.SignFile(args[0], provenance, key)
+}
+
+func verifyCodeplug() error {
+	var trustFilenames stringsFlag
+
+	flags := flag.NewFlagSet("verifyCodeplug", flag.ExitOnError)
+	flags.Var(&trustFilenames, "trust", "<public key filename>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-trust <publicKeyFilename>]... <filename>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("With -trust, the file must be signed with one of the given keys.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+
+	var trustedKeys []ed25519.PublicKey
+	for _, filename := range trustFilenames {
+		key, err := codeplug.ReadPublicKeyFile(filename)
+		if err != nil {
+			return err
+		}
+		trustedKeys = append(trustedKeys, key)
+	}
+
+	provenance, err := codeplug.VerifyFile(args[0], trustedKeys)
+	if err != nil {
+		return err
+	}
+	if provenance == nil {
+		return fmt.Errorf("%s has no provenance", args[0])
+	}
+	fmt.Printf("%s: %s\n", args[0], provenance)
+
+	if trustedKeys != nil && !provenance.Trusted {
+		return fmt.Errorf("%s is not signed with a trusted key", args[0])
+	}
+
+	return nil
+}
+
+// readPassword returns the password given by the DMRRADIO_PASSWORD
+// environment variable, or else prompts for it on standard input.
+func readPassword() (string, error) {
+	if password := os.Getenv("DMRRADIO_PASSWORD"); password != "" {
+		return password, nil
+	}
+
+	errorf("Password: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", errors.New("no password given")
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func encryptCodeplug() error {
+	flags := flag.NewFlagSet("encryptCodeplug", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <inFilename> <encryptedFilename>\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
+		errorf("The password is read from DMRRADIO_PASSWORD, or from standard input.\n")
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 2 {
 		flags.Usage()
 	}
-	xlsxFilename := args[0]
-	codeplugFilename := args[1]
 
-	cp, err := loadCodeplug(codeplug.FileTypeXLSX, xlsxFilename)
+	cp, err := loadCodeplugFile(args[0])
 	if err != nil {
 		return err
 	}
 
-	ignoreWarnings := true
-	return cp.SaveAs(codeplugFilename, ignoreWarnings)
+	password, err := readPassword()
+	if err != nil {
+		return err
+	}
+
+	return cp.ExportEncrypted(args[1], password)
 }
 
-func codeplugToXLSX() error {
-	flags := flag.NewFlagSet("codeplugToXLSX", flag.ExitOnError)
+func decryptCodeplug() error {
+	flags := flag.NewFlagSet("decryptCodeplug", flag.ExitOnError)
 
 	flags.Usage = func() {
-		errorf("Usage: %s %s <codeplugFilename> <xlsxFilename>\n", os.Args[0], os.Args[1])
+		errorf("Usage: %s %s <encryptedFilename> <outFilename>\n", os.Args[0], os.Args[1])
 		flags.PrintDefaults()
+		errorf("The password is read from DMRRADIO_PASSWORD, or from standard input.\n")
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 2 {
 		flags.Usage()
 	}
+
+	password, err := readPassword()
+	if err != nil {
+		return err
+	}
+
+	cp, err := codeplug.NewEncryptedCodeplug(args[0], password)
+	if err != nil {
+		return err
+	}
+
+	cp, err = loadNewCodeplug(cp)
+	if err != nil {
+		return err
+	}
+
+	return saveCodeplugFile(cp, args[1])
+}
+
+func formatUsage(flags *flag.FlagSet, args string) func() {
+	return func() {
+		errorf("Usage: %s %s -format <format> %s\n", os.Args[0], os.Args[1], args)
+		flags.PrintDefaults()
+		errorf("format must be chosen from the following list:\n")
+		for _, name := range codeplug.FileFormats() {
+			errorf("\t%s\n", name)
+		}
+		os.Exit(1)
+	}
+}
+
+func importCodeplug() error {
+	var format string
+
+	flags := flag.NewFlagSet("importCodeplug", flag.ExitOnError)
+	flags.StringVar(&format, "format", "", "<format>")
+	flags.Usage = formatUsage(flags, "<filename> <codeplugFilename>")
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 || format == "" {
+		flags.Usage()
+	}
+	filename := args[0]
+	codeplugFilename := args[1]
+
+	cp, err := codeplug.NewCodeplugFormat(format, filename)
+	if err != nil {
+		return err
+	}
+
+	cp, err = loadNewCodeplug(cp)
+	if err != nil {
+		return err
+	}
+
+	err = policyError(cp)
+	if err != nil {
+		return err
+	}
+
+	ignoreWarnings := true
+	return cp.SaveAs(codeplugFilename, ignoreWarnings)
+}
+
+func exportCodeplug() error {
+	var format string
+
+	flags := flag.NewFlagSet("exportCodeplug", flag.ExitOnError)
+	flags.StringVar(&format, "format", "", "<format>")
+	flags.Usage = formatUsage(flags, "<codeplugFilename> <filename>")
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 2 || format == "" {
+		flags.Usage()
+	}
 	codeplugFilename := args[0]
-	xlsxFilename := args[1]
+	filename := args[1]
 
 	cp, err := loadCodeplug(codeplug.FileTypeNone, codeplugFilename)
 	if err != nil {
 		return err
 	}
 
-	return cp.ExportXLSX(xlsxFilename)
+	return cp.ExportFormat(format, filename)
+}
+
+func serve() error {
+	var addr string
+
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	flags.StringVar(&addr, "addr", "localhost:8080", "listen on <address>")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-addr <address>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Serves POST /v1/parse, /v1/validate, /v1/convert?to=<format>,\n")
+		errorf("/v1/diff and /v1/userdb?format=<format> over HTTP.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	if len(flags.Args()) != 0 {
+		flags.Usage()
+	}
+
+	log.Printf("serving codeplug operations on http://%s/v1/", addr)
+
+	return http.ListenAndServe(addr, service.NewHandler())
+}
+
+// stringsFlag is a flag that may be given more than once.
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// globalOptions handles the options preceding the subCommand, removing
+// them from os.Args.
+func globalOptions() error {
+	var plugins stringsFlag
+	var transport string
+	var language string
+	var transferLog string
+	var mode string
+	var profileName string
+	var policiesFilename string
+	var rulesFilename string
+	var talkgroupNamesFilename string
+	var abbreviationsFilename string
+
+	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	flags.Var(&plugins, "plugin", "<pluginFilename>")
+	flags.StringVar(&transport, "transport", codeplug.DefaultTransport, "<transport>")
+	flags.BoolVar(&overrideLocks, "overrideLocks", false, "allow changes to locked records and fields")
+	flags.StringVar(&language, "lang", "", "<language>, as in de, es or zh_CN")
+	flags.StringVar(&transferLog, "transferLog", "", "append a detailed log of radio transfers to <logFilename>")
+	flags.StringVar(&auditAuthor, "auditAuthor", "", "record <name> as the last modifier of each changed record")
+	flags.StringVar(&mode, "parseMode", "", "treat unknown records and fields in imported files as <normal|strict|lenient>")
+	flags.StringVar(&profileName, "profile", "", "take subCommand option defaults from the profile <name>")
+	flags.StringVar(&policiesFilename, "policies", "", "refuse to save codeplugs violating the channel policies in <policiesFilename>")
+	flags.StringVar(&rulesFilename, "rules", "", "refuse to save codeplugs violating the rules in <rulesFilename>")
+	flags.StringVar(&talkgroupNamesFilename, "talkgroupNames", "", "name added talkgroups as in <csvFilename> of id,name[,shorter name]...")
+	flags.StringVar(&abbreviationsFilename, "abbreviations", "", "shorten long generated names with the word,abbreviation rows of <csvFilename>")
+	flags.Usage = usage
+
+	// Messages are in the environment's language, if possible,
+	// until the -lang option is seen.
+	err := i18n.LoadUserCatalogs()
+	if err != nil {
+		return err
+	}
+	_ = i18n.SetLanguage("")
+
+	flags.Parse(os.Args[1:])
+	os.Args = append(os.Args[:1], flags.Args()...)
+
+	if language != "" {
+		err := i18n.SetLanguage(language)
+		if err != nil {
+			return err
+		}
+	}
+
+	parseMode, err = codeplug.ParseParseMode(mode)
+	if err != nil {
+		return err
+	}
+
+	if profileName != "" {
+		selectedProfile, err = profile.Load(profileName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if policiesFilename != "" {
+		policies, err = codeplug.LoadPolicies(policiesFilename)
+		if err != nil {
+			return err
+		}
+	}
+
+	if rulesFilename != "" {
+		rules, err = codeplug.LoadRules(rulesFilename)
+		if err != nil {
+			return err
+		}
+	}
+
+	if talkgroupNamesFilename != "" {
+		talkgroupNames, err = codeplug.LoadTalkgroupNames(talkgroupNamesFilename)
+		if err != nil {
+			return err
+		}
+	}
+
+	if abbreviationsFilename != "" {
+		abbreviations, err = codeplug.LoadAbbreviations(abbreviationsFilename)
+		if err != nil {
+			return err
+		}
+	}
+
+	if transferLog != "" {
+		file, err := os.OpenFile(transferLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		dfu.SetTransferLog(file)
+	}
+
+	for _, filename := range plugins {
+		err := codeplug.LoadPlugin(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	return codeplug.SetTransport(transport)
 }
 
 func printVersion() error {
@@ -551,7 +4234,7 @@ func printVersion() error {
 		os.Exit(1)
 	}
 
-	flags.Parse(os.Args[2:len(os.Args)])
+	parseFlags(flags)
 	args := flags.Args()
 	if len(args) != 0 {
 		flags.Usage()
@@ -565,6 +4248,12 @@ func main() {
 	log.SetPrefix(filepath.Base(os.Args[0]) + ": ")
 	log.SetFlags(log.Lshortfile)
 
+	err := globalOptions()
+	if err != nil {
+		errorf("%s\n", err.Error())
+		os.Exit(1)
+	}
+
 	if len(os.Args) < 2 {
 		usage()
 	}
@@ -572,20 +4261,83 @@ func main() {
 	subCommandName := strings.ToLower(os.Args[1])
 
 	subCommands := map[string]func() error{
-		"readcodeplug":   readCodeplug,
-		"writecodeplug":  writeCodeplug,
-		"dumpspiflash":   dumpSPIFlash,
-		"dumpusers":      dumpUsers,
-		"writeusers":     writeUsers,
-		"getusers":       getUsers,
-		"writefirmware":  writeFirmware,
-		"texttocodeplug": textToCodeplug,
-		"codeplugtotext": codeplugToText,
-		"jsontocodeplug": jsonToCodeplug,
-		"codeplugtojson": codeplugToJSON,
-		"xlsxtocodeplug": xlsxToCodeplug,
-		"codeplugtoxlsx": codeplugToXLSX,
-		"version":        printVersion,
+		"readcodeplug":           readCodeplug,
+		"newcodeplug":            newCodeplug,
+		"newcodeplugwizard":      newCodeplugWizard,
+		"writecodeplug":          writeCodeplug,
+		"listradios":             listRadios,
+		"dumpspiflash":           dumpSPIFlash,
+		"splitflashdump":         splitFlashDump,
+		"importcpsarchive":       importCPSArchive,
+		"dumpcalibration":        dumpCalibration,
+		"writecalibration":       writeCalibration,
+		"dumpusers":              dumpUsers,
+		"writeusers":             writeUsers,
+		"getusers":               getUsers,
+		"contactstousers":        contactsToUsers,
+		"writefirmware":          writeFirmware,
+		"texttocodeplug":         textToCodeplug,
+		"codeplugtotext":         codeplugToText,
+		"jsontocodeplug":         jsonToCodeplug,
+		"codeplugtojson":         codeplugToJSON,
+		"xlsxtocodeplug":         xlsxToCodeplug,
+		"codeplugtoxlsx":         codeplugToXLSX,
+		"codeplugtohtml":         codeplugToHTML,
+		"codeplugtomarkdown":     codeplugToMarkdown,
+		"codeplugtoopenrtx":      codeplugToOpenRTX,
+		"addhotspot":             addHotspot,
+		"brandmeisterdevices":    brandmeisterDevices,
+		"addbrandmeisterdevice":  addBrandmeisterDevice,
+		"plantalkgroups":         planTalkgroups,
+		"addsimplex":             addSimplex,
+		"addparrot":              addParrot,
+		"sortchannels":           sortChannels,
+		"adddistancezone":        addDistanceZone,
+		"addtravelzones":         addTravelZones,
+		"addpastedrepeaters":     addPastedRepeaters,
+		"syncscanlists":          syncScanLists,
+		"optimizegrouplists":     optimizeGroupLists,
+		"talkgroupusage":         talkgroupUsage,
+		"checkpolicies":          checkPolicies,
+		"checkrules":             checkRules,
+		"fix":                    fix,
+		"mergeduplicatechannels": mergeDuplicateChannels,
+		"lockrecords":            lockRecords,
+		"renamerecords":          renameRecords,
+		"get":                    getFields,
+		"set":                    setFields,
+		"clonerecord":            cloneRecord,
+		"renametalkgroups":       renameTalkgroups,
+		"tagrecords":             tagRecords,
+		"listtagged":             listTagged,
+		"addtagzone":             addTagZone,
+		"query":                  query,
+		"report":                 report,
+		"bundle":                 bundle,
+		"unknownregions":         unknownRegions,
+		"verifyroundtrip":        verifyRoundTrip,
+		"checkanalogchannels":    checkAnalogChannels,
+		"checkdigitalchannels":   checkDigitalChannels,
+		"importcontacts":         importContacts,
+		"applyoverlays":          applyOverlays,
+		"applyschedule":          applySchedule,
+		"exportzone":             exportZone,
+		"importzone":             importZone,
+		"searchsnippets":         searchSnippets,
+		"importsnippets":         importSnippets,
+		"watch":                  watch,
+		"gensigningkey":          genSigningKey,
+		"signcodeplug":           signCodeplug,
+		"verifycodeplug":         verifyCodeplug,
+		"encryptcodeplug":        encryptCodeplug,
+		"decryptcodeplug":        decryptCodeplug,
+		"importcodeplug":         importCodeplug,
+		"exportcodeplug":         exportCodeplug,
+		"serve":                  serve,
+		"listprofiles":           listProfiles,
+		"showprofile":            showProfile,
+		"setprofileoption":       setProfileOption,
+		"version":                printVersion,
 	}
 
 	subCommand := subCommands[subCommandName]
@@ -593,7 +4345,7 @@ func main() {
 		usage()
 	}
 
-	err := subCommand()
+	err = subCommand()
 	if err != nil {
 		errorf("%s\n", err.Error())
 		os.Exit(1)
@@ -0,0 +1,156 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Radio.
+//
+// Radio is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Radio is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Radio.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/dalefarnsworth/codeplug/profile"
+)
+
+// selectedProfile is the profile named by the -profile option, or nil.
+var selectedProfile *profile.Profile
+
+// parseFlags parses the subcommand's options.  Those set by the
+// selected profile are applied first, so that the command line
+// overrides them.
+func parseFlags(flags *flag.FlagSet) {
+	if selectedProfile != nil {
+		opts := selectedProfile.CommandOptions(os.Args[1])
+		names := make([]string, 0, len(opts))
+		for name := range opts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if flags.Lookup(name) == nil {
+				errorf("profile %s: %s has no option -%s\n", selectedProfile.Name, os.Args[1], name)
+				os.Exit(1)
+			}
+			err := flags.Set(name, opts[name])
+			if err != nil {
+				errorf("profile %s: -%s: %s\n", selectedProfile.Name, name, err.Error())
+				os.Exit(1)
+			}
+		}
+	}
+
+	flags.Parse(os.Args[2:len(os.Args)])
+}
+
+func listProfiles() error {
+	flags := flag.NewFlagSet("listProfiles", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	if len(flags.Args()) != 0 {
+		flags.Usage()
+	}
+
+	names, err := profile.Names()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+
+	return nil
+}
+
+func showProfile() error {
+	flags := flag.NewFlagSet("showProfile", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <profileName>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 {
+		flags.Usage()
+	}
+
+	p, err := profile.Load(args[0])
+	if err != nil {
+		return err
+	}
+
+	bytes, err := json.MarshalIndent(p, "", "\t")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bytes))
+
+	return nil
+}
+
+func setProfileOption() error {
+	flags := flag.NewFlagSet("setProfileOption", flag.ExitOnError)
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s <profileName> <subCommand> <option> [<value>]\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("Sets the default value of a subCommand's option when the\n")
+		errorf("profile is selected with -profile, e.g.\n")
+		errorf("\tsetProfileOption club getUsers only US,CA\n")
+		errorf("The profile is created if it does not exist.  If no value\n")
+		errorf("is given, the option is removed from the profile.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 3 && len(args) != 4 {
+		flags.Usage()
+	}
+	name := args[0]
+	value := ""
+	if len(args) == 4 {
+		value = args[3]
+	}
+
+	p, err := profile.Load(name)
+	if err != nil {
+		p, err = profile.New(name)
+		if err != nil {
+			return err
+		}
+	}
+	p.SetOption(args[1], args[2], value)
+
+	return p.Save()
+}
@@ -0,0 +1,302 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Radio.
+//
+// Radio is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Radio is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Radio.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// A watchProject is a directory of codeplug sources:
+//
+//	base.<ext>		the base codeplug, of any readable type
+//	talkgroups*.csv		talkgroups added to it, as id,name[,private]
+//	*.csv, *.vcf		other contacts added to it
+//	overlays/*.txt|json	overlays applied to it, in name order
+//	members/*.txt|json	an overlay for each member's codeplug
+//
+// Building it writes the resulting codeplug, and one for each member,
+// each with its HTML cheat sheet, to an output directory.
+type watchProject struct {
+	dir    string
+	outDir string
+}
+
+// globFiles returns the sorted names of the files in dir having one of
+// the given extensions.
+func globFiles(dir string, exts ...string) ([]string, error) {
+	var filenames []string
+	for _, ext := range exts {
+		names, err := filepath.Glob(filepath.Join(dir, "*"+ext))
+		if err != nil {
+			return nil, err
+		}
+		filenames = append(filenames, names...)
+	}
+	sort.Strings(filenames)
+
+	return filenames, nil
+}
+
+// baseFilename returns the name of the project's base codeplug.
+func (p *watchProject) baseFilename() (string, error) {
+	filenames, err := filepath.Glob(filepath.Join(p.dir, "base.*"))
+	if err != nil {
+		return "", err
+	}
+	switch len(filenames) {
+	case 0:
+		return "", fmt.Errorf("%s: no base codeplug", p.dir)
+	case 1:
+		return filenames[0], nil
+	}
+
+	return "", fmt.Errorf("%s: several base codeplugs: %s", p.dir, strings.Join(filenames, ", "))
+}
+
+// inputs returns the names of all of the project's source files.
+func (p *watchProject) inputs() ([]string, error) {
+	base, err := p.baseFilename()
+	if err != nil {
+		return nil, err
+	}
+	filenames := []string{base}
+
+	contacts, err := globFiles(p.dir, ".csv", ".vcf")
+	if err != nil {
+		return nil, err
+	}
+	overlays, err := globFiles(filepath.Join(p.dir, "overlays"), ".txt", ".json")
+	if err != nil {
+		return nil, err
+	}
+	members, err := globFiles(filepath.Join(p.dir, "members"), ".txt", ".json")
+	if err != nil {
+		return nil, err
+	}
+
+	filenames = append(filenames, contacts...)
+	filenames = append(filenames, overlays...)
+
+	return append(filenames, members...), nil
+}
+
+// fingerprint returns a string that changes whenever a source file is
+// added, removed or modified.
+func (p *watchProject) fingerprint() string {
+	filenames, err := p.inputs()
+	if err != nil {
+		return err.Error()
+	}
+
+	var sb strings.Builder
+	for _, filename := range filenames {
+		fileInfo, err := os.Stat(filename)
+		if err != nil {
+			fmt.Fprintf(&sb, "%s: %s\n", filename, err.Error())
+			continue
+		}
+		fmt.Fprintf(&sb, "%s %d %d\n", filename, fileInfo.Size(), fileInfo.ModTime().UnixNano())
+	}
+
+	return sb.String()
+}
+
+// readContacts reads the talkgroups of a talkgroups*.csv file, or the
+// contacts of another contacts file.
+func readContacts(filename string) ([]codeplug.Talkgroup, error) {
+	if !strings.HasPrefix(strings.ToLower(filepath.Base(filename)), "talkgroups") ||
+		strings.ToLower(filepath.Ext(filename)) != ".csv" {
+		return codeplug.ReadContactsFile(filename)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return codeplug.ReadTalkgroupsCSV(file)
+}
+
+// load returns the project's codeplug: the base codeplug with the
+// contacts added and the overlays applied.
+func (p *watchProject) load() (*codeplug.Codeplug, error) {
+	base, err := p.baseFilename()
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := loadCodeplugFile(base)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", base, err.Error())
+	}
+
+	contacts, err := globFiles(p.dir, ".csv", ".vcf")
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range contacts {
+		tgs, err := readContacts(filename)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", filename, err.Error())
+		}
+		_, err = cp.AddContacts(tgs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", filename, err.Error())
+		}
+	}
+
+	overlays, err := globFiles(filepath.Join(p.dir, "overlays"), ".txt", ".json")
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range overlays {
+		err = cp.ApplyOverlayFile(filename)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cp, nil
+}
+
+// write writes the codeplug and its cheat sheet to the output
+// directory, named name.
+func (p *watchProject) write(cp *codeplug.Codeplug, name string) error {
+	filename := filepath.Join(p.outDir, name+"."+cp.Ext())
+	err := saveCodeplugFile(cp, filename)
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	filename = filepath.Join(p.outDir, name+".html")
+	err = cp.ExportHTML(filename)
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	return nil
+}
+
+// build regenerates all of the project's output files.
+func (p *watchProject) build() error {
+	err := os.MkdirAll(p.outDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	cp, err := p.load()
+	if err != nil {
+		return err
+	}
+	err = p.write(cp, "codeplug")
+	if err != nil {
+		return err
+	}
+
+	members, err := globFiles(filepath.Join(p.dir, "members"), ".txt", ".json")
+	if err != nil {
+		return err
+	}
+	for _, filename := range members {
+		cp, err := p.load()
+		if err != nil {
+			return err
+		}
+		err = cp.ApplyOverlayFile(filename)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+		err = p.write(cp, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func watch() error {
+	var outDir string
+	var interval float64
+	var once bool
+
+	flags := flag.NewFlagSet("watch", flag.ExitOnError)
+	flags.StringVar(&outDir, "out", "", "<output directory>, <dir>/out by default")
+	flags.Float64Var(&interval, "interval", 2, "<seconds> between checks for changed sources")
+	flags.BoolVar(&once, "once", false, "build once and exit")
+
+	flags.Usage = func() {
+		errorf("Usage: %s %s [-out <outDir>] [-interval <seconds>] [-once] <dir>\n", os.Args[0], os.Args[1])
+		flags.PrintDefaults()
+		errorf("dir holds the sources:\n")
+		errorf("\tbase.<ext>\t\tthe base codeplug, e.g. base.rdt or base.txt\n")
+		errorf("\ttalkgroups*.csv\t\ttalkgroups to add, as id,name[,private]\n")
+		errorf("\t*.csv, *.vcf\t\tother contacts to add\n")
+		errorf("\toverlays/*.txt|json\toverlays, applied in name order\n")
+		errorf("\tmembers/*.txt|json\tan overlay for each member's codeplug\n")
+		errorf("Whenever a source changes, codeplug.<ext> and <member>.<ext>,\n")
+		errorf("each with an HTML cheat sheet, are rebuilt in outDir.\n")
+		os.Exit(1)
+	}
+
+	parseFlags(flags)
+	args := flags.Args()
+	if len(args) != 1 || interval <= 0 {
+		flags.Usage()
+	}
+
+	p := &watchProject{dir: args[0], outDir: outDir}
+	if p.outDir == "" {
+		p.outDir = filepath.Join(p.dir, "out")
+	}
+
+	if once {
+		return p.build()
+	}
+
+	fingerprint := ""
+	for {
+		fp := p.fingerprint()
+		if fp != fingerprint {
+			fingerprint = fp
+			err := p.build()
+			if err != nil {
+				errorf("%s: build failed: %s\n", time.Now().Format("15:04:05"), err.Error())
+			} else {
+				fmt.Printf("%s: built %s\n", time.Now().Format("15:04:05"), p.outDir)
+			}
+		}
+		time.Sleep(time.Duration(interval * float64(time.Second)))
+	}
+}
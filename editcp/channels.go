@@ -24,8 +24,11 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/dalefarnsworth/codeplug/codeplug"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 )
 
 func channels(edt *editor) {
@@ -83,6 +86,7 @@ func ciRecord(edt *editor, recordBox *ui.HBox) {
 	form.AddFieldRows(r,
 		codeplug.FtCiPrivateCallConfirmed,
 		codeplug.FtCiEmergencyAlarmAck,
+		codeplug.FtCiEmergencySystem,
 		codeplug.FtCiDataCallConfirmed,
 		codeplug.FtCiCompressedUdpDataHeader,
 		codeplug.FtCiContactName,
@@ -135,3 +139,54 @@ func ciRecord(edt *editor, recordBox *ui.HBox) {
 		codeplug.FtCiDecode7,
 		codeplug.FtCiDecode8)
 }
+
+// mergeDuplicateChannels offers to merge each set of channels that
+// behave identically into the first channel of the set.
+func (edt *editor) mergeDuplicateChannels() {
+	cp := edt.codeplug
+	title := "Merge Duplicate Channels"
+
+	duplicates := cp.FindDuplicateChannels()
+	if len(duplicates) == 0 {
+		ui.InfoPopup(title, "No duplicate channels found.")
+		return
+	}
+
+	for _, names := range duplicates {
+		msg := fmt.Sprintf("Channels %s are identical.\n\nMerge them into %s?",
+			strings.Join(names, ", "), names[0])
+		if ui.YesNoPopup(title, msg) != ui.PopupYes {
+			continue
+		}
+		err := cp.MergeChannels(names[0], names[1:])
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+			break
+		}
+	}
+
+	ui.ResetWindows(cp)
+}
+
+// checkAnalogChannels reports likely errors in the analog channels.
+func (edt *editor) checkAnalogChannels() {
+	showChannelProblems("Check Analog Channels", edt.codeplug.AnalyzeAnalogChannels())
+}
+
+// checkDigitalChannels reports likely errors in the digital channels.
+func (edt *editor) checkDigitalChannels() {
+	showChannelProblems("Check Digital Channels", edt.codeplug.AnalyzeDigitalChannels())
+}
+
+func showChannelProblems(title string, problems []codeplug.ChannelProblem) {
+	if len(problems) == 0 {
+		ui.InfoPopup(title, "No problems found.")
+		return
+	}
+
+	strs := make([]string, len(problems))
+	for i, problem := range problems {
+		strs[i] = problem.String()
+	}
+	ui.InfoPopup(title, strings.Join(strs, "\n"))
+}
@@ -0,0 +1,97 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// cloneRecord shows a dialog for adding copies of r, each with one
+// field set to a different value, as in a channel for each of several
+// talkgroups.
+func cloneRecord(cp *codeplug.Codeplug, r *codeplug.Record) {
+	title := "Clone Record"
+
+	var fieldNames []string
+	for _, fType := range r.FieldTypes() {
+		if fType != r.NameFieldType() {
+			fieldNames = append(fieldNames, string(fType))
+		}
+	}
+	if len(fieldNames) == 0 {
+		return
+	}
+
+	field := fieldNames[0]
+	if r.Type() == codeplug.RtChannels_md380 {
+		field = string(codeplug.FtCiContactName)
+	}
+	values := ""
+	nameTemplate := "{name} {value}"
+
+	dialog := ui.NewDialog("Clone " + r.Name())
+
+	form := dialog.AddForm()
+	form.AddRow("Field to vary:", ui.NewComboboxWidget(field, fieldNames, func(s string) {
+		field = s
+	}))
+	form.AddRow("Values (value,value,...):", ui.NewLineEditWidget(values, func(s string) {
+		values = s
+	}))
+	form.AddRow("Name template ({name}, {value}, {n}):", ui.NewLineEditWidget(nameTemplate, func(s string) {
+		nameTemplate = s
+	}))
+
+	dialog.AddSpace(2)
+	row := dialog.AddHbox()
+
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Clone", func() {
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() {
+		return
+	}
+
+	var valueList []string
+	for _, value := range strings.Split(values, ",") {
+		if strings.TrimSpace(value) != "" {
+			valueList = append(valueList, strings.TrimSpace(value))
+		}
+	}
+
+	_, err := cp.CloneRecord(r, codeplug.FieldType(field), valueList, nameTemplate)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	ui.ResetWindows(cp)
+}
@@ -24,8 +24,11 @@
 package main
 
 import (
+	"fmt"
+	"path/filepath"
+
 	"github.com/dalefarnsworth/codeplug/codeplug"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 )
 
 func contacts(edt *editor) {
@@ -46,3 +49,34 @@ func dcRecord(edt *editor, recordBox *ui.HBox) {
 
 	recordBox.AddFiller()
 }
+
+// importContacts adds private-call contacts read from a vCard or
+// contacts CSV file.
+func (edt *editor) importContacts() {
+	cp := edt.codeplug
+	dir := settings.codeplugDirectory
+	filename := ui.OpenContactsFilename("Import contacts file", dir)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	title := fmt.Sprintf("Import contacts from %s", filename)
+
+	tgs, err := codeplug.ReadContactsFile(filename)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	names, err := cp.AddContacts(tgs)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+	ui.ResetWindows(cp)
+
+	msg := fmt.Sprintf("Added %d of the %d contacts found.", len(names), len(tgs))
+	ui.InfoPopup(title, msg)
+}
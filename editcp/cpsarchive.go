@@ -0,0 +1,122 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// importCPSArchive opens a sample or default codeplug shipped in a
+// vendor CPS installer, after saving it to a codeplug file.
+func (edt *editor) importCPSArchive() {
+	title := "Import from CPS installer"
+
+	dir := settings.codeplugDirectory
+	filename := ui.OpenCPSArchiveFilename(title, dir)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	acs, err := codeplug.ReadArchiveCodeplugs(filename)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	ac := acs[0]
+	if len(acs) > 1 {
+		var ok bool
+		ac, ok = chooseArchiveCodeplug(title, acs)
+		if !ok {
+			return
+		}
+	}
+
+	ext := ac.Ext()
+	base := baseFilename(filename)
+	if strings.ToLower(path.Ext(ac.Name)) == "."+ext {
+		base = strings.TrimSuffix(path.Base(ac.Name), path.Ext(ac.Name))
+	}
+	dir = filepath.Join(settings.codeplugDirectory, base+"."+ext)
+	cpFilename := ui.SaveFilename("Save imported codeplug", dir, ext)
+	if cpFilename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(cpFilename)
+	saveSettings()
+
+	err = ioutil.WriteFile(cpFilename, ac.Data, 0644)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	newEditor(edt.app, codeplug.FileTypeNone, cpFilename)
+}
+
+// chooseArchiveCodeplug lets the user choose one of the codeplugs
+// found in a CPS installer.
+func chooseArchiveCodeplug(title string, acs []codeplug.ArchiveCodeplug) (codeplug.ArchiveCodeplug, bool) {
+	names := make([]string, len(acs))
+	for i, ac := range acs {
+		names[i] = fmt.Sprintf("%d: %s", i+1, ac.Name)
+	}
+	chosen := 0
+
+	dialog := ui.NewDialog(title)
+
+	row := dialog.AddHbox()
+	form := row.AddForm()
+	form.AddRow("Codeplug:", ui.NewComboboxWidget(names[0], names, func(s string) {
+		for i, name := range names {
+			if name == s {
+				chosen = i
+			}
+		}
+	}))
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Import", func() {
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() {
+		return codeplug.ArchiveCodeplug{}, false
+	}
+
+	return acs[chosen], true
+}
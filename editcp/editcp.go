@@ -24,15 +24,18 @@
 package main
 
 import (
+	"bytes"
+	"crypto/ed25519"
 	"crypto/sha256"
 	"fmt"
 	"os"
+	"os/user"
 	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/dalefarnsworth/codeplug/codeplug"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 	"github.com/therecipe/qt/core"
 )
 
@@ -52,6 +55,23 @@ type editorSettings struct {
 	frequencyRange        string
 	displayGPS            bool
 	suppressWarnings      bool
+	syncScanLists         bool
+	signingAuthor         string
+	signingKeyFile        string
+	language              string
+	rebootAfterWrite      bool
+	trackChanges          bool
+	strictParsing         bool
+	radioBackup           bool
+	radioBackupKeep       int
+	profile               string
+	policiesFile          string
+	rulesFile             string
+	userdbContacts        bool
+	talkgroupNamesFile    string
+	abbreviationsFile     string
+	snippetIndexURL       string
+	snippetTrustedKeyFile string
 }
 
 var appSettings *ui.AppSettings
@@ -161,6 +181,9 @@ func (edt *editor) saveAs(filename string) string {
 		settings.codeplugDirectory = filepath.Dir(filename)
 		saveSettings()
 	}
+	if settings.syncScanLists && !edt.syncScanLists(false) {
+		return ""
+	}
 	ignoreWarnings := settings.suppressWarnings
 	err := edt.codeplug.SaveAs(filename, ignoreWarnings)
 	if warning, ok := err.(codeplug.Warning); ok {
@@ -207,6 +230,29 @@ func (edt *editor) setAutosaveInterval(seconds int) {
 	edt.autosaveTimer.Start(seconds * 60 * 1000)
 }
 
+// setAuditor makes the codeplug record who changes each record, if
+// change tracking is enabled.  The signing author names the person
+// making the changes, or failing that, the login name.
+func (edt *editor) setAuditor() {
+	cp := edt.codeplug
+	if cp == nil {
+		return
+	}
+
+	if !settings.trackChanges {
+		cp.SetAuditor("", "")
+		return
+	}
+
+	author := settings.signingAuthor
+	if author == "" {
+		if u, err := user.Current(); err == nil {
+			author = u.Username
+		}
+	}
+	cp.SetAuditor(author, "editcp "+version)
+}
+
 func (edt *editor) autosave() {
 	cp := edt.codeplug
 	if cp == nil {
@@ -244,6 +290,8 @@ func main() {
 	app.SetApplicationName("Codeplug Editor")
 	appSettings = app.NewSettings()
 	loadSettings()
+	loadPlugins()
+	loadLanguage()
 
 	filenames := os.Args[1:]
 	if len(filenames) == 0 {
@@ -263,6 +311,17 @@ func main() {
 	saveSettings()
 }
 
+// loadPlugins loads the Go plugins listed, separated as in PATH, in the
+// EDITCP_PLUGINS environment variable.
+func loadPlugins() {
+	for _, filename := range filepath.SplitList(os.Getenv("EDITCP_PLUGINS")) {
+		err := codeplug.LoadPlugin(filename)
+		if err != nil {
+			ui.ErrorPopup("Plugin Error", err.Error())
+		}
+	}
+}
+
 func (edt *editor) titleSuffix() string {
 	suffix := ""
 	if edt.codeplugCount > 1 {
@@ -307,7 +366,17 @@ func (edt *editor) openCodeplug(fType codeplug.FileType, filename string) {
 	if edt.codeplug == nil {
 		checkAutosave(filename)
 
-		cp, err := codeplug.NewCodeplug(fType, filename)
+		var cp *codeplug.Codeplug
+		var err error
+		if fType != codeplug.FileTypeNone && codeplug.IsEncryptedFile(filename) {
+			password := passwordDialog(filename, false)
+			if password == "" {
+				return
+			}
+			cp, err = codeplug.NewEncryptedCodeplug(filename, password)
+		} else {
+			cp, err = codeplug.NewCodeplug(fType, filename)
+		}
 		if err != nil {
 			ui.ErrorPopup("Codeplug Error", err.Error())
 			return
@@ -319,6 +388,10 @@ func (edt *editor) openCodeplug(fType codeplug.FileType, filename string) {
 			return
 		}
 
+		if settings.strictParsing {
+			cp.SetParseMode(codeplug.ParseStrict)
+		}
+
 		ignoreWarnings := settings.suppressWarnings
 		err = cp.Load(model, frequencyRange, ignoreWarnings)
 		if warning, ok := err.(codeplug.Warning); ok {
@@ -338,6 +411,11 @@ func (edt *editor) openCodeplug(fType codeplug.FileType, filename string) {
 		edt.codeplugHash = edt.codeplug.CurrentHash()
 		loadSettings()
 		edt.setAutosaveInterval(settings.autosaveInterval)
+		edt.setAuditor()
+		edt.setPolicies()
+		edt.setRules()
+		edt.setTalkgroupNames()
+		edt.setAbbreviations()
 	}
 
 	if fType == codeplug.FileTypeNone {
@@ -547,7 +625,11 @@ func (edt *editor) updateMenuBar() {
 	mb.Clear()
 	menu := mb.AddMenu("File")
 	menu.AddAction("New...", func() {
-		newEditor(edt.app, codeplug.FileTypeNew, "")
+		edt := newEditor(edt.app, codeplug.FileTypeNew, "")
+		if edt == nil || edt.codeplug == nil {
+			return
+		}
+		edt.newCodeplugWizard()
 	})
 	menu.AddAction("Open...", func() {
 		dir := settings.codeplugDirectory
@@ -584,6 +666,30 @@ func (edt *editor) updateMenuBar() {
 		edt.importJSON()
 	})
 
+	importMenu.AddAction("Import encrypted file...", func() {
+		edt.importEncrypted()
+	})
+
+	importMenu.AddAction("Import from CPS installer...", func() {
+		edt.importCPSArchive()
+	})
+
+	importMenu.AddAction("Import contacts (vCard or CSV)...", func() {
+		edt.importContacts()
+	}).SetEnabled(cp != nil)
+
+	importMenu.AddAction("Apply overlay file...", func() {
+		edt.applyOverlay()
+	}).SetEnabled(cp != nil)
+
+	importMenu.AddAction("Import zone bundle...", func() {
+		edt.importZoneBundle()
+	}).SetEnabled(cp != nil)
+
+	importMenu.AddAction("Import from snippet index...", func() {
+		edt.importSnippet()
+	}).SetEnabled(cp != nil)
+
 	exportMenu := menu.AddMenu("Export...")
 	exportMenu.SetEnabled(cp != nil)
 
@@ -599,6 +705,34 @@ func (edt *editor) updateMenuBar() {
 		edt.exportJSON()
 	})
 
+	exportMenu.AddAction("Export cheat sheet (HTML)...", func() {
+		edt.exportHTML()
+	})
+
+	exportMenu.AddAction("Export to Markdown...", func() {
+		edt.exportMarkdown()
+	})
+
+	exportMenu.AddAction("Export to OpenRTX...", func() {
+		edt.exportOpenRTX()
+	})
+
+	exportMenu.AddAction("Export encrypted...", func() {
+		edt.exportEncrypted()
+	})
+
+	exportMenu.AddAction("Export zone bundle...", func() {
+		edt.exportZoneBundle()
+	})
+
+	menu.AddAction("Capacity Report...", func() {
+		edt.capacityReport()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Unknown Regions...", func() {
+		edt.unknownRegions()
+	}).SetEnabled(cp != nil)
+
 	menu.AddSeparator()
 
 	menu.AddAction("Save", func() {
@@ -658,6 +792,60 @@ func (edt *editor) updateMenuBar() {
 		gpsSystems(edt)
 	}).SetEnabled(cp != nil && settings.displayGPS)
 
+	menu.AddAction("Add Hotspot...", func() {
+		edt.hotspotWizard()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Add Pasted Repeaters...", func() {
+		edt.addPastedRepeaters()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Sync Scan Lists with Zones", func() {
+		edt.syncScanLists(true)
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Optimize RX Group Lists...", func() {
+		edt.optimizeGroupLists()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Talkgroup Usage...", func() {
+		edt.talkgroupUsage()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Check Channel Policies...", func() {
+		edt.checkPolicies()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Check Rules...", func() {
+		edt.checkRules()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Rename Talkgroups...", func() {
+		edt.renameTalkgroups()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Merge Duplicate Channels...", func() {
+		edt.mergeDuplicateChannels()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Check Analog Channels...", func() {
+		edt.checkAnalogChannels()
+	}).SetEnabled(cp != nil)
+
+	menu.AddAction("Check Digital Channels...", func() {
+		edt.checkDigitalChannels()
+	}).SetEnabled(cp != nil)
+
+	lockText := "Override Locks"
+	if cp != nil && cp.LockOverride() {
+		lockText = "Enforce Locks"
+	}
+	menu.AddAction(lockText, func() {
+		cp.SetLockOverride(!cp.LockOverride())
+		edt.updateMenuBar()
+		ui.ResetWindows(cp)
+	}).SetEnabled(cp != nil)
+
 	edt.undoAction = menu.AddAction("Undo", func() {
 		edt.codeplug.UndoChange()
 	})
@@ -854,6 +1042,9 @@ func (edt *editor) exportText() {
 	saveSettings()
 
 	err := edt.codeplug.ExportText(filename)
+	if err == nil {
+		err = signExport(filename)
+	}
 	if err != nil {
 		title := fmt.Sprintf("Export to %s", filename)
 		ui.ErrorPopup(title, err.Error())
@@ -905,6 +1096,66 @@ func (edt *editor) exportXLSX() {
 	}
 }
 
+func (edt *editor) exportHTML() {
+	dir := settings.codeplugDirectory
+	base := baseFilename(edt.codeplug.Filename())
+	ext := "html"
+	dir = filepath.Join(dir, base+"."+ext)
+	filename := ui.SaveFilename("Export cheat sheet to HTML file", dir, ext)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	err := edt.codeplug.ExportHTML(filename)
+	if err != nil {
+		title := fmt.Sprintf("Export to %s", filename)
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+}
+
+func (edt *editor) exportMarkdown() {
+	dir := settings.codeplugDirectory
+	base := baseFilename(edt.codeplug.Filename())
+	ext := "md"
+	dir = filepath.Join(dir, base+"."+ext)
+	filename := ui.SaveFilename("Export to Markdown file", dir, ext)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	err := edt.codeplug.ExportMarkdown(filename)
+	if err != nil {
+		title := fmt.Sprintf("Export to %s", filename)
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+}
+
+func (edt *editor) exportOpenRTX() {
+	dir := settings.codeplugDirectory
+	base := baseFilename(edt.codeplug.Filename())
+	ext := "json"
+	dir = filepath.Join(dir, base+"-openrtx."+ext)
+	filename := ui.SaveFilename("Export to OpenRTX file", dir, ext)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	err := edt.codeplug.ExportOpenRTX(filename)
+	if err != nil {
+		title := fmt.Sprintf("Export to %s", filename)
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+}
+
 func (edt *editor) importJSON() {
 	dir := settings.codeplugDirectory
 	filename := ui.OpenJSONFilename("Import JSON file", dir)
@@ -917,6 +1168,47 @@ func (edt *editor) importJSON() {
 	newEditor(edt.app, codeplug.FileTypeJSON, filename)
 }
 
+// signExport adds provenance to an exported text or JSON file, if an
+// author or signing key has been set in the preferences.
+func signExport(filename string) error {
+	if settings.signingAuthor == "" && settings.signingKeyFile == "" {
+		return nil
+	}
+
+	var key ed25519.PrivateKey
+	if settings.signingKeyFile != "" {
+		var err error
+		key, err = codeplug.ReadPrivateKeyFile(settings.signingKeyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	provenance := codeplug.Provenance{
+		Author: settings.signingAuthor,
+		Tool:   "editcp " + version,
+	}
+
+	return codeplug.SignFile(filename, provenance, key)
+}
+
+func (edt *editor) applyOverlay() {
+	dir := settings.codeplugDirectory
+	filename := ui.OpenOverlayFilename("Apply overlay file", dir)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	err := edt.codeplug.ApplyOverlayFile(filename)
+	if err != nil {
+		ui.ErrorPopup("Apply overlay", err.Error())
+	}
+
+	ui.ResetWindows(edt.codeplug)
+}
+
 func (edt *editor) exportJSON() {
 	dir := settings.codeplugDirectory
 	base := baseFilename(edt.codeplug.Filename())
@@ -930,6 +1222,9 @@ func (edt *editor) exportJSON() {
 	saveSettings()
 
 	err := edt.codeplug.ExportJSON(filename)
+	if err == nil {
+		err = signExport(filename)
+	}
 	if err != nil {
 		title := fmt.Sprintf("Export to %s", filename)
 		ui.ErrorPopup(title, err.Error())
@@ -937,6 +1232,60 @@ func (edt *editor) exportJSON() {
 	}
 }
 
+func (edt *editor) capacityReport() {
+	report := edt.codeplug.Report()
+	ui.InfoPopup("Capacity Report", report.String())
+}
+
+// unknownRegions shows a hex dump of the bytes of the codeplug that
+// are not yet described by any field, and offers to export it.
+func (edt *editor) unknownRegions() {
+	cp := edt.codeplug
+	title := "Unknown Regions"
+
+	var buf bytes.Buffer
+	err := cp.WriteUnknownRegions(&buf)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	dialog := ui.NewDialog(title)
+	dialog.AddWidget(ui.NewTextViewWidget(buf.String()))
+
+	row := dialog.AddHbox()
+
+	exportButton := ui.NewButtonWidget("Export...", func() {
+		dialog.Accept()
+	})
+	row.AddWidget(exportButton)
+
+	closeButton := ui.NewButtonWidget("Close", func() {
+		dialog.Reject()
+	})
+	row.AddWidget(closeButton)
+
+	if !dialog.Exec() {
+		return
+	}
+
+	dir := settings.codeplugDirectory
+	base := baseFilename(cp.Filename())
+	ext := "txt"
+	dir = filepath.Join(dir, base+"-unknown."+ext)
+	filename := ui.SaveFilename("Export unknown regions", dir, ext)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	err = cp.ExportUnknownRegions(filename)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+	}
+}
+
 func about() {
 	msg := fmt.Sprintf("editcp Version %s\n", version)
 	msg += `
@@ -1104,6 +1453,20 @@ func addRecordSelector(box *ui.VBox, writable bool) {
 				return
 			}
 		})
+
+		if cp.Record(rType).NameField() != nil {
+			row.AddSpace(3)
+			rename := row.AddButton("Rename...")
+			rename.ConnectClicked(func() {
+				renameRecords(cp, rl.SelectedRecords())
+			})
+
+			row.AddSpace(3)
+			clone := row.AddButton("Clone...")
+			clone.ConnectClicked(func() {
+				cloneRecord(cp, currentRecord(w))
+			})
+		}
 	}
 
 	row.AddFiller()
@@ -1138,6 +1501,23 @@ func loadSettings() {
 	settings.frequencyRange = as.String("frequencyRange", "")
 	settings.displayGPS = as.Bool("displayGPS", true)
 	settings.suppressWarnings = as.Bool("suppressWarnings", false)
+	settings.syncScanLists = as.Bool("syncScanLists", false)
+	settings.signingAuthor = as.String("signingAuthor", "")
+	settings.signingKeyFile = as.String("signingKeyFile", "")
+	settings.language = as.String("language", "")
+	settings.rebootAfterWrite = as.Bool("rebootAfterWrite", false)
+	settings.trackChanges = as.Bool("trackChanges", false)
+	settings.strictParsing = as.Bool("strictParsing", false)
+	settings.radioBackup = as.Bool("radioBackup", true)
+	settings.radioBackupKeep = as.Int("radioBackupKeep", codeplug.DefaultRadioBackupKeep)
+	settings.profile = as.String("profile", "")
+	settings.policiesFile = as.String("policiesFile", "")
+	settings.rulesFile = as.String("rulesFile", "")
+	settings.userdbContacts = as.Bool("userdbContacts", false)
+	settings.talkgroupNamesFile = as.String("talkgroupNamesFile", "")
+	settings.abbreviationsFile = as.String("abbreviationsFile", "")
+	settings.snippetIndexURL = as.String("snippetIndexURL", "")
+	settings.snippetTrustedKeyFile = as.String("snippetTrustedKeyFile", "")
 
 	size := as.BeginReadArray("recentFiles")
 	settings.recentFiles = make([]string, size)
@@ -1158,6 +1538,23 @@ func saveSettings() {
 	as.SetString("frequencyRange", settings.frequencyRange)
 	as.SetBool("displayGPS", settings.displayGPS)
 	as.SetBool("suppressWarnings", settings.suppressWarnings)
+	as.SetBool("syncScanLists", settings.syncScanLists)
+	as.SetString("signingAuthor", settings.signingAuthor)
+	as.SetString("signingKeyFile", settings.signingKeyFile)
+	as.SetString("language", settings.language)
+	as.SetBool("rebootAfterWrite", settings.rebootAfterWrite)
+	as.SetBool("trackChanges", settings.trackChanges)
+	as.SetBool("strictParsing", settings.strictParsing)
+	as.SetBool("radioBackup", settings.radioBackup)
+	as.SetInt("radioBackupKeep", settings.radioBackupKeep)
+	as.SetString("profile", settings.profile)
+	as.SetString("policiesFile", settings.policiesFile)
+	as.SetString("rulesFile", settings.rulesFile)
+	as.SetBool("userdbContacts", settings.userdbContacts)
+	as.SetString("talkgroupNamesFile", settings.talkgroupNamesFile)
+	as.SetString("abbreviationsFile", settings.abbreviationsFile)
+	as.SetString("snippetIndexURL", settings.snippetIndexURL)
+	as.SetString("snippetTrustedKeyFile", settings.snippetTrustedKeyFile)
 
 	as.BeginWriteArray("recentFiles", len(settings.recentFiles))
 	for i, name := range settings.recentFiles {
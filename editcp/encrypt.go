@@ -0,0 +1,125 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// passwordDialog asks for the password of an encrypted codeplug file.
+// If confirm is true, the password must be entered twice.  The empty
+// string is returned if the dialog is cancelled.
+func passwordDialog(filename string, confirm bool) string {
+	title := fmt.Sprintf("Password for %s", filepath.Base(filename))
+
+	for {
+		dialog := ui.NewDialog(title)
+
+		password := ""
+		again := ""
+
+		row := dialog.AddHbox()
+		form := row.AddForm()
+		form.AddRow("Password:", ui.NewPasswordWidget(func(s string) {
+			password = s
+		}))
+		if confirm {
+			form.AddRow("Confirm password:", ui.NewPasswordWidget(func(s string) {
+				again = s
+			}))
+		}
+		dialog.AddSpace(2)
+
+		row = dialog.AddHbox()
+
+		cancelButton := ui.NewButtonWidget("Cancel", func() {
+			dialog.Reject()
+		})
+		row.AddWidget(cancelButton)
+
+		okButton := ui.NewButtonWidget("OK", func() {
+			dialog.Accept()
+		})
+		row.AddWidget(okButton)
+
+		if !dialog.Exec() {
+			return ""
+		}
+
+		switch {
+		case password == "":
+			ui.ErrorPopup(title, "The password must not be empty.")
+		case confirm && password != again:
+			ui.ErrorPopup(title, "The passwords do not match.")
+		default:
+			return password
+		}
+	}
+}
+
+func (edt *editor) exportEncrypted() {
+	dir := settings.codeplugDirectory
+	base := baseFilename(edt.codeplug.Filename())
+	ext := "enc"
+	dir = filepath.Join(dir, base+"."+ext)
+	filename := ui.SaveFilename("Export to encrypted file", dir, ext)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	password := passwordDialog(filename, true)
+	if password == "" {
+		return
+	}
+
+	err := edt.codeplug.ExportEncrypted(filename, password)
+	if err != nil {
+		title := fmt.Sprintf("Export to %s", filename)
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+}
+
+func (edt *editor) importEncrypted() {
+	dir := settings.codeplugDirectory
+	filename := ui.OpenEncryptedFilename("Import encrypted file", dir)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	if !codeplug.IsEncryptedFile(filename) {
+		ui.ErrorPopup("Import encrypted file", filename+" is not an encrypted codeplug file")
+		return
+	}
+
+	newEditor(edt.app, codeplug.FileTypeJSON, filename)
+}
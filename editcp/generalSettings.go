@@ -25,7 +25,7 @@ package main
 
 import (
 	"github.com/dalefarnsworth/codeplug/codeplug"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 )
 
 func generalSettings(edt *editor) {
@@ -35,82 +35,29 @@ func generalSettings(edt *editor) {
 
 func gsRecord(edt *editor, recordBox *ui.HBox) {
 	r := currentRecord(recordBox.Window())
+	groups := edt.codeplug.FieldGroups(r.Type())
 
+	fieldCount := 0
+	for _, group := range groups {
+		fieldCount += len(group.FieldTypes)
+	}
+
+	// Fill the left column with groups until it holds about half of
+	// the fields, then fill the right column.
 	mainBox := recordBox.AddVbox()
 	row := mainBox.AddHbox()
-
 	column := row.AddVbox()
-	groupBox := column.AddGroupbox("Save")
-	form := groupBox.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsSavePreamble,
-		codeplug.FtGsSaveModeReceive,
-	)
-
-	groupBox = column.AddGroupbox("Alert Tone")
-	form = groupBox.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsDisableAllTones,
-		codeplug.FtGsChFreeIndicationTone,
-		codeplug.FtGsTalkPermitTone,
-		codeplug.FtGsCallAlertToneDuration,
-	)
-
-	groupBox = column.AddGroupbox("Scan")
-	form = groupBox.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsScanDigitalHangTime,
-		codeplug.FtGsScanAnalogHangTime,
-	)
-
-	groupBox = column.AddGroupbox("Lone Worker")
-	form = groupBox.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsLoneWorkerResponseTime,
-		codeplug.FtGsLoneWorkerReminderTime,
-	)
-
-	groupBox = column.AddGroupbox("Power On Password")
-	form = groupBox.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsPwAndLockEnable,
-	)
-	form.AddFieldRows(r,
-		codeplug.FtGsPowerOnPassword,
-	)
-
-	column = row.AddVbox()
-	form = column.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsRadioName,
-		codeplug.FtGsRadioID,
-		codeplug.FtGsMonitorType,
-		codeplug.FtGsVoxSensitivity,
-		codeplug.FtGsTxPreambleDuration,
-		codeplug.FtGsRxLowBatteryInterval,
-		codeplug.FtGsBacklightColor,
-		codeplug.FtGsFreqChannelMode,
-		codeplug.FtGsModeSelect,
-		codeplug.FtGsLockUnlock,
-		codeplug.FtGsPcProgPassword,
-		codeplug.FtGsRadioProgPassword,
-		codeplug.FtGsBacklightTime,
-		codeplug.FtGsSetKeypadLockTime,
-		codeplug.FtGsDisableAllLeds,
-	)
-
-	groupBox = column.AddGroupbox("Talkaround")
-	form = groupBox.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsGroupCallHangTime,
-		codeplug.FtGsPrivateCallHangTime,
-	)
-
-	groupBox = column.AddGroupbox("Intro Screen")
-	form = groupBox.AddForm()
-	form.AddFieldRows(r,
-		codeplug.FtGsIntroScreen,
-		codeplug.FtGsIntroScreenLine1,
-		codeplug.FtGsIntroScreenLine2,
-	)
+	leftFields := 0
+	left := true
+	for _, group := range groups {
+		if left && leftFields != 0 && leftFields+len(group.FieldTypes)/2 > fieldCount/2 {
+			column = row.AddVbox()
+			left = false
+		}
+		leftFields += len(group.FieldTypes)
+
+		groupBox := column.AddGroupbox(group.Name)
+		form := groupBox.AddForm()
+		form.AddFieldRows(r, group.FieldTypes...)
+	}
 }
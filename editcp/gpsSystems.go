@@ -25,7 +25,7 @@ package main
 
 import (
 	"github.com/dalefarnsworth/codeplug/codeplug"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 )
 
 func gpsSystems(edt *editor) {
@@ -24,8 +24,11 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/dalefarnsworth/codeplug/codeplug"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 )
 
 func groupLists(edt *editor) {
@@ -38,3 +41,102 @@ func glRecord(edt *editor, recordBox *ui.HBox) {
 	addFieldMembers(column, &settings.sortAvailableContacts,
 		codeplug.FtGlName, codeplug.FtGlContact, "Contacts")
 }
+
+// optimizeGroupLists reports the RX group lists proposed for the
+// codeplug's channels and replaces the current ones if the user agrees.
+func (edt *editor) optimizeGroupLists() {
+	cp := edt.codeplug
+	title := "Optimize RX Group Lists"
+
+	a, err := cp.AnalyzeGroupLists()
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+	if len(a.Plans) == 0 {
+		ui.InfoPopup(title, "No channel transmits on a talkgroup.")
+		return
+	}
+
+	msg := a.String() + "\nReplace the RX group lists?"
+	if ui.YesNoPopup(title, msg) != ui.PopupYes {
+		return
+	}
+
+	_, err = cp.OptimizeGroupLists()
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+	}
+
+	ui.ResetWindows(cp)
+}
+
+// talkgroupUsage reports the contacts used by no channel and the
+// channels whose talkgroup is not in their RX group list, offering to
+// fix them.
+func (edt *editor) talkgroupUsage() {
+	cp := edt.codeplug
+	title := "Talkgroup Usage"
+
+	u := cp.AnalyzeTalkgroupUsage()
+
+	dialog := ui.NewDialog(title)
+	dialog.AddWidget(ui.NewTextViewWidget(u.String()))
+
+	removeUnused := false
+	keepListed := true
+	addUnheard := false
+
+	form := dialog.AddForm()
+	if len(u.Unused) != 0 {
+		form.AddRow("Remove contacts used by no channel:", ui.NewCheckboxWidget(removeUnused, func(b bool) {
+			removeUnused = b
+		}))
+		form.AddRow("Keep contacts in RX group lists:", ui.NewCheckboxWidget(keepListed, func(b bool) {
+			keepListed = b
+		}))
+	}
+	if len(u.Unheard) != 0 {
+		form.AddRow("Add talkgroups to channels' RX group lists:", ui.NewCheckboxWidget(addUnheard, func(b bool) {
+			addUnheard = b
+		}))
+	}
+	dialog.AddSpace(2)
+
+	row := dialog.AddHbox()
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Apply", func() {
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() || (!removeUnused && !addUnheard) {
+		return
+	}
+
+	var msgs []string
+	if addUnheard {
+		added, err := cp.AddUnheardTalkgroups()
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+		}
+		msgs = append(msgs, fmt.Sprintf("Talkgroups added for %d of %d channels.",
+			len(added), len(u.Unheard)))
+	}
+	if removeUnused {
+		removed, err := cp.RemoveUnusedContacts(keepListed)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+		}
+		if len(removed) != 0 {
+			msgs = append(msgs, "Removed contacts: "+strings.Join(removed, ", "))
+		}
+	}
+
+	ui.ResetWindows(cp)
+
+	if len(msgs) != 0 {
+		ui.InfoPopup(title, strings.Join(msgs, "\n"))
+	}
+}
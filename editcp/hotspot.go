@@ -0,0 +1,134 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+func (edt *editor) hotspotWizard() {
+	cp := edt.codeplug
+
+	dialog := ui.NewDialog("Add Hotspot")
+
+	name := "Hotspot"
+	rxFrequency := ""
+	txFrequency := ""
+	colorCode := 1
+	slot := "2"
+	talkgroups := "91:Worldwide,9990:Parrot:private"
+	channelName := "{tg.name}"
+	if s, ok := profileOption("addHotspot", "channelName"); ok {
+		channelName = s
+	}
+
+	row := dialog.AddHbox()
+	groupBox := row.AddGroupbox("Hotspot")
+	form := groupBox.AddForm()
+
+	form.AddRow("Name:", ui.NewLineEditWidget(name, func(s string) {
+		name = s
+	}))
+	form.AddRow("Receive frequency (MHz):", ui.NewLineEditWidget(rxFrequency, func(s string) {
+		rxFrequency = s
+	}))
+	form.AddRow("Transmit frequency, if not simplex (MHz):", ui.NewLineEditWidget(txFrequency, func(s string) {
+		txFrequency = s
+	}))
+	form.AddRow("Color code:", ui.NewSpinboxWidget(colorCode, 0, 15, func(i int) {
+		colorCode = i
+	}))
+	form.AddRow("Time slot:", ui.NewComboboxWidget(slot, []string{"1", "2"}, func(s string) {
+		slot = s
+	}))
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Talkgroups")
+	form = groupBox.AddForm()
+
+	form.AddRow("Talkgroups (id:name[:private],...):", ui.NewLineEditWidget(talkgroups, func(s string) {
+		talkgroups = s
+	}))
+	form.AddRow("Channel name template:", ui.NewLineEditWidget(channelName, func(s string) {
+		channelName = s
+	}))
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+
+	cancelButton := ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	})
+	row.AddWidget(cancelButton)
+
+	okButton := ui.NewButtonWidget("Add", func() {
+		dialog.Accept()
+	})
+	row.AddWidget(okButton)
+
+	if !dialog.Exec() {
+		return
+	}
+
+	title := "Add Hotspot"
+
+	hotspot := codeplug.Hotspot{
+		Name:                name,
+		ColorCode:           colorCode,
+		ChannelNameTemplate: channelName,
+	}
+
+	var err error
+	hotspot.RxFrequency, err = strconv.ParseFloat(strings.TrimSpace(rxFrequency), 64)
+	if err != nil {
+		ui.ErrorPopup(title, "bad receive frequency")
+		return
+	}
+	if strings.TrimSpace(txFrequency) != "" {
+		hotspot.TxFrequency, err = strconv.ParseFloat(strings.TrimSpace(txFrequency), 64)
+		if err != nil {
+			ui.ErrorPopup(title, "bad transmit frequency")
+			return
+		}
+	}
+	hotspot.Slot, _ = strconv.Atoi(slot)
+
+	hotspot.Talkgroups, err = codeplug.ParseTalkgroups(talkgroups)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	err = cp.AddHotspot(&hotspot)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+	}
+
+	ui.ResetWindows(cp)
+}
@@ -0,0 +1,93 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+	"github.com/dalefarnsworth/codeplug/userdb"
+)
+
+// identityDialog offers to fill in a new codeplug's radio ID, radio
+// name and intro screen lines from the operator's radioid.net
+// registration.
+func (edt *editor) identityDialog() {
+	title := "Radio Identity"
+
+	for {
+		dialog := ui.NewDialog(title)
+
+		radioID := ""
+
+		dialog.AddLabel("Enter your DMR ID to fill in the radio ID, radio name\nand intro screen from your radioid.net registration.")
+		row := dialog.AddHbox()
+		form := row.AddForm()
+		form.AddRow("Your DMR ID:", ui.NewLineEditWidget("", func(s string) {
+			radioID = s
+		}))
+		dialog.AddSpace(2)
+
+		row = dialog.AddHbox()
+
+		skipButton := ui.NewButtonWidget("Skip", func() {
+			dialog.Reject()
+		})
+		row.AddWidget(skipButton)
+
+		lookupButton := ui.NewButtonWidget("Look up", func() {
+			dialog.Accept()
+		})
+		row.AddWidget(lookupButton)
+
+		if !dialog.Exec() {
+			return
+		}
+
+		id, err := codeplug.ParseDmrID(radioID)
+		if err != nil {
+			msg := fmt.Sprintf("invalid DMR ID %q: %s", radioID, err.Error())
+			ui.ErrorPopup(title, msg)
+			continue
+		}
+
+		user, err := userdb.New().LookupUser(id)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+			continue
+		}
+
+		err = edt.codeplug.SetIdentity(codeplug.Identity{
+			RadioID:          user.ID,
+			RadioName:        user.Callsign,
+			IntroScreenLine1: user.Callsign,
+			IntroScreenLine2: user.FirstName(),
+		})
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+		}
+		return
+	}
+}
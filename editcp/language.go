@@ -0,0 +1,54 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"github.com/dalefarnsworth/codeplug/internal/i18n"
+)
+
+// loadLanguage loads the user's message catalogs and selects the
+// preferred language, or the system's language if there is none.
+func loadLanguage() {
+	err := i18n.LoadUserCatalogs()
+	if err != nil {
+		logPrint(err.Error())
+	}
+
+	err = i18n.SetLanguage(settings.language)
+	if err != nil && settings.language != "" {
+		logPrint(err.Error())
+	}
+}
+
+// setLanguage selects the language of the editors' menus, buttons and
+// windows opened from now on.
+func setLanguage(language string) {
+	settings.language = language
+	loadLanguage()
+
+	for _, edt := range editors {
+		edt.updateMenuBar()
+		edt.updateButtons()
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+func (edt *editor) addPastedRepeaters() {
+	cp := edt.codeplug
+	title := "Add Pasted Repeaters"
+
+	dialog := ui.NewDialog(title)
+
+	listing := ""
+	channelName := "{callsign} {city}"
+	talkgroup := "9:Local"
+
+	dialog.AddLabel("Paste repeaters copied from RepeaterBook or Brandmeister pages,\nor lines such as \"439.9875 -9.4 CC1\":")
+	dialog.AddWidget(ui.NewTextEditWidget(listing, func(s string) {
+		listing = s
+	}))
+
+	form := dialog.AddForm()
+	form.AddRow("Channel name template:", ui.NewLineEditWidget(channelName, func(s string) {
+		channelName = s
+	}))
+	form.AddRow("DMR talkgroup (id:name):", ui.NewLineEditWidget(talkgroup, func(s string) {
+		talkgroup = s
+	}))
+	dialog.AddSpace(2)
+
+	row := dialog.AddHbox()
+
+	cancelButton := ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	})
+	row.AddWidget(cancelButton)
+
+	nextButton := ui.NewButtonWidget("Next", func() {
+		dialog.Accept()
+	})
+	row.AddWidget(nextButton)
+
+	if !dialog.Exec() {
+		return
+	}
+
+	tg, err := codeplug.ParseTalkgroup(talkgroup)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	repeaters, err := codeplug.ParseRepeaterListing(listing)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	for i := range repeaters {
+		rptr := &repeaters[i]
+
+		name, err := cp.RepeaterChannelName(rptr, channelName)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+			break
+		}
+
+		add, quit := confirmRepeater(fmt.Sprintf("%s (%d of %d)", title, i+1, len(repeaters)), rptr, &name)
+		if quit {
+			break
+		}
+		if !add {
+			continue
+		}
+
+		err = cp.AddRepeaterChannel(rptr, name, tg)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+		}
+	}
+
+	ui.ResetWindows(cp)
+}
+
+// confirmRepeater shows the fields parsed for a repeater, allowing them
+// and the name of its channel to be corrected.  It returns whether the
+// channel is to be added, and whether no further repeaters are.
+func confirmRepeater(title string, rptr *codeplug.Repeater, name *string) (add bool, quit bool) {
+	for {
+		dialog := ui.NewDialog(title)
+
+		description := rptr.String()
+		form := dialog.AddForm()
+		form.AddRow("Repeater:", ui.NewLineEditWidget(description, func(s string) {
+			description = s
+		}))
+		form.AddRow("Channel name:", ui.NewLineEditWidget(*name, func(s string) {
+			*name = s
+		}))
+		dialog.AddSpace(2)
+
+		row := dialog.AddHbox()
+
+		row.AddWidget(ui.NewButtonWidget("Stop", func() {
+			quit = true
+			dialog.Reject()
+		}))
+		row.AddWidget(ui.NewButtonWidget("Skip", func() {
+			dialog.Reject()
+		}))
+		row.AddWidget(ui.NewButtonWidget("Add", func() {
+			dialog.Accept()
+		}))
+
+		if !dialog.Exec() {
+			return false, quit
+		}
+
+		edited, err := codeplug.ParseRepeaterListing(description)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+			continue
+		}
+		*rptr = edited[0]
+
+		return true, false
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// setPolicies makes the codeplug's channels subject to the policies in
+// the policies file chosen in the preferences, if any.  Violations are
+// then warned of when the codeplug is saved.
+func (edt *editor) setPolicies() {
+	cp := edt.codeplug
+	if cp == nil {
+		return
+	}
+
+	var policies []*codeplug.Policy
+	var err error
+	if settings.policiesFile != "" {
+		policies, err = codeplug.LoadPolicies(settings.policiesFile)
+	}
+	if err == nil {
+		err = cp.SetPolicies(policies)
+	}
+	if err != nil {
+		ui.ErrorPopup("Channel Policies", err.Error())
+	}
+}
+
+// checkPolicies lists the channel fields violating the codeplug's
+// policies, offering to set them to the values required.
+func (edt *editor) checkPolicies() {
+	cp := edt.codeplug
+	title := "Check Channel Policies"
+
+	if len(cp.Policies()) == 0 {
+		ui.InfoPopup(title, "No channel policies file is set in the preferences.")
+		return
+	}
+
+	violations := cp.PolicyViolations()
+	if len(violations) == 0 {
+		ui.InfoPopup(title, "Every channel conforms to the policies.")
+		return
+	}
+
+	strs := make([]string, len(violations))
+	for i, v := range violations {
+		strs[i] = v.String()
+	}
+
+	dialog := ui.NewDialog(title)
+	dialog.AddWidget(ui.NewTextViewWidget(strings.Join(strs, "\n")))
+	dialog.AddSpace(2)
+
+	row := dialog.AddHbox()
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Fix", func() {
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() {
+		return
+	}
+
+	remaining, err := cp.FixPolicyViolations()
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+	}
+	ui.ResetWindows(cp)
+
+	if len(remaining) != 0 {
+		strs = make([]string, len(remaining))
+		for i, v := range remaining {
+			strs[i] = v.String()
+		}
+		msg := fmt.Sprintf("These fields were not fixed, being locked or required\nto differ by another policy:\n%s",
+			strings.Join(strs, "\n"))
+		ui.InfoPopup(title, msg)
+	}
+}
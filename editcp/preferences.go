@@ -24,7 +24,11 @@
 package main
 
 import (
-	"github.com/dalefarnsworth/codeplug/ui"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/internal/i18n"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+	"github.com/dalefarnsworth/codeplug/profile"
 )
 
 func (edt *editor) preferences() {
@@ -56,6 +60,175 @@ func (edt *editor) preferences() {
 		suppressWarnings = checked
 	})
 	form.AddRow("Suppress invalid field warning messages:", checkbox)
+
+	strictParsing := settings.strictParsing
+
+	checked = strictParsing
+	checkbox = ui.NewCheckboxWidget(checked, func(checked bool) {
+		strictParsing = checked
+	})
+	form.AddRow("Reject files with unknown records or fields:", checkbox)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Scan Lists")
+	form = groupBox.AddForm()
+
+	syncScanLists := settings.syncScanLists
+
+	checked = syncScanLists
+	checkbox = ui.NewCheckboxWidget(checked, func(checked bool) {
+		syncScanLists = checked
+	})
+	form.AddRow("Sync scan lists with zones when saving:", checkbox)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Radio")
+	form = groupBox.AddForm()
+
+	rebootAfterWrite := settings.rebootAfterWrite
+
+	checked = rebootAfterWrite
+	checkbox = ui.NewCheckboxWidget(checked, func(checked bool) {
+		rebootAfterWrite = checked
+	})
+	form.AddRow("Restart radio after writing codeplug:", checkbox)
+
+	radioBackup := settings.radioBackup
+
+	checked = radioBackup
+	checkbox = ui.NewCheckboxWidget(checked, func(checked bool) {
+		radioBackup = checked
+	})
+	form.AddRow("Back up radio's codeplug before writing:", checkbox)
+
+	radioBackupKeep := settings.radioBackupKeep
+
+	spinbox := ui.NewSpinboxWidget(radioBackupKeep, 0, 1000, func(i int) {
+		radioBackupKeep = i
+	})
+	form.AddRow("Backups kept (0 keeps all):", spinbox)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Change Tracking")
+	form = groupBox.AddForm()
+
+	trackChanges := settings.trackChanges
+
+	checked = trackChanges
+	checkbox = ui.NewCheckboxWidget(checked, func(checked bool) {
+		trackChanges = checked
+	})
+	form.AddRow("Record who last changed each record:", checkbox)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Channel Policies and Rules")
+	form = groupBox.AddForm()
+
+	policiesFile := settings.policiesFile
+
+	lineEdit := ui.NewLineEditWidget(policiesFile, func(s string) {
+		policiesFile = s
+	})
+	form.AddRow("Policies file:", lineEdit)
+
+	rulesFile := settings.rulesFile
+
+	lineEdit = ui.NewLineEditWidget(rulesFile, func(s string) {
+		rulesFile = s
+	})
+	form.AddRow("Rules file:", lineEdit)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Talkgroup Names")
+	form = groupBox.AddForm()
+
+	talkgroupNamesFile := settings.talkgroupNamesFile
+
+	lineEdit = ui.NewLineEditWidget(talkgroupNamesFile, func(s string) {
+		talkgroupNamesFile = s
+	})
+	form.AddRow("Talkgroup names file:", lineEdit)
+
+	abbreviationsFile := settings.abbreviationsFile
+
+	lineEdit = ui.NewLineEditWidget(abbreviationsFile, func(s string) {
+		abbreviationsFile = s
+	})
+	form.AddRow("Abbreviations file:", lineEdit)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Signing Exported Files")
+	form = groupBox.AddForm()
+
+	signingAuthor := settings.signingAuthor
+	signingKeyFile := settings.signingKeyFile
+
+	lineEdit = ui.NewLineEditWidget(signingAuthor, func(s string) {
+		signingAuthor = s
+	})
+	form.AddRow("Author:", lineEdit)
+	lineEdit = ui.NewLineEditWidget(signingKeyFile, func(s string) {
+		signingKeyFile = s
+	})
+	form.AddRow("Private key file:", lineEdit)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Snippet Index")
+	form = groupBox.AddForm()
+
+	snippetIndexURL := settings.snippetIndexURL
+	snippetTrustedKeyFile := settings.snippetTrustedKeyFile
+
+	lineEdit = ui.NewLineEditWidget(snippetIndexURL, func(s string) {
+		snippetIndexURL = s
+	})
+	form.AddRow("Index URL:", lineEdit)
+	lineEdit = ui.NewLineEditWidget(snippetTrustedKeyFile, func(s string) {
+		snippetTrustedKeyFile = s
+	})
+	form.AddRow("Trusted public key file:", lineEdit)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Language")
+	form = groupBox.AddForm()
+
+	systemDefault := i18n.T("System default")
+	language := settings.language
+	if language == "" {
+		language = systemDefault
+	}
+	languages := append([]string{systemDefault}, i18n.Languages()...)
+
+	combobox := ui.NewComboboxWidget(language, languages, func(s string) {
+		language = s
+	})
+	form.AddRow("Language:", combobox)
+	dialog.AddSpace(2)
+
+	row = dialog.AddHbox()
+	groupBox = row.AddGroupbox("Profile")
+	form = groupBox.AddForm()
+
+	noProfile := i18n.T("None")
+	profileName := settings.profile
+	if profileName == "" {
+		profileName = noProfile
+	}
+	profileNames, _ := profile.Names()
+	profileNames = append([]string{noProfile}, profileNames...)
+
+	combobox = ui.NewComboboxWidget(profileName, profileNames, func(s string) {
+		profileName = s
+	})
+	form.AddRow("Profile:", combobox)
 	dialog.AddSpace(2)
 
 	row = dialog.AddHbox()
@@ -64,7 +237,7 @@ func (edt *editor) preferences() {
 
 	autosaveInterval := settings.autosaveInterval
 
-	spinbox := ui.NewSpinboxWidget(autosaveInterval, 0, 60, func(i int) {
+	spinbox = ui.NewSpinboxWidget(autosaveInterval, 0, 60, func(i int) {
 		autosaveInterval = i
 	})
 	form.AddRow("Auto Save interval (minutes):", spinbox)
@@ -90,8 +263,48 @@ func (edt *editor) preferences() {
 	edt.setDisplayGPS(displayGPS)
 
 	settings.suppressWarnings = suppressWarnings
+	settings.strictParsing = strictParsing
+
+	settings.syncScanLists = syncScanLists
+
+	settings.rebootAfterWrite = rebootAfterWrite
+	settings.radioBackup = radioBackup
+	settings.radioBackupKeep = radioBackupKeep
+
+	settings.trackChanges = trackChanges
+
+	settings.policiesFile = strings.TrimSpace(policiesFile)
+	settings.rulesFile = strings.TrimSpace(rulesFile)
+	settings.talkgroupNamesFile = strings.TrimSpace(talkgroupNamesFile)
+	settings.abbreviationsFile = strings.TrimSpace(abbreviationsFile)
+
+	settings.signingAuthor = strings.TrimSpace(signingAuthor)
+	settings.signingKeyFile = strings.TrimSpace(signingKeyFile)
+
+	settings.snippetIndexURL = strings.TrimSpace(snippetIndexURL)
+	settings.snippetTrustedKeyFile = strings.TrimSpace(snippetTrustedKeyFile)
+	for _, ed := range editors {
+		ed.setAuditor()
+		ed.setPolicies()
+		ed.setRules()
+		ed.setTalkgroupNames()
+		ed.setAbbreviations()
+	}
 
 	settings.autosaveInterval = autosaveInterval
 	edt.setAutosaveInterval(autosaveInterval)
+
+	if profileName == noProfile {
+		profileName = ""
+	}
+	settings.profile = profileName
+
+	if language == systemDefault {
+		language = ""
+	}
+	if language != settings.language {
+		setLanguage(language)
+	}
+
 	saveSettings()
 }
@@ -0,0 +1,113 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/profile"
+	"github.com/dalefarnsworth/codeplug/userdb"
+)
+
+// profileOption returns the value, and whether it is set, of an option
+// of the dmrRadio subcommand corresponding to an editcp action, in the
+// profile selected in the preferences.
+func profileOption(command, option string) (string, bool) {
+	if settings.profile == "" {
+		return "", false
+	}
+
+	p, err := profile.Load(settings.profile)
+	if err != nil {
+		return "", false
+	}
+
+	return p.Option(command, option)
+}
+
+// profileUsersDB returns a user database configured by the getUsers
+// options of the selected profile.
+func profileUsersDB() (*userdb.UsersDB, error) {
+	db := userdb.New()
+
+	if s, ok := profileOption("getUsers", "states"); ok {
+		style, err := userdb.ParseStateStyle(s)
+		if err != nil {
+			return nil, err
+		}
+		db.SetStateStyle(style)
+	}
+
+	if s, ok := profileOption("getUsers", "countries"); ok {
+		style, err := userdb.ParseCountryStyle(s)
+		if err != nil {
+			return nil, err
+		}
+		db.SetCountryStyle(style)
+	}
+
+	if s, ok := profileOption("getUsers", "callsigns"); ok {
+		check, err := userdb.ParseCallsignCheck(s)
+		if err != nil {
+			return nil, err
+		}
+		db.SetCallsignCheck(check)
+	}
+
+	if s, ok := profileOption("getUsers", "titleCase"); ok {
+		titleCase, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		db.SetTitleCase(titleCase)
+	}
+
+	if s, ok := profileOption("getUsers", "regions"); ok {
+		err := db.SetRegionFilter(strings.Split(s, ",")...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s, ok := profileOption("getUsers", "only"); ok {
+		db.SetCountryFilter(strings.Split(s, ",")...)
+	}
+
+	if s, ok := profileOption("getUsers", "blocklist"); ok {
+		file, err := os.Open(s)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := userdb.ReadBlocklist(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		db.SetBlocklist(entries)
+	}
+
+	return db, nil
+}
@@ -31,11 +31,12 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/dalefarnsworth/codeplug/codeplug"
 	"github.com/dalefarnsworth/codeplug/dfu"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 	"github.com/dalefarnsworth/codeplug/userdb"
 	"github.com/therecipe/qt/core"
 )
@@ -103,21 +104,62 @@ func (edt *editor) addRadioMenu(menu *ui.Menu) {
 			"Preparing to write codeplug to radio...",
 			"Writing codeplug to radio...",
 		}
-		msgIndex := 0
 
-		pd := ui.NewProgressDialog(msgs[msgIndex])
-		err := cp.WriteRadio(func(cur int) bool {
-			if cur == codeplug.MinProgress {
-				pd.SetLabelText(msgs[msgIndex])
-				msgIndex++
+		rebootTimeout := time.Duration(0)
+		if settings.rebootAfterWrite {
+			rebootTimeout = codeplug.DefaultRebootTimeout
+		}
+		cp.SetRebootAfterWrite(rebootTimeout)
+
+		err := setRadioBackup(cp)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+			return
+		}
+		if cp.RadioBackupDir() != "" {
+			msgs = []string{
+				"Preparing to write codeplug to radio...",
+				"Backing up codeplug from radio...",
+				"Writing codeplug to radio...",
 			}
-			pd.SetRange(codeplug.MinProgress, codeplug.MaxProgress)
-			pd.SetValue(cur)
-			if pd.WasCanceled() {
-				return false
+		}
+
+		var pd *ui.ProgressDialog
+		writeRadio := func() error {
+			msgIndex := 0
+			pd = ui.NewProgressDialog(msgs[msgIndex])
+			return cp.WriteRadio(func(cur int) bool {
+				if cur == codeplug.MinProgress {
+					pd.SetLabelText(msgs[msgIndex])
+					msgIndex++
+				}
+				pd.SetRange(codeplug.MinProgress, codeplug.MaxProgress)
+				pd.SetValue(cur)
+				if pd.WasCanceled() {
+					return false
+				}
+				return true
+			})
+		}
+
+		cp.SetIgnoreModelMismatch(false)
+		err = writeRadio()
+		if _, ok := err.(*codeplug.ModelMismatchError); ok {
+			pd.Close()
+			msg := fmt.Sprintf("%s\n\nWrite the codeplug anyway?", err.Error())
+			if ui.YesNoPopup(title, msg) != ui.PopupYes {
+				return
 			}
-			return true
-		})
+			cp.SetIgnoreModelMismatch(true)
+			err = writeRadio()
+			cp.SetIgnoreModelMismatch(false)
+		}
+		if err == codeplug.ErrNoReboot {
+			pd.Close()
+			msg := "Turn radio off and back on again."
+			ui.InfoPopup("Codeplug write complete", msg)
+			return
+		}
 		if err != nil {
 			pd.Close()
 			title := fmt.Sprintf("Write codeplug to radio failed: %s", err.Error())
@@ -129,7 +171,7 @@ func (edt *editor) addRadioMenu(menu *ui.Menu) {
 
 	md380toolsMenu.AddAction("Write user database to radio...", func() {
 		title := "Write user database to radio"
-		cancel, download := userdbDialog(title)
+		cancel, download := userdbDialog(title, cp)
 		if cancel {
 			return
 		}
@@ -154,7 +196,16 @@ func (edt *editor) addRadioMenu(menu *ui.Menu) {
 		pd := ui.NewProgressDialog(msgs[msgIndex])
 
 		if download {
-			err := userdb.WriteMD380ToolsFile(tmpFilename, func(cur int) bool {
+			db, err := profileUsersDB()
+			if err != nil {
+				pd.Close()
+				ui.ErrorPopup(title, err.Error())
+				return
+			}
+			if settings.userdbContacts && cp != nil && cp.Loaded() {
+				db.AddCustomUsers(contactUsers(cp))
+			}
+			err = db.WriteMD380ToolsFile(tmpFilename, func(cur int) bool {
 				if cur == userdb.MinProgress {
 					pd.SetLabelText(msgs[msgIndex])
 					msgIndex++
@@ -200,6 +251,91 @@ func (edt *editor) addRadioMenu(menu *ui.Menu) {
 		}
 	})
 
+	md380toolsMenu.AddAction("Extract user database from flash dump...", func() {
+		title := "Extract user database from flash dump"
+		dumpFilename := ui.OpenFlashDumpFilename(title, settings.codeplugDirectory)
+		if dumpFilename == "" {
+			return
+		}
+
+		dump, err := ioutil.ReadFile(dumpFilename)
+		if err == nil {
+			var users []byte
+			users, err = codeplug.FlashDumpUsers(dump)
+			if err == nil {
+				dir := filepath.Join(settings.codeplugDirectory, "users.csv")
+				filename := ui.SaveFilename(title, dir, "csv")
+				if filename == "" {
+					return
+				}
+				err = ioutil.WriteFile(filename, users, 0644)
+			}
+		}
+		if err != nil {
+			ui.ErrorPopup(title+" failed", err.Error())
+		}
+	})
+
+	md380toolsMenu.AddAction("Save radio calibration to file...", func() {
+		title := "Save radio calibration to file"
+		ext := "cal"
+		dir := filepath.Join(settings.codeplugDirectory, "calibration."+ext)
+		filename := ui.SaveFilename(title, dir, ext)
+		if filename == "" {
+			return
+		}
+
+		msgs := []string{
+			"Preparing to read calibration from radio...",
+			"Reading calibration from radio...",
+		}
+
+		err := calibrationTransfer(msgs, func(df *dfu.Dfu) error {
+			file, err := os.Create(filename)
+			if err != nil {
+				return err
+			}
+			err = df.DumpCalibration(file)
+			cerr := file.Close()
+			if err == nil {
+				err = cerr
+			}
+			return err
+		})
+		if err != nil {
+			ui.ErrorPopup(title+" failed", err.Error())
+		}
+	})
+
+	md380toolsMenu.AddAction("Restore radio calibration from file (dangerous)...", func() {
+		title := "Restore radio calibration from file"
+		msg := "DANGER: This replaces the radio's calibration.\n" +
+			"Calibration saved from another radio, or damaged calibration,\n" +
+			"leaves the radio off frequency or at the wrong power.\n\n" +
+			"Restore the radio's calibration?"
+		if ui.YesNoPopup(title, msg) != ui.PopupYes {
+			return
+		}
+
+		filename := ui.OpenCalibrationFilename(title, settings.codeplugDirectory)
+		if filename == "" {
+			return
+		}
+
+		msgs := []string{
+			"Reading the radio's flash memory...",
+			"Erasing the radio's flash memory for calibration...",
+			"Writing calibration to radio...",
+		}
+
+		err := calibrationTransfer(msgs, func(df *dfu.Dfu) error {
+			return df.WriteCalibration(filename)
+		})
+		if err != nil {
+			ui.ErrorPopup(title+" failed", err.Error())
+		}
+	})
+
 	md380toolsMenu.AddAction("Write md380tools firmware to radio...", func() {
 		path := "https://farnsworth.org/dale/md380tools/"
 		nonGpsUrl := path + "firmware/D13.20.bin"
@@ -267,6 +403,73 @@ func (edt *editor) addRadioMenu(menu *ui.Menu) {
 	})
 }
 
+// setRadioBackup sets where, and whether, the radio's codeplug is
+// saved before cp is written, from the preferences.
+// The selected profile's writeCodeplug options override them.
+func setRadioBackup(cp *codeplug.Codeplug) error {
+	backup := settings.radioBackup
+	if s, ok := profileOption("writeCodeplug", "noBackup"); ok {
+		noBackup, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		backup = !noBackup
+	}
+
+	keep := settings.radioBackupKeep
+	if s, ok := profileOption("writeCodeplug", "keepBackups"); ok {
+		var err error
+		keep, err = strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	dir := ""
+	if backup {
+		var ok bool
+		dir, ok = profileOption("writeCodeplug", "backupDir")
+		if !ok {
+			var err error
+			dir, err = codeplug.DefaultRadioBackupDir()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return cp.SetRadioBackup(dir, keep)
+}
+
+// calibrationTransfer connects to the radio and calls transfer,
+// showing its progress with msgs.
+func calibrationTransfer(msgs []string, transfer func(df *dfu.Dfu) error) error {
+	msgIndex := 0
+	pd := ui.NewProgressDialog(msgs[msgIndex])
+
+	df, err := dfu.New(func(cur int) bool {
+		if cur == dfu.MinProgress {
+			pd.SetLabelText(msgs[msgIndex])
+			msgIndex++
+		}
+		pd.SetRange(dfu.MinProgress, dfu.MaxProgress)
+		pd.SetValue(cur)
+		if pd.WasCanceled() {
+			return false
+		}
+		return true
+	})
+	if err == nil {
+		defer df.Close()
+		err = transfer(df)
+	}
+	if err != nil {
+		pd.Close()
+	}
+
+	return err
+}
+
 func writeFirmware(url string, msgs []string) {
 	tmpFile, err := ioutil.TempFile("", "editcp")
 	if err != nil {
@@ -342,7 +545,22 @@ func userdbFilename() string {
 	return filepath.Join(cacheDir, name)
 }
 
-func userdbDialog(title string) (canceled, download bool) {
+// contactUsers returns the users database extras for the codeplug's
+// contacts.
+func contactUsers(cp *codeplug.Codeplug) []*userdb.User {
+	var contacts []userdb.Contact
+	for _, tg := range cp.Contacts() {
+		contacts = append(contacts, userdb.Contact{
+			ID:    tg.ID,
+			Name:  tg.Name,
+			Group: !tg.Private,
+		})
+	}
+
+	return userdb.ContactUsers(contacts)
+}
+
+func userdbDialog(title string, cp *codeplug.Codeplug) (canceled, download bool) {
 	loadSettings()
 
 	usersFilename := userdbFilename()
@@ -357,6 +575,12 @@ func userdbDialog(title string) (canceled, download bool) {
 	})
 	downloadCheckbox.SetEnabled(fileExists(usersFilename))
 
+	contacts := settings.userdbContacts
+	contactsCheckbox := ui.NewCheckboxWidget(contacts, func(checked bool) {
+		contacts = checked
+	})
+	contactsCheckbox.SetEnabled(cp != nil && cp.Loaded())
+
 	dialog := ui.NewDialog(title)
 
 	filenameBox := ui.NewHbox()
@@ -371,6 +595,7 @@ md380tools firmware.  See https://github.com/travisgoodspeed/md380tools.`
 
 	form := dialog.AddForm()
 	form.AddRow("Download new users database file", downloadCheckbox)
+	form.AddRow("Add the codeplug's contacts to the download", contactsCheckbox)
 
 	dialog.AddLabel("Filename:")
 	dialog.AddExistingHbox(filenameBox)
@@ -387,6 +612,10 @@ md380tools firmware.  See https://github.com/travisgoodspeed/md380tools.`
 	row.AddWidget(saveButton)
 
 	saved := dialog.Exec()
+	if saved && cp != nil && cp.Loaded() {
+		settings.userdbContacts = contacts
+		saveSettings()
+	}
 	return !saved, download
 }
 
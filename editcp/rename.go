@@ -0,0 +1,145 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// renameRecords shows a dialog for renaming the given records by a
+// pattern, previewing their new names before they are applied.  If no
+// records are given, all records of their type are renamed.
+func renameRecords(cp *codeplug.Codeplug, records []*codeplug.Record) {
+	title := "Rename Records"
+	if len(records) == 0 {
+		return
+	}
+	if len(records) == 1 {
+		records = cp.Records(records[0].Type())
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Index() < records[j].Index()
+	})
+
+	rule := codeplug.RenameRule{
+		Pattern:     "^(.*)$",
+		Replacement: "{1}",
+		Start:       1,
+		Step:        1,
+	}
+	cases := append([]string{"unchanged"}, codeplug.RenameCases()...)
+
+	dialog := ui.NewDialog(title)
+
+	form := dialog.AddForm()
+	form.AddRow("Match (regular expression):", ui.NewLineEditWidget(rule.Pattern, func(s string) {
+		rule.Pattern = s
+	}))
+	form.AddRow("New name ({1}, {name}, {n}):", ui.NewLineEditWidget(rule.Replacement, func(s string) {
+		rule.Replacement = s
+	}))
+	form.AddRow("First number:", ui.NewSpinboxWidget(rule.Start, 0, 9999, func(i int) {
+		rule.Start = i
+	}))
+	form.AddRow("Number increment:", ui.NewSpinboxWidget(rule.Step, 1, 100, func(i int) {
+		rule.Step = i
+	}))
+	form.AddRow("Number digits:", ui.NewSpinboxWidget(rule.Width, 0, 4, func(i int) {
+		rule.Width = i
+	}))
+	form.AddRow("Case:", ui.NewComboboxWidget(cases[0], cases, func(s string) {
+		rule.Case = s
+		if s == cases[0] {
+			rule.Case = ""
+		}
+	}))
+
+	preview := ui.NewTextViewWidget("")
+	dialog.AddWidget(preview)
+
+	previewRenames := func() []codeplug.Rename {
+		renames, err := cp.PreviewRenames(records, &rule)
+		if err != nil {
+			ui.UpdateTextViewWidget(preview, err.Error())
+			return nil
+		}
+		ui.UpdateTextViewWidget(preview, renamesTable(renames))
+		return renames
+	}
+	previewRenames()
+
+	dialog.AddSpace(2)
+	row := dialog.AddHbox()
+
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Preview", func() {
+		previewRenames()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Apply", func() {
+		renames := previewRenames()
+		if renames == nil {
+			return
+		}
+		err := cp.ApplyRenames(renames)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+			return
+		}
+		dialog.Accept()
+	}))
+
+	dialog.Exec()
+}
+
+// renamesTable returns a table of the old and new names of renames.
+func renamesTable(renames []codeplug.Rename) string {
+	if len(renames) == 0 {
+		return "No records would be renamed."
+	}
+
+	width := 0
+	for _, rename := range renames {
+		if len(rename.OldName) > width {
+			width = len(rename.OldName)
+		}
+	}
+
+	var sb strings.Builder
+	for _, rename := range renames {
+		fmt.Fprintf(&sb, "%-*s -> %s", width, rename.OldName, rename.NewName)
+		if rename.Err != nil {
+			fmt.Fprintf(&sb, "  (%s)", rename.Err.Error())
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,85 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// setRules makes the codeplug's records subject to the rules in the
+// rules file chosen in the preferences, if any.  Violations are then
+// warned of when the codeplug is saved.
+func (edt *editor) setRules() {
+	cp := edt.codeplug
+	if cp == nil {
+		return
+	}
+
+	var rules []*codeplug.Rule
+	var err error
+	if settings.rulesFile != "" {
+		rules, err = codeplug.LoadRules(settings.rulesFile)
+	}
+	if err == nil {
+		err = cp.SetRules(rules)
+	}
+	if err != nil {
+		ui.ErrorPopup("Rules", err.Error())
+	}
+}
+
+// checkRules lists the records violating the codeplug's rules.
+func (edt *editor) checkRules() {
+	cp := edt.codeplug
+	title := "Check Rules"
+
+	violations := cp.RuleViolations()
+	if len(violations) == 0 {
+		if len(cp.Rules()) == 0 {
+			ui.InfoPopup(title, "No rules file is set in the preferences.")
+			return
+		}
+		ui.InfoPopup(title, "Every record follows the rules.")
+		return
+	}
+
+	strs := make([]string, len(violations))
+	for i, v := range violations {
+		strs[i] = v.String()
+	}
+
+	dialog := ui.NewDialog(title)
+	dialog.AddWidget(ui.NewTextViewWidget(strings.Join(strs, "\n")))
+	dialog.AddSpace(2)
+
+	row := dialog.AddHbox()
+	row.AddWidget(ui.NewButtonWidget("Close", func() {
+		dialog.Accept()
+	}))
+
+	dialog.Exec()
+}
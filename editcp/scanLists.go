@@ -24,8 +24,11 @@
 package main
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/dalefarnsworth/codeplug/codeplug"
-	"github.com/dalefarnsworth/codeplug/ui"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
 )
 
 func scanLists(edt *editor) {
@@ -55,3 +58,31 @@ func slRecord(edt *editor, recordBox *ui.HBox) {
 		codeplug.FtSlSignallingHoldTime,
 		codeplug.FtSlPrioritySampleTime)
 }
+
+// syncScanLists gives each zone a scan list of the same name holding
+// the zone's channels.  If assign is set, channels without a scan list
+// are given their zone's scan list.  It returns false on failure.
+func (edt *editor) syncScanLists(assign bool) bool {
+	cp := edt.codeplug
+	title := "Sync Scan Lists"
+
+	sync, err := cp.SyncScanListsWithZones(assign)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return false
+	}
+	ui.ResetWindows(cp)
+
+	if len(sync.Truncated) != 0 {
+		msg := fmt.Sprintf("These scan lists hold only the first channels of their zones:\n%s",
+			strings.Join(sync.Truncated, "\n"))
+		ui.InfoPopup(title, msg)
+	}
+	if len(sync.Locked) != 0 {
+		msg := fmt.Sprintf("These scan lists are locked and were not changed:\n%s",
+			strings.Join(sync.Locked, "\n"))
+		ui.InfoPopup(title, msg)
+	}
+
+	return true
+}
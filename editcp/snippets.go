@@ -0,0 +1,142 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/snippets"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// snippetsClient returns a client of the snippet index given by the
+// preferences, or by the environment.
+func snippetsClient() (*snippets.Client, error) {
+	indexURL := settings.snippetIndexURL
+	if indexURL == "" {
+		indexURL = os.Getenv(snippets.IndexURLEnv)
+	}
+	if indexURL == "" {
+		return nil, fmt.Errorf("no snippet index: set its URL in the preferences")
+	}
+
+	client := snippets.NewClient(indexURL)
+	if settings.snippetTrustedKeyFile != "" {
+		key, err := codeplug.ReadPublicKeyFile(settings.snippetTrustedKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		client.TrustedKeys = append(client.TrustedKeys, key)
+	}
+
+	return client, nil
+}
+
+// importSnippet shows a dialog for searching the snippet index and
+// importing a snippet's zone bundle.
+func (edt *editor) importSnippet() {
+	cp := edt.codeplug
+	title := "Import from snippet index"
+
+	client, err := snippetsClient()
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+
+	search := ""
+	name := ""
+	conflicts := codeplug.ZoneConflicts()
+	conflict := conflicts[0]
+
+	dialog := ui.NewDialog(title)
+
+	form := dialog.AddForm()
+	form.AddRow("Search words:", ui.NewLineEditWidget(search, func(s string) {
+		search = s
+	}))
+
+	results := ui.NewTextViewWidget("")
+	dialog.AddWidget(results)
+
+	showResults := func() {
+		matches, err := client.Search(strings.Fields(search))
+		if err != nil {
+			ui.UpdateTextViewWidget(results, err.Error())
+			return
+		}
+		if len(matches) == 0 {
+			ui.UpdateTextViewWidget(results, "No snippets found.")
+			return
+		}
+		var sb strings.Builder
+		for _, s := range matches {
+			sb.WriteString(s.String() + "\n")
+		}
+		ui.UpdateTextViewWidget(results, sb.String())
+	}
+	showResults()
+
+	form = dialog.AddForm()
+	form.AddRow("Snippet name:", ui.NewLineEditWidget(name, func(s string) {
+		name = s
+	}))
+	form.AddRow("Records whose names are in use:", ui.NewComboboxWidget(conflict, conflicts, func(s string) {
+		conflict = s
+	}))
+
+	dialog.AddSpace(2)
+	row := dialog.AddHbox()
+
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Search", func() {
+		showResults()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Import", func() {
+		if strings.TrimSpace(name) == "" {
+			return
+		}
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() {
+		return
+	}
+
+	renames, err := client.Import(cp, strings.TrimSpace(name), codeplug.ZoneConflict(conflict))
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+	ui.ResetWindows(cp)
+
+	if len(renames) > 0 {
+		ui.InfoPopup(title, "Records imported under new names:\n"+renamesTable(renames))
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// newCodeplugWizard guides the user from a newly created codeplug to
+// a working starter codeplug: the operator's identity, simplex
+// channels, local repeaters and a hotspot.
+func (edt *editor) newCodeplugWizard() {
+	edt.identityDialog()
+	edt.starterDialog()
+
+	msg := "Add channels for a hotspot?"
+	if ui.YesNoPopup("New Codeplug", msg) == ui.PopupYes {
+		edt.hotspotWizard()
+	}
+
+	ui.ResetWindows(edt.codeplug)
+}
+
+// starterDialog offers to add simplex channels for a region, and the
+// repeaters near the operator from a RepeaterBook file.
+func (edt *editor) starterDialog() {
+	cp := edt.codeplug
+	title := "New Codeplug"
+
+	region := "None"
+	regionNames := []string{region}
+	for _, r := range codeplug.SimplexRegions() {
+		regionNames = append(regionNames, r.Description)
+	}
+	addRepeaters := false
+	location := ""
+
+	for {
+		dialog := ui.NewDialog(title)
+
+		row := dialog.AddHbox()
+		groupBox := row.AddGroupbox("Simplex")
+		form := groupBox.AddForm()
+		form.AddRow("Region:", ui.NewComboboxWidget(region, regionNames, func(s string) {
+			region = s
+		}))
+		dialog.AddSpace(2)
+
+		row = dialog.AddHbox()
+		groupBox = row.AddGroupbox("Local Repeaters")
+		form = groupBox.AddForm()
+		form.AddRow("Add from a RepeaterBook file:", ui.NewCheckboxWidget(addRepeaters, func(b bool) {
+			addRepeaters = b
+		}))
+		form.AddRow("Your location (latitude,longitude):", ui.NewLineEditWidget(location, func(s string) {
+			location = s
+		}))
+		dialog.AddSpace(2)
+
+		row = dialog.AddHbox()
+
+		skipButton := ui.NewButtonWidget("Skip", func() {
+			dialog.Reject()
+		})
+		row.AddWidget(skipButton)
+
+		okButton := ui.NewButtonWidget("Add", func() {
+			dialog.Accept()
+		})
+		row.AddWidget(okButton)
+
+		if !dialog.Exec() {
+			return
+		}
+
+		var starter codeplug.Starter
+		for _, r := range codeplug.SimplexRegions() {
+			if r.Description == region {
+				starter.SimplexRegion = r.Name
+			}
+		}
+
+		if addRepeaters {
+			var err error
+			starter.Home, err = codeplug.ParseLocation(strings.TrimSpace(location))
+			if err != nil {
+				ui.ErrorPopup(title, err.Error())
+				continue
+			}
+
+			dir := settings.codeplugDirectory
+			filename := ui.OpenJSONFilename("Open RepeaterBook file", dir)
+			if filename == "" {
+				continue
+			}
+			settings.codeplugDirectory = filepath.Dir(filename)
+			saveSettings()
+
+			file, err := os.Open(filename)
+			if err != nil {
+				ui.ErrorPopup(title, err.Error())
+				continue
+			}
+			starter.Repeaters, err = codeplug.ReadRepeaterBook(file)
+			file.Close()
+			if err != nil {
+				ui.ErrorPopup(title, err.Error())
+				continue
+			}
+		}
+
+		err := cp.AddStarter(&starter)
+		if err != nil {
+			ui.ErrorPopup(title, err.Error())
+		}
+		return
+	}
+}
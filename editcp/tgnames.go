@@ -0,0 +1,118 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+// setTalkgroupNames sets the talkgroup name overrides in the file
+// chosen in the preferences, if any, to be used for the contacts and
+// channels added to the codeplug.
+func (edt *editor) setTalkgroupNames() {
+	cp := edt.codeplug
+	if cp == nil {
+		return
+	}
+
+	var tgNames []codeplug.TalkgroupName
+	var err error
+	if settings.talkgroupNamesFile != "" {
+		tgNames, err = codeplug.LoadTalkgroupNames(settings.talkgroupNamesFile)
+	}
+	if err == nil {
+		err = cp.SetTalkgroupNames(tgNames)
+	}
+	if err != nil {
+		ui.ErrorPopup("Talkgroup Names", err.Error())
+	}
+}
+
+// setAbbreviations sets the abbreviations in the file chosen in the
+// preferences, if any, to be used to shorten names generated for the
+// codeplug.
+func (edt *editor) setAbbreviations() {
+	cp := edt.codeplug
+	if cp == nil {
+		return
+	}
+
+	var abbrevs map[string]string
+	var err error
+	if settings.abbreviationsFile != "" {
+		abbrevs, err = codeplug.LoadAbbreviations(settings.abbreviationsFile)
+	}
+	if err == nil {
+		err = cp.SetAbbreviations(abbrevs)
+	}
+	if err != nil {
+		ui.ErrorPopup("Abbreviations", err.Error())
+	}
+}
+
+// renameTalkgroups offers to give the codeplug's group contacts the
+// names in the talkgroup names file.
+func (edt *editor) renameTalkgroups() {
+	cp := edt.codeplug
+	title := "Rename Talkgroups"
+
+	if settings.talkgroupNamesFile == "" {
+		ui.InfoPopup(title, "No talkgroup names file is set in the preferences.")
+		return
+	}
+
+	renames := cp.PreviewTalkgroupRenames()
+	if len(renames) == 0 {
+		ui.InfoPopup(title, "Every talkgroup contact has its preferred name.")
+		return
+	}
+
+	dialog := ui.NewDialog(title)
+	dialog.AddWidget(ui.NewTextViewWidget(renamesTable(renames)))
+	dialog.AddSpace(2)
+
+	row := dialog.AddHbox()
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Rename", func() {
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() {
+		return
+	}
+
+	var applied []codeplug.Rename
+	for _, rename := range renames {
+		if rename.Err == nil {
+			applied = append(applied, rename)
+		}
+	}
+	err := cp.ApplyRenames(applied)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Editcp.
+//
+// Editcp is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Editcp is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Editcp.  If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/ui"
+)
+
+func (edt *editor) exportZoneBundle() {
+	cp := edt.codeplug
+	title := "Export zone bundle"
+
+	var zoneNames []string
+	for _, r := range cp.Records(codeplug.RtZones_md380) {
+		zoneNames = append(zoneNames, r.Name())
+	}
+	zoneName := zoneNames[0]
+
+	dialog := ui.NewDialog(title)
+	form := dialog.AddForm()
+	form.AddRow("Zone:", ui.NewComboboxWidget(zoneName, zoneNames, func(s string) {
+		zoneName = s
+	}))
+
+	dialog.AddSpace(2)
+	row := dialog.AddHbox()
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Export", func() {
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() {
+		return
+	}
+
+	ext := "txt"
+	dir := filepath.Join(settings.codeplugDirectory, zoneName+"."+ext)
+	filename := ui.SaveFilename(title, dir, ext)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	err := cp.WriteZoneBundleFile(filename, zoneName)
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+	}
+}
+
+func (edt *editor) importZoneBundle() {
+	cp := edt.codeplug
+	dir := settings.codeplugDirectory
+	filename := ui.OpenTextFilename("Import zone bundle", dir)
+	if filename == "" {
+		return
+	}
+	settings.codeplugDirectory = filepath.Dir(filename)
+	saveSettings()
+
+	title := fmt.Sprintf("Import zone bundle %s", filename)
+
+	conflicts := codeplug.ZoneConflicts()
+	conflict := conflicts[0]
+
+	dialog := ui.NewDialog(title)
+	form := dialog.AddForm()
+	form.AddRow("Records whose names are in use:", ui.NewComboboxWidget(conflict, conflicts, func(s string) {
+		conflict = s
+	}))
+
+	dialog.AddSpace(2)
+	row := dialog.AddHbox()
+	row.AddWidget(ui.NewButtonWidget("Cancel", func() {
+		dialog.Reject()
+	}))
+	row.AddWidget(ui.NewButtonWidget("Import", func() {
+		dialog.Accept()
+	}))
+
+	if !dialog.Exec() {
+		return
+	}
+
+	renames, err := cp.ImportZoneBundleFile(filename, codeplug.ZoneConflict(conflict))
+	if err != nil {
+		ui.ErrorPopup(title, err.Error())
+		return
+	}
+	ui.ResetWindows(cp)
+
+	if len(renames) > 0 {
+		ui.InfoPopup(title, "Records imported under new names:\n"+renamesTable(renames))
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/dalefarnsworth/codeplug
+
+go 1.17
+
+require (
+	github.com/google/gousb v1.1.2
+	github.com/tealeg/xlsx v1.0.5
+	github.com/therecipe/qt v0.0.0-20200904063919-c0c124a5770d
+	golang.org/x/crypto v0.1.0
+	golang.org/x/sys v0.1.0
+)
+
+require github.com/gopherjs/gopherjs v0.0.0-20190411002643-bd77b112433e // indirect
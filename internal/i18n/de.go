@@ -0,0 +1,189 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+package i18n
+
+// The built-in German catalog.
+func init() {
+	Register("de", Catalog{
+		"File":                              "Datei",
+		"Edit":                              "Bearbeiten",
+		"Radio":                             "Funkgerät",
+		"Windows":                           "Fenster",
+		"Help":                              "Hilfe",
+		"New...":                            "Neu...",
+		"Open...":                           "Öffnen...",
+		"Open Recent...":                    "Zuletzt geöffnet...",
+		"Save":                              "Speichern",
+		"Save As...":                        "Speichern unter...",
+		"Revert":                            "Zurücksetzen",
+		"Close":                             "Schließen",
+		"Quit":                              "Beenden",
+		"Import...":                         "Importieren...",
+		"Export...":                         "Exportieren...",
+		"Import text file...":               "Textdatei importieren...",
+		"Import JSON file...":               "JSON-Datei importieren...",
+		"Import Spreadsheet file...":        "Tabellendatei importieren...",
+		"Import contacts (vCard or CSV)...": "Kontakte importieren (vCard oder CSV)...",
+		"Import encrypted file...":          "Verschlüsselte Datei importieren...",
+		"Import from CPS installer...":      "Von CPS-Installationsprogramm importieren...",
+		"Import from CPS installer":         "Von CPS-Installationsprogramm importieren",
+		"Save imported codeplug":            "Importiertes Codeplug speichern",
+		"Codeplug:":                         "Codeplug:",
+		"Import":                            "Importieren",
+		"Apply overlay file...":             "Overlay-Datei anwenden...",
+		"Export to text...":                 "Als Text exportieren...",
+		"Export to JSON...":                 "Als JSON exportieren...",
+		"Export to Spreadsheet...":          "Als Tabelle exportieren...",
+		"Export encrypted...":               "Verschlüsselt exportieren...",
+		"Capacity Report...":                "Kapazitätsbericht...",
+		"Unknown Regions...":                "Unbekannte Bereiche...",
+		"Basic Information":                 "Grundinformationen",
+		"General Settings":                  "Allgemeine Einstellungen",
+		"Menu Items":                        "Menüpunkte",
+		"Channels":                          "Kanäle",
+		"Contacts":                          "Kontakte",
+		"RX Group Lists":                    "RX-Gruppenlisten",
+		"Scan Lists":                        "Scanlisten",
+		"Zones":                             "Zonen",
+		"GPS Systems":                       "GPS-Systeme",
+		"Add Hotspot...":                    "Hotspot hinzufügen...",
+		"Add Hotspot":                       "Hotspot hinzufügen",
+		"Add Pasted Repeaters...":           "Eingefügte Relais hinzufügen...",
+		"Rename...":                         "Umbenennen...",
+		"Rename Records":                    "Datensätze umbenennen",
+		"Match (regular expression):":       "Muster (regulärer Ausdruck):",
+		"New name ({1}, {name}, {n}):":      "Neuer Name ({1}, {name}, {n}):",
+		"First number:":                     "Erste Nummer:",
+		"Number increment:":                 "Nummernschritt:",
+		"Number digits:":                    "Nummernstellen:",
+		"Case:":                             "Groß-/Kleinschreibung:",
+		"Preview":                           "Vorschau",
+		"Apply":                             "Anwenden",
+		"Add Pasted Repeaters":              "Eingefügte Relais hinzufügen",
+		"Paste repeaters copied from RepeaterBook or Brandmeister pages,\nor lines such as \"439.9875 -9.4 CC1\":": "Von RepeaterBook- oder Brandmeister-Seiten kopierte Relais einfügen,\noder Zeilen wie \"439.9875 -9.4 CC1\":",
+		"DMR talkgroup (id:name):":            "DMR-Sprechgruppe (ID:Name):",
+		"Repeater:":                           "Relais:",
+		"Channel name:":                       "Kanalname:",
+		"Next":                                "Weiter",
+		"Stop":                                "Beenden",
+		"Sync Scan Lists with Zones":          "Scanlisten mit Zonen abgleichen",
+		"Optimize RX Group Lists...":          "RX-Gruppenlisten optimieren...",
+		"Talkgroup Usage...":                  "Sprechgruppennutzung...",
+		"Check Channel Policies...":           "Kanalrichtlinien prüfen...",
+		"Check Channel Policies":              "Kanalrichtlinien prüfen",
+		"Channel Policies":                    "Kanalrichtlinien",
+		"Policies file:":                      "Richtliniendatei:",
+		"Rename Talkgroups...":                "Sprechgruppen umbenennen...",
+		"Talkgroup Names":                     "Sprechgruppennamen",
+		"Talkgroup names file:":               "Datei mit Sprechgruppennamen:",
+		"Abbreviations file:":                 "Abkürzungsdatei:",
+		"Fix":                                 "Korrigieren",
+		"Talkgroup Usage":                     "Sprechgruppennutzung",
+		"Remove contacts used by no channel:": "Von keinem Kanal genutzte Kontakte entfernen:",
+		"Keep contacts in RX group lists:":    "Kontakte in RX-Gruppenlisten behalten:",
+		"Add talkgroups to channels' RX group lists:": "Sprechgruppen zu den RX-Gruppenlisten der Kanäle hinzufügen:",
+		"Merge Duplicate Channels...":                 "Doppelte Kanäle zusammenführen...",
+		"Check Analog Channels...":                    "Analoge Kanäle prüfen...",
+		"Check Digital Channels...":                   "Digitale Kanäle prüfen...",
+		"Override Locks":                              "Sperren aufheben",
+		"Enforce Locks":                               "Sperren durchsetzen",
+		"Undo":                                        "Rückgängig",
+		"Redo":                                        "Wiederholen",
+		"Preferences...":                              "Einstellungen...",
+		"Preferences":                                 "Einstellungen",
+		"Read codeplug from radio":                    "Codeplug vom Funkgerät lesen",
+		"Write codeplug to radio":                     "Codeplug auf Funkgerät schreiben",
+		"Write user database to radio...":             "Benutzerdatenbank auf Funkgerät schreiben...",
+		"Write original firmware to radio...":         "Originale Firmware auf Funkgerät schreiben...",
+		"Write md380tools firmware to radio...":       "md380tools-Firmware auf Funkgerät schreiben...",
+		"Update Firmware":                             "Firmware aktualisieren",
+		"Utilities":                                   "Hilfsprogramme",
+		"About...":                                    "Über...",
+		"Thanks...":                                   "Danksagungen...",
+		"Add":                                         "Hinzufügen",
+		"Delete":                                      "Löschen",
+		"Cancel":                                      "Abbrechen",
+		"OK":                                          "OK",
+		"Ok":                                          "OK",
+		"Write":                                       "Schreiben",
+		"Select Radio Model":                          "Funkgerätemodell wählen",
+		"Select codeplug type":                        "Codeplug-Typ wählen",
+		"Display Options":                             "Anzeigeoptionen",
+		"Warnings":                                    "Warnungen",
+		"Signing Exported Files":                      "Signieren exportierter Dateien",
+		"Language":                                    "Sprache",
+		"Language:":                                   "Sprache:",
+		"System default":                              "Systemstandard",
+		"Display GPS fields:":                         "GPS-Felder anzeigen:",
+		"Suppress invalid field warning messages:":     "Warnungen zu ungültigen Feldern unterdrücken:",
+		"Reject files with unknown records or fields:": "Dateien mit unbekannten Datensätzen oder Feldern ablehnen:",
+		"Sync scan lists with zones when saving:":      "Scanlisten beim Speichern mit Zonen abgleichen:",
+		"Auto Save interval (minutes):":                "Intervall für automatisches Speichern (Minuten):",
+		"Author:":                                      "Autor:",
+		"Private key file:":                            "Private Schlüsseldatei:",
+		"None":                                         "Keines",
+		"Profile:":                                     "Profil:",
+		"Profile":                                      "Profil",
+		"Backups kept (0 keeps all):":                  "Aufbewahrte Sicherungen (0 behält alle):",
+		"Back up radio's codeplug before writing:":     "Codeplug des Funkgeräts vor dem Schreiben sichern:",
+		"Change Tracking":                              "Änderungsverfolgung",
+		"Record who last changed each record:":         "Aufzeichnen, wer jeden Datensatz zuletzt geändert hat:",
+		"Export to Markdown...":                        "Nach Markdown exportieren...",
+		"Export to OpenRTX...":                         "Nach OpenRTX exportieren...",
+		"Export to Markdown file":                      "In Markdown-Datei exportieren",
+		"Export cheat sheet (HTML)...":                 "Spickzettel exportieren (HTML)...",
+		"Export cheat sheet to HTML file":              "Spickzettel in HTML-Datei exportieren",
+		"New Codeplug":                                 "Neues Codeplug",
+		"Add channels for a hotspot?":                  "Kanäle für einen Hotspot hinzufügen?",
+		"Simplex":                                      "Simplex",
+		"Region:":                                      "Region:",
+		"Local Repeaters":                              "Lokale Relais",
+		"Add from a RepeaterBook file:":                "Aus einer RepeaterBook-Datei hinzufügen:",
+		"Your location (latitude,longitude):":          "Ihr Standort (Breite,Länge):",
+		"Open RepeaterBook file":                       "RepeaterBook-Datei öffnen",
+		"Radio Identity":                               "Funkgeräte-Identität",
+		"Your DMR ID:":                                 "Ihre DMR-ID:",
+		"Skip":                                         "Überspringen",
+		"Look up":                                      "Nachschlagen",
+		"Extract user database from flash dump...":     "Benutzerdatenbank aus Flash-Abbild extrahieren...",
+		"Save radio calibration to file...":            "Kalibrierung des Funkgeräts in Datei speichern...",
+		"Restore radio calibration from file (dangerous)...": "Kalibrierung des Funkgeräts aus Datei wiederherstellen (gefährlich)...",
+		"Save radio calibration to file":                     "Kalibrierung des Funkgeräts in Datei speichern",
+		"Restore radio calibration from file":                "Kalibrierung des Funkgeräts aus Datei wiederherstellen",
+		"Restart radio after writing codeplug:":              "Funkgerät nach dem Schreiben des Codeplugs neu starten:",
+		"Codeplug write complete":                            "Codeplug geschrieben",
+		"Turn radio off and back on again.":                  "Funkgerät aus- und wieder einschalten.",
+		"Password:":                                          "Passwort:",
+		"Confirm password:":                                  "Passwort bestätigen:",
+		"Name:":                                              "Name:",
+		"Color code:":                                        "Farbcode:",
+		"Time slot:":                                         "Zeitschlitz:",
+		"Receive frequency (MHz):":                           "Empfangsfrequenz (MHz):",
+		"Transmit frequency, if not simplex (MHz):":          "Sendefrequenz, falls nicht Simplex (MHz):",
+		"Talkgroups":                                         "Sprechgruppen",
+		"Radio model":                                        "Funkgerätemodell",
+		"subCommands:\n":                                     "Unterbefehle:\n",
+		"Use '%s <subCommand> -h' for subCommand help\n":     "'%s <subCommand> -h' zeigt die Hilfe zu einem Unterbefehl\n",
+	})
+}
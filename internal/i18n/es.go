@@ -0,0 +1,189 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+package i18n
+
+// The built-in Spanish catalog.
+func init() {
+	Register("es", Catalog{
+		"File":                              "Archivo",
+		"Edit":                              "Editar",
+		"Radio":                             "Radio",
+		"Windows":                           "Ventanas",
+		"Help":                              "Ayuda",
+		"New...":                            "Nuevo...",
+		"Open...":                           "Abrir...",
+		"Open Recent...":                    "Abrir reciente...",
+		"Save":                              "Guardar",
+		"Save As...":                        "Guardar como...",
+		"Revert":                            "Revertir",
+		"Close":                             "Cerrar",
+		"Quit":                              "Salir",
+		"Import...":                         "Importar...",
+		"Export...":                         "Exportar...",
+		"Import text file...":               "Importar archivo de texto...",
+		"Import JSON file...":               "Importar archivo JSON...",
+		"Import Spreadsheet file...":        "Importar hoja de cálculo...",
+		"Import contacts (vCard or CSV)...": "Importar contactos (vCard o CSV)...",
+		"Import encrypted file...":          "Importar archivo cifrado...",
+		"Import from CPS installer...":      "Importar desde instalador de CPS...",
+		"Import from CPS installer":         "Importar desde instalador de CPS",
+		"Save imported codeplug":            "Guardar codeplug importado",
+		"Codeplug:":                         "Codeplug:",
+		"Import":                            "Importar",
+		"Apply overlay file...":             "Aplicar archivo de superposición...",
+		"Export to text...":                 "Exportar a texto...",
+		"Export to JSON...":                 "Exportar a JSON...",
+		"Export to Spreadsheet...":          "Exportar a hoja de cálculo...",
+		"Export encrypted...":               "Exportar cifrado...",
+		"Capacity Report...":                "Informe de capacidad...",
+		"Unknown Regions...":                "Regiones desconocidas...",
+		"Basic Information":                 "Información básica",
+		"General Settings":                  "Ajustes generales",
+		"Menu Items":                        "Elementos del menú",
+		"Channels":                          "Canales",
+		"Contacts":                          "Contactos",
+		"RX Group Lists":                    "Listas de grupos RX",
+		"Scan Lists":                        "Listas de escaneo",
+		"Zones":                             "Zonas",
+		"GPS Systems":                       "Sistemas GPS",
+		"Add Hotspot...":                    "Añadir hotspot...",
+		"Add Hotspot":                       "Añadir hotspot",
+		"Add Pasted Repeaters...":           "Añadir repetidores pegados...",
+		"Rename...":                         "Renombrar...",
+		"Rename Records":                    "Renombrar registros",
+		"Match (regular expression):":       "Patrón (expresión regular):",
+		"New name ({1}, {name}, {n}):":      "Nuevo nombre ({1}, {name}, {n}):",
+		"First number:":                     "Primer número:",
+		"Number increment:":                 "Incremento del número:",
+		"Number digits:":                    "Dígitos del número:",
+		"Case:":                             "Mayúsculas:",
+		"Preview":                           "Vista previa",
+		"Apply":                             "Aplicar",
+		"Add Pasted Repeaters":              "Añadir repetidores pegados",
+		"Paste repeaters copied from RepeaterBook or Brandmeister pages,\nor lines such as \"439.9875 -9.4 CC1\":": "Pegue repetidores copiados de páginas de RepeaterBook o Brandmeister,\no líneas como \"439.9875 -9.4 CC1\":",
+		"DMR talkgroup (id:name):":            "Grupo de conversación DMR (id:nombre):",
+		"Repeater:":                           "Repetidor:",
+		"Channel name:":                       "Nombre del canal:",
+		"Next":                                "Siguiente",
+		"Stop":                                "Detener",
+		"Sync Scan Lists with Zones":          "Sincronizar listas de escaneo con zonas",
+		"Optimize RX Group Lists...":          "Optimizar listas de grupos RX...",
+		"Talkgroup Usage...":                  "Uso de grupos de conversación...",
+		"Check Channel Policies...":           "Comprobar políticas de canales...",
+		"Check Channel Policies":              "Comprobar políticas de canales",
+		"Channel Policies":                    "Políticas de canales",
+		"Policies file:":                      "Archivo de políticas:",
+		"Rename Talkgroups...":                "Renombrar grupos de conversación...",
+		"Talkgroup Names":                     "Nombres de grupos de conversación",
+		"Talkgroup names file:":               "Archivo de nombres de grupos de conversación:",
+		"Abbreviations file:":                 "Archivo de abreviaturas:",
+		"Fix":                                 "Corregir",
+		"Talkgroup Usage":                     "Uso de grupos de conversación",
+		"Remove contacts used by no channel:": "Eliminar contactos que ningún canal usa:",
+		"Keep contacts in RX group lists:":    "Conservar contactos en listas de grupos RX:",
+		"Add talkgroups to channels' RX group lists:": "Añadir grupos a las listas de grupos RX de los canales:",
+		"Merge Duplicate Channels...":                 "Fusionar canales duplicados...",
+		"Check Analog Channels...":                    "Comprobar canales analógicos...",
+		"Check Digital Channels...":                   "Comprobar canales digitales...",
+		"Override Locks":                              "Anular bloqueos",
+		"Enforce Locks":                               "Aplicar bloqueos",
+		"Undo":                                        "Deshacer",
+		"Redo":                                        "Rehacer",
+		"Preferences...":                              "Preferencias...",
+		"Preferences":                                 "Preferencias",
+		"Read codeplug from radio":                    "Leer codeplug de la radio",
+		"Write codeplug to radio":                     "Escribir codeplug en la radio",
+		"Write user database to radio...":             "Escribir base de datos de usuarios en la radio...",
+		"Write original firmware to radio...":         "Escribir firmware original en la radio...",
+		"Write md380tools firmware to radio...":       "Escribir firmware md380tools en la radio...",
+		"Update Firmware":                             "Actualizar firmware",
+		"Utilities":                                   "Utilidades",
+		"About...":                                    "Acerca de...",
+		"Thanks...":                                   "Agradecimientos...",
+		"Add":                                         "Añadir",
+		"Delete":                                      "Eliminar",
+		"Cancel":                                      "Cancelar",
+		"OK":                                          "Aceptar",
+		"Ok":                                          "Aceptar",
+		"Write":                                       "Escribir",
+		"Select Radio Model":                          "Seleccionar modelo de radio",
+		"Select codeplug type":                        "Seleccionar tipo de codeplug",
+		"Display Options":                             "Opciones de visualización",
+		"Warnings":                                    "Advertencias",
+		"Signing Exported Files":                      "Firma de archivos exportados",
+		"Language":                                    "Idioma",
+		"Language:":                                   "Idioma:",
+		"System default":                              "Predeterminado del sistema",
+		"Display GPS fields:":                         "Mostrar campos GPS:",
+		"Suppress invalid field warning messages:":     "Suprimir avisos de campos no válidos:",
+		"Reject files with unknown records or fields:": "Rechazar archivos con registros o campos desconocidos:",
+		"Sync scan lists with zones when saving:":      "Sincronizar listas de escaneo con zonas al guardar:",
+		"Auto Save interval (minutes):":                "Intervalo de guardado automático (minutos):",
+		"Author:":                                      "Autor:",
+		"Private key file:":                            "Archivo de clave privada:",
+		"None":                                         "Ninguno",
+		"Profile:":                                     "Perfil:",
+		"Profile":                                      "Perfil",
+		"Backups kept (0 keeps all):":                  "Respaldos conservados (0 conserva todos):",
+		"Back up radio's codeplug before writing:":     "Respaldar el codeplug de la radio antes de escribir:",
+		"Change Tracking":                              "Seguimiento de cambios",
+		"Record who last changed each record:":         "Registrar quién cambió cada registro por última vez:",
+		"Export to Markdown...":                        "Exportar a Markdown...",
+		"Export to OpenRTX...":                         "Exportar a OpenRTX...",
+		"Export to Markdown file":                      "Exportar a archivo Markdown",
+		"Export cheat sheet (HTML)...":                 "Exportar hoja de referencia (HTML)...",
+		"Export cheat sheet to HTML file":              "Exportar hoja de referencia a archivo HTML",
+		"New Codeplug":                                 "Nuevo codeplug",
+		"Add channels for a hotspot?":                  "¿Añadir canales para un hotspot?",
+		"Simplex":                                      "Símplex",
+		"Region:":                                      "Región:",
+		"Local Repeaters":                              "Repetidores locales",
+		"Add from a RepeaterBook file:":                "Añadir desde un archivo de RepeaterBook:",
+		"Your location (latitude,longitude):":          "Su ubicación (latitud,longitud):",
+		"Open RepeaterBook file":                       "Abrir archivo de RepeaterBook",
+		"Radio Identity":                               "Identidad de la radio",
+		"Your DMR ID:":                                 "Su ID DMR:",
+		"Skip":                                         "Omitir",
+		"Look up":                                      "Buscar",
+		"Extract user database from flash dump...":     "Extraer base de datos de usuarios de volcado de flash...",
+		"Save radio calibration to file...":            "Guardar la calibración de la radio en un archivo...",
+		"Restore radio calibration from file (dangerous)...": "Restaurar la calibración de la radio desde un archivo (peligroso)...",
+		"Save radio calibration to file":                     "Guardar la calibración de la radio en un archivo",
+		"Restore radio calibration from file":                "Restaurar la calibración de la radio desde un archivo",
+		"Restart radio after writing codeplug:":              "Reiniciar la radio tras escribir el codeplug:",
+		"Codeplug write complete":                            "Codeplug escrito",
+		"Turn radio off and back on again.":                  "Apague la radio y vuelva a encenderla.",
+		"Password:":                                          "Contraseña:",
+		"Confirm password:":                                  "Confirmar contraseña:",
+		"Name:":                                              "Nombre:",
+		"Color code:":                                        "Código de color:",
+		"Time slot:":                                         "Intervalo de tiempo:",
+		"Receive frequency (MHz):":                           "Frecuencia de recepción (MHz):",
+		"Transmit frequency, if not simplex (MHz):":          "Frecuencia de transmisión, si no es símplex (MHz):",
+		"Talkgroups":                                         "Grupos de conversación",
+		"Radio model":                                        "Modelo de radio",
+		"subCommands:\n":                                     "Subcomandos:\n",
+		"Use '%s <subCommand> -h' for subCommand help\n":     "Use '%s <subCommand> -h' para la ayuda de un subcomando\n",
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package i18n translates the messages of the codeplug programs into
+// the user's language.  Messages are looked up, in English, in the
+// message catalog of the selected language.  Messages not found there
+// are used untranslated.
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// A Catalog maps English messages to their translations.
+type Catalog map[string]string
+
+var (
+	mutex    sync.RWMutex
+	catalogs = make(map[string]Catalog)
+	language string
+	current  Catalog
+)
+
+// Register adds the messages of catalog to the catalog of the named
+// language, replacing any existing translations of the same messages.
+func Register(lang string, catalog Catalog) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	lang = normalize(lang)
+	c := catalogs[lang]
+	if c == nil {
+		c = make(Catalog)
+		catalogs[lang] = c
+	}
+	for msg, translation := range catalog {
+		c[msg] = translation
+	}
+}
+
+// LoadCatalog registers the catalog in the named JSON file, an object
+// mapping English messages to their translations.  The file's base
+// name is its language, as in "de.json" or "zh_CN.json".
+func LoadCatalog(filename string) error {
+	bytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	var catalog Catalog
+	err = json.Unmarshal(bytes, &catalog)
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err.Error())
+	}
+
+	base := filepath.Base(filename)
+	Register(strings.TrimSuffix(base, filepath.Ext(base)), catalog)
+
+	return nil
+}
+
+// CatalogDir returns the directory holding the user's own catalogs.
+func CatalogDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "codeplug", "i18n"), nil
+}
+
+// LoadUserCatalogs registers the catalogs of the JSON files in the
+// user's catalog directory, if it exists.  They may add to, or correct,
+// the built-in catalogs.
+func LoadUserCatalogs() error {
+	dir, err := CatalogDir()
+	if err != nil {
+		return nil
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, filename := range filenames {
+		err := LoadCatalog(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Languages returns the languages having catalogs, and "en".
+func Languages() []string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	langs := []string{"en"}
+	for lang := range catalogs {
+		if lang != "en" {
+			langs = append(langs, lang)
+		}
+	}
+	sort.Strings(langs[1:])
+
+	return langs
+}
+
+// SetLanguage selects the language of translated messages.  A language
+// such as "de_AT.UTF-8" uses the "de_AT" catalog, or if there is none,
+// the "de" catalog.  If lang is empty, the language is taken from the
+// environment.  An unknown language is an error, and leaves messages
+// untranslated.
+func SetLanguage(lang string) error {
+	if lang == "" {
+		lang = EnvLanguage()
+	}
+	lang = normalize(lang)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	language = "en"
+	current = nil
+
+	switch lang {
+	case "", "c", "posix", "en":
+		return nil
+	}
+
+	for _, l := range []string{lang, strings.SplitN(lang, "_", 2)[0]} {
+		if catalogs[l] != nil {
+			language = l
+			current = catalogs[l]
+			return nil
+		}
+	}
+	if strings.HasPrefix(lang, "en_") {
+		return nil
+	}
+
+	return fmt.Errorf("no translations for language: %s", lang)
+}
+
+// Language returns the selected language.
+func Language() string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	if language == "" {
+		return "en"
+	}
+	return language
+}
+
+// EnvLanguage returns the user's language as given by the LANGUAGE,
+// LC_ALL, LC_MESSAGES or LANG environment variables.  LANGUAGE may
+// list several languages; the first is used.
+func EnvLanguage() string {
+	for _, name := range []string{"LANGUAGE", "LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := strings.SplitN(os.Getenv(name), ":", 2)[0]
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// T returns the translation of msg into the selected language, or msg
+// itself if it has no translation.
+func T(msg string) string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	translation, ok := current[msg]
+	if !ok || translation == "" {
+		return msg
+	}
+	return translation
+}
+
+// Sprintf formats according to the translation of format.
+func Sprintf(format string, a ...interface{}) string {
+	return fmt.Sprintf(T(format), a...)
+}
+
+// normalize returns lang without any encoding or modifier, as in "de"
+// for "de.UTF-8" or "de_DE@euro", in lower case except for the region.
+func normalize(lang string) string {
+	lang = strings.TrimSpace(lang)
+	if i := strings.IndexAny(lang, ".@"); i >= 0 {
+		lang = lang[:i]
+	}
+	lang = strings.Replace(lang, "-", "_", -1)
+
+	parts := strings.SplitN(lang, "_", 2)
+	lang = strings.ToLower(parts[0])
+	if len(parts) == 2 {
+		lang += "_" + strings.ToUpper(parts[1])
+	}
+
+	return lang
+}
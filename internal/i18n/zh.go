@@ -0,0 +1,189 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+package i18n
+
+// The built-in Chinese (Simplified) catalog.
+func init() {
+	Register("zh", Catalog{
+		"File":                              "文件",
+		"Edit":                              "编辑",
+		"Radio":                             "电台",
+		"Windows":                           "窗口",
+		"Help":                              "帮助",
+		"New...":                            "新建...",
+		"Open...":                           "打开...",
+		"Open Recent...":                    "最近打开...",
+		"Save":                              "保存",
+		"Save As...":                        "另存为...",
+		"Revert":                            "还原",
+		"Close":                             "关闭",
+		"Quit":                              "退出",
+		"Import...":                         "导入...",
+		"Export...":                         "导出...",
+		"Import text file...":               "导入文本文件...",
+		"Import JSON file...":               "导入 JSON 文件...",
+		"Import Spreadsheet file...":        "导入电子表格文件...",
+		"Import contacts (vCard or CSV)...": "导入联系人 (vCard 或 CSV)...",
+		"Import encrypted file...":          "导入加密文件...",
+		"Import from CPS installer...":      "从 CPS 安装程序导入...",
+		"Import from CPS installer":         "从 CPS 安装程序导入",
+		"Save imported codeplug":            "保存导入的码表",
+		"Codeplug:":                         "码表：",
+		"Import":                            "导入",
+		"Apply overlay file...":             "应用覆盖文件...",
+		"Export to text...":                 "导出为文本...",
+		"Export to JSON...":                 "导出为 JSON...",
+		"Export to Spreadsheet...":          "导出为电子表格...",
+		"Export encrypted...":               "加密导出...",
+		"Capacity Report...":                "容量报告...",
+		"Unknown Regions...":                "未知区域...",
+		"Basic Information":                 "基本信息",
+		"General Settings":                  "常规设置",
+		"Menu Items":                        "菜单项",
+		"Channels":                          "信道",
+		"Contacts":                          "联系人",
+		"RX Group Lists":                    "接收组列表",
+		"Scan Lists":                        "扫描列表",
+		"Zones":                             "区域",
+		"GPS Systems":                       "GPS 系统",
+		"Add Hotspot...":                    "添加热点...",
+		"Add Hotspot":                       "添加热点",
+		"Add Pasted Repeaters...":           "添加粘贴的中继台...",
+		"Rename...":                         "重命名...",
+		"Rename Records":                    "重命名记录",
+		"Match (regular expression):":       "匹配 (正则表达式):",
+		"New name ({1}, {name}, {n}):":      "新名称 ({1}, {name}, {n}):",
+		"First number:":                     "起始编号:",
+		"Number increment:":                 "编号增量:",
+		"Number digits:":                    "编号位数:",
+		"Case:":                             "大小写:",
+		"Preview":                           "预览",
+		"Apply":                             "应用",
+		"Add Pasted Repeaters":              "添加粘贴的中继台",
+		"Paste repeaters copied from RepeaterBook or Brandmeister pages,\nor lines such as \"439.9875 -9.4 CC1\":": "粘贴从 RepeaterBook 或 Brandmeister 页面复制的中继台，\n或如 \"439.9875 -9.4 CC1\" 的行：",
+		"DMR talkgroup (id:name):":            "DMR 通话组（ID:名称）：",
+		"Repeater:":                           "中继台：",
+		"Channel name:":                       "信道名称：",
+		"Next":                                "下一步",
+		"Stop":                                "停止",
+		"Sync Scan Lists with Zones":          "按区域同步扫描列表",
+		"Optimize RX Group Lists...":          "优化接收组列表...",
+		"Talkgroup Usage...":                  "通话组使用情况...",
+		"Check Channel Policies...":           "检查信道策略...",
+		"Check Channel Policies":              "检查信道策略",
+		"Channel Policies":                    "信道策略",
+		"Policies file:":                      "策略文件:",
+		"Rename Talkgroups...":                "重命名通话组...",
+		"Talkgroup Names":                     "通话组名称",
+		"Talkgroup names file:":               "通话组名称文件:",
+		"Abbreviations file:":                 "缩写文件:",
+		"Fix":                                 "修正",
+		"Talkgroup Usage":                     "通话组使用情况",
+		"Remove contacts used by no channel:": "删除未被任何信道使用的联系人:",
+		"Keep contacts in RX group lists:":    "保留接收组列表中的联系人:",
+		"Add talkgroups to channels' RX group lists:": "将通话组添加到信道的接收组列表:",
+		"Merge Duplicate Channels...":                 "合并重复信道...",
+		"Check Analog Channels...":                    "检查模拟信道...",
+		"Check Digital Channels...":                   "检查数字信道...",
+		"Override Locks":                              "忽略锁定",
+		"Enforce Locks":                               "强制锁定",
+		"Undo":                                        "撤销",
+		"Redo":                                        "重做",
+		"Preferences...":                              "首选项...",
+		"Preferences":                                 "首选项",
+		"Read codeplug from radio":                    "从电台读取写频文件",
+		"Write codeplug to radio":                     "将写频文件写入电台",
+		"Write user database to radio...":             "将用户数据库写入电台...",
+		"Write original firmware to radio...":         "将原厂固件写入电台...",
+		"Write md380tools firmware to radio...":       "将 md380tools 固件写入电台...",
+		"Update Firmware":                             "更新固件",
+		"Utilities":                                   "实用工具",
+		"About...":                                    "关于...",
+		"Thanks...":                                   "致谢...",
+		"Add":                                         "添加",
+		"Delete":                                      "删除",
+		"Cancel":                                      "取消",
+		"OK":                                          "确定",
+		"Ok":                                          "确定",
+		"Write":                                       "写入",
+		"Select Radio Model":                          "选择电台型号",
+		"Select codeplug type":                        "选择写频文件类型",
+		"Display Options":                             "显示选项",
+		"Warnings":                                    "警告",
+		"Signing Exported Files":                      "导出文件签名",
+		"Language":                                    "语言",
+		"Language:":                                   "语言：",
+		"System default":                              "系统默认",
+		"Display GPS fields:":                         "显示 GPS 字段：",
+		"Suppress invalid field warning messages:":     "不显示无效字段警告：",
+		"Reject files with unknown records or fields:": "拒绝含有未知记录或字段的文件：",
+		"Sync scan lists with zones when saving:":      "保存时按区域同步扫描列表：",
+		"Auto Save interval (minutes):":                "自动保存间隔（分钟）：",
+		"Author:":                                      "作者：",
+		"Private key file:":                            "私钥文件：",
+		"None":                                         "无",
+		"Profile:":                                     "配置文件：",
+		"Profile":                                      "配置文件",
+		"Backups kept (0 keeps all):":                  "保留的备份数（0 为全部保留）：",
+		"Back up radio's codeplug before writing:":     "写入前备份电台的码本：",
+		"Change Tracking":                              "变更跟踪",
+		"Record who last changed each record:":         "记录每条记录的最后修改者：",
+		"Export to Markdown...":                        "导出为 Markdown...",
+		"Export to OpenRTX...":                         "导出为 OpenRTX...",
+		"Export to Markdown file":                      "导出到 Markdown 文件",
+		"Export cheat sheet (HTML)...":                 "导出速查表 (HTML)...",
+		"Export cheat sheet to HTML file":              "导出速查表到 HTML 文件",
+		"New Codeplug":                                 "新建写频文件",
+		"Add channels for a hotspot?":                  "为热点添加信道？",
+		"Simplex":                                      "直频",
+		"Region:":                                      "地区：",
+		"Local Repeaters":                              "本地中继",
+		"Add from a RepeaterBook file:":                "从 RepeaterBook 文件添加：",
+		"Your location (latitude,longitude):":          "您的位置（纬度,经度）：",
+		"Open RepeaterBook file":                       "打开 RepeaterBook 文件",
+		"Radio Identity":                               "电台身份",
+		"Your DMR ID:":                                 "您的 DMR ID：",
+		"Skip":                                         "跳过",
+		"Look up":                                      "查询",
+		"Extract user database from flash dump...":     "从闪存转储中提取用户数据库...",
+		"Save radio calibration to file...":            "将电台校准数据保存到文件...",
+		"Restore radio calibration from file (dangerous)...": "从文件恢复电台校准数据（危险）...",
+		"Save radio calibration to file":                     "将电台校准数据保存到文件",
+		"Restore radio calibration from file":                "从文件恢复电台校准数据",
+		"Restart radio after writing codeplug:":              "写入码表后重启电台：",
+		"Codeplug write complete":                            "码表写入完成",
+		"Turn radio off and back on again.":                  "请关闭电台后重新开机。",
+		"Password:":                                          "密码：",
+		"Confirm password:":                                  "确认密码：",
+		"Name:":                                              "名称：",
+		"Color code:":                                        "色码：",
+		"Time slot:":                                         "时隙：",
+		"Receive frequency (MHz):":                           "接收频率 (MHz)：",
+		"Transmit frequency, if not simplex (MHz):":          "发射频率，非同频时 (MHz)：",
+		"Talkgroups":                                         "通话组",
+		"Radio model":                                        "电台型号",
+		"subCommands:\n":                                     "子命令：\n",
+		"Use '%s <subCommand> -h' for subCommand help\n":     "使用 '%s <subCommand> -h' 查看子命令帮助\n",
+	})
+}
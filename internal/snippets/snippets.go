@@ -0,0 +1,310 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Codeplug.
+//
+// Codeplug is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Codeplug is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Codeplug.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package snippets fetches codeplug snippets, signed zone bundles
+// shared by other hams, from a community index.
+//
+// An index is a JSON document served over HTTPS, listing the snippets
+// and where to fetch them:
+//
+//	{"snippets": [{
+//		"name": "yellowstone",
+//		"description": "Repeaters along the Yellowstone loop",
+//		"author": "K7ABC",
+//		"tags": ["travel", "wyoming"],
+//		"url": "bundles/yellowstone.txt",
+//		"sha256": "9f86d081..."
+//	}]}
+//
+// A snippet's URL may be relative to the index's.  Each snippet is a
+// zone bundle, as written by codeplug.WriteZoneBundle, signed with
+// codeplug.SignFile.
+package snippets
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// IndexURLEnv names the environment variable from which programs may
+// take the index's URL when none is given.
+const IndexURLEnv = "CODEPLUG_SNIPPETS_INDEX"
+
+// maxSnippetSize limits the size of a fetched index or snippet.
+const maxSnippetSize = 4 << 20
+
+// A Snippet describes a shared zone bundle listed in an index.
+type Snippet struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Author      string    `json:"author"`
+	Tags        []string  `json:"tags"`
+	URL         string    `json:"url"`
+	Updated     time.Time `json:"updated"`
+
+	// SHA256, if not empty, is the hex encoded SHA-256 hash of the
+	// snippet's file.
+	SHA256 string `json:"sha256"`
+}
+
+// String returns a one-line description of the snippet.
+func (s *Snippet) String() string {
+	str := s.Name
+	if len(s.Tags) > 0 {
+		str += " [" + strings.Join(s.Tags, ", ") + "]"
+	}
+	if s.Author != "" {
+		str += " by " + s.Author
+	}
+	if s.Description != "" {
+		str += ": " + s.Description
+	}
+
+	return str
+}
+
+// Matches returns true if each of the words appears in the snippet's
+// name, description, author or tags, ignoring case.
+func (s *Snippet) Matches(words []string) bool {
+	text := strings.ToLower(strings.Join(append([]string{s.Name, s.Description, s.Author}, s.Tags...), " "))
+	for _, word := range words {
+		if !strings.Contains(text, strings.ToLower(word)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// A Client fetches snippets from an index.
+type Client struct {
+	// IndexURL is the address of the index.  Besides https and
+	// http, file URLs are accepted, for indexes kept on disk.
+	IndexURL string
+
+	// TrustedKeys, if not nil, are the keys trusted to sign
+	// snippets.  Snippets signed with other keys are refused.
+	TrustedKeys []ed25519.PublicKey
+
+	client *http.Client
+}
+
+// NewClient returns a client of the index at indexURL.  Files on disk
+// may be read only through an index that is itself a file URL.
+func NewClient(indexURL string) *Client {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if u, err := url.Parse(indexURL); err == nil && u.Scheme == "file" {
+		transport.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+	}
+
+	return &Client{
+		IndexURL: indexURL,
+		client: &http.Client{
+			Transport:     transport,
+			CheckRedirect: checkRedirect,
+			Timeout:       30 * time.Second,
+		},
+	}
+}
+
+// checkRedirect refuses redirects to anything but http and https
+// URLs, so that a server cannot point the client at local files.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if req.URL.Scheme != "https" && req.URL.Scheme != "http" {
+		return fmt.Errorf("redirect to %s refused", req.URL)
+	}
+	if len(via) >= 10 {
+		return fmt.Errorf("stopped after 10 redirects")
+	}
+
+	return nil
+}
+
+// get returns the contents of the file at rawURL, resolved relative to
+// the index's URL.  A file URL is refused unless the index's URL is
+// also a file URL.
+func (c *Client) get(rawURL string) ([]byte, error) {
+	base, err := url.Parse(c.IndexURL)
+	if err != nil {
+		return nil, fmt.Errorf("bad snippet index URL: %s", err.Error())
+	}
+	ref, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("bad snippet URL: %s", err.Error())
+	}
+	u := base.ResolveReference(ref)
+
+	switch u.Scheme {
+	case "https", "http":
+	case "file":
+		if base.Scheme != "file" {
+			return nil, fmt.Errorf("%s: file URL in a %s index", u, base.Scheme)
+		}
+	default:
+		return nil, fmt.Errorf("%s: unsupported URL scheme", u)
+	}
+
+	client := c.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("%s: %s", u, resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(&io.LimitedReader{R: resp.Body, N: maxSnippetSize + 1})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", u, err.Error())
+	}
+	if len(data) > maxSnippetSize {
+		return nil, fmt.Errorf("%s: too large", u)
+	}
+
+	return data, nil
+}
+
+// Snippets returns the snippets listed in the index.
+func (c *Client) Snippets() ([]Snippet, error) {
+	data, err := c.get(c.IndexURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var index struct {
+		Snippets []Snippet `json:"snippets"`
+	}
+	err = json.Unmarshal(data, &index)
+	if err != nil {
+		return nil, fmt.Errorf("bad snippet index: %s", err.Error())
+	}
+
+	return index.Snippets, nil
+}
+
+// Search returns the index's snippets matching all of the words, as
+// by Snippet.Matches.  All snippets match if no words are given.
+func (c *Client) Search(words []string) ([]Snippet, error) {
+	snippets, err := c.Snippets()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Snippet
+	for _, s := range snippets {
+		if s.Matches(words) {
+			matches = append(matches, s)
+		}
+	}
+
+	return matches, nil
+}
+
+// Find returns the index's snippet with the given name.
+func (c *Client) Find(name string) (Snippet, error) {
+	snippets, err := c.Snippets()
+	if err != nil {
+		return Snippet{}, err
+	}
+
+	for _, s := range snippets {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+
+	return Snippet{}, fmt.Errorf("no snippet named %s", name)
+}
+
+// Fetch returns the contents of the snippet's zone bundle, and its
+// provenance.  The bundle must be signed, with one of the trusted keys
+// if any are set, and must match its SHA-256 hash, if the index gives
+// one.
+func (c *Client) Fetch(s Snippet) ([]byte, *codeplug.Provenance, error) {
+	if s.URL == "" {
+		return nil, nil, fmt.Errorf("snippet %s has no URL", s.Name)
+	}
+
+	data, err := c.get(s.URL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if s.SHA256 != "" {
+		sum := sha256.Sum256(data)
+		if !strings.EqualFold(hex.EncodeToString(sum[:]), s.SHA256) {
+			return nil, nil, fmt.Errorf("snippet %s: SHA-256 mismatch", s.Name)
+		}
+	}
+
+	_, p, err := codeplug.VerifyData(data, c.TrustedKeys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("snippet %s: %s", s.Name, err.Error())
+	}
+	switch {
+	case p == nil || p.Key == nil:
+		return nil, nil, fmt.Errorf("snippet %s is not signed", s.Name)
+	case c.TrustedKeys != nil && !p.Trusted:
+		return nil, nil, fmt.Errorf("snippet %s is not signed with a trusted key", s.Name)
+	}
+
+	return data, p, nil
+}
+
+// Import fetches the named snippet and imports its zone bundle into
+// cp, as by Codeplug.ImportZoneBundle.
+func (c *Client) Import(cp *codeplug.Codeplug, name string, conflict codeplug.ZoneConflict) ([]codeplug.Rename, error) {
+	s, err := c.Find(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, _, err := c.Fetch(s)
+	if err != nil {
+		return nil, err
+	}
+
+	renames, err := cp.ImportZoneBundle(bytes.NewReader(data), conflict)
+	if err != nil {
+		return nil, fmt.Errorf("snippet %s: %s", name, err.Error())
+	}
+
+	return renames, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Stdfu.
+//
+// Stdfu is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU General Public License
+// as published by the Free Software Foundation.
+//
+// Stdfu is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Stdfu.  If not, see <http://www.gnu.org/licenses/>.
+
+package stdfu
+
+import (
+	"errors"
+)
+
+// ErrNoUSB is returned by every operation when running in a browser,
+// which gives WebAssembly programs no access to USB devices.
+var ErrNoUSB = errors.New("USB radios are not supported in the browser")
+
+// StDfu is a placeholder so that package dfu, and the codeplug
+// packages importing it, build for js/wasm.
+type StDfu struct{}
+
+func Devices() ([]string, error) {
+	return nil, ErrNoUSB
+}
+
+func NewDevice(device string) (*StDfu, error) {
+	return nil, ErrNoUSB
+}
+
+func New() (*StDfu, error) {
+	return nil, ErrNoUSB
+}
+
+func (dfu *StDfu) Abort() error {
+	return ErrNoUSB
+}
+
+func (dfu *StDfu) Close() error {
+	return nil
+}
+
+func (dfu *StDfu) ClrStatus() error {
+	return ErrNoUSB
+}
+
+func (dfu *StDfu) Detach() error {
+	return ErrNoUSB
+}
+
+func (dfu *StDfu) Dnload(blockNumber int, buffer []byte) error {
+	return ErrNoUSB
+}
+
+func (dfu *StDfu) GetState() (State, error) {
+	return DfuError, ErrNoUSB
+}
+
+func (dfu *StDfu) GetStatus() (DfuStatus, error) {
+	return DfuStatus{}, ErrNoUSB
+}
+
+func (dfu *StDfu) SelectCurrentConfiguration(configIdx, interfaceIdx, altSetIdx int) error {
+	return ErrNoUSB
+}
+
+func (dfu *StDfu) GetStringDescriptor(index int) (string, error) {
+	return "", ErrNoUSB
+}
+
+func (dfu *StDfu) Upload(blockNumber int, buffer []byte) error {
+	return ErrNoUSB
+}
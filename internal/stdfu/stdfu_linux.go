@@ -21,11 +21,17 @@
 // You should have received a copy of the GNU General Public License
 // along with StDFU.  If not, see <http://www.gnu.org/licenses/>.
 
+// Package stdfu implements the low-level ST Microelectronics DFU
+// protocol used by package dfu.  It is an implementation detail of
+// dfu and is not part of the library's stable API.
 package stdfu
 
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/gousb"
@@ -48,19 +54,85 @@ type StDfu struct {
 	ctx       *gousb.Context
 }
 
+const (
+	md380Vendor  = 0x0483
+	md380Product = 0xdf11
+)
+
+// Devices returns the USB port paths of the connected radios, in the
+// form "bus-port.port...", as accepted by NewDevice.  A radio keeps its
+// path when it restarts, as long as it stays in the same USB port.
+func Devices() ([]string, error) {
+	ctx := gousb.NewContext()
+	defer ctx.Close()
+
+	var devices []string
+	devs, err := ctx.OpenDevices(func(desc *gousb.DeviceDesc) bool {
+		if isRadio(desc) {
+			devices = append(devices, devicePath(desc))
+		}
+		return false
+	})
+	for _, dev := range devs {
+		dev.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("OpenDevices failed: %v", err)
+	}
+	sort.Strings(devices)
+
+	return devices, nil
+}
+
+func isRadio(desc *gousb.DeviceDesc) bool {
+	return desc.Vendor == md380Vendor && desc.Product == md380Product
+}
+
+func devicePath(desc *gousb.DeviceDesc) string {
+	ports := make([]string, len(desc.Path))
+	for i, port := range desc.Path {
+		ports[i] = strconv.Itoa(port)
+	}
+
+	return fmt.Sprintf("%d-%s", desc.Bus, strings.Join(ports, "."))
+}
+
+// openDevice opens the radio at the USB port path device, returning
+// nil if there is none.
+func openDevice(ctx *gousb.Context, device string) (*gousb.Device, error) {
+	devs, err := ctx.OpenDevices(func(desc *gousb.DeviceDesc) bool {
+		return isRadio(desc) && devicePath(desc) == device
+	})
+	if len(devs) == 0 {
+		return nil, err
+	}
+	for _, dev := range devs[1:] {
+		dev.Close()
+	}
+
+	return devs[0], nil
+}
+
 func New() (*StDfu, error) {
+	return NewDevice("")
+}
+
+// NewDevice opens the radio at the USB port path device, as returned
+// by Devices, or the first radio found if device is "".
+func NewDevice(device string) (*StDfu, error) {
 	ctx := gousb.NewContext()
 
 	stDfu := &StDfu{
 		ctx: ctx,
 	}
 
-	const (
-		md380Vendor  = 0x0483
-		md380Product = 0xdf11
-	)
-
-	dev, err := ctx.OpenDeviceWithVIDPID(md380Vendor, md380Product)
+	var dev *gousb.Device
+	var err error
+	if device == "" {
+		dev, err = ctx.OpenDeviceWithVIDPID(md380Vendor, md380Product)
+	} else {
+		dev, err = openDevice(ctx, device)
+	}
 	if err != nil {
 		stDfu.Close()
 		return nil, fmt.Errorf("OpenDevice failed: %v", err)
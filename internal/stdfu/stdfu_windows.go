@@ -21,6 +21,9 @@
 // You should have received a copy of the GNU General Public License
 // along with Stdfu.  If not, see <http://www.gnu.org/licenses/>.
 
+// Package stdfu implements the low-level ST Microelectronics DFU
+// protocol used by package dfu.  It is an implementation detail of
+// dfu and is not part of the library's stable API.
 package stdfu
 
 import (
@@ -274,6 +277,28 @@ type UUID struct {
 	d [8]byte
 }
 
+// Devices returns the connected radio as the single device "".  Only
+// one radio may be connected on Windows.
+func Devices() ([]string, error) {
+	stDfu, err := New()
+	if err != nil {
+		return nil, err
+	}
+	stDfu.Close()
+
+	return []string{""}, nil
+}
+
+// NewDevice opens the connected radio.  Only one radio may be
+// connected on Windows, so device must be "".
+func NewDevice(device string) (*StDfu, error) {
+	if device != "" {
+		return nil, errors.New("selecting a radio by USB port is not supported on Windows")
+	}
+
+	return New()
+}
+
 func New() (*StDfu, error) {
 	devUUID := &UUID{
 		a: 0x3fe809ab,
@@ -232,6 +232,12 @@ func (fnl *fieldNameList) initMemberModel(model *core.QStringListModel, r *codep
 			return false
 		}
 
+		err = r.CheckFieldsUnlocked(fType)
+		if err != nil {
+			WarningPopup("Drop Error", err.Error())
+			return false
+		}
+
 		fields, err := fieldNamesToFields(r, fieldNames, fType)
 		if err != nil {
 			WarningPopup("Field Name Error", err.Error())
@@ -365,6 +371,12 @@ func (fnl *fieldNameList) initAvailableModel(model *core.QStringListModel, r *co
 
 		r := sfl.fieldMembers.record
 
+		err = r.CheckFieldsUnlocked(fType)
+		if err != nil {
+			WarningPopup("Add Member", err.Error())
+			return false
+		}
+
 		fields, err := fieldNamesToFields(r, fieldNames, fType)
 		if err != nil {
 			WarningPopup("Add Member", err.Error())
@@ -613,6 +625,12 @@ func (vBox *VBox) AddFieldMembers(r *codeplug.Record, sortAvailable *bool, nameT
 			return
 		}
 
+		err := r.CheckFieldsUnlocked(memberType)
+		if err != nil {
+			WarningPopup("Add Member", err.Error())
+			return
+		}
+
 		if len(allFields)+len(names) > r.MaxFields(memberType) {
 			WarningPopup("Add Member", "too many fields")
 			return
@@ -649,6 +667,12 @@ func (vBox *VBox) AddFieldMembers(r *codeplug.Record, sortAvailable *bool, nameT
 			return
 		}
 
+		err := r.CheckFieldsUnlocked(memberType)
+		if err != nil {
+			WarningPopup("Delete Member", err.Error())
+			return
+		}
+
 		fields, err := fieldNamesToFields(r, names, memberType)
 		if err != nil {
 			WarningPopup("Delete Member", err.Error())
@@ -171,6 +171,13 @@ func (rl *RecordList) RemoveSelected() error {
 		return fmt.Errorf("can't delete last record")
 	}
 
+	for _, r := range records {
+		err := r.CheckUnlocked()
+		if err != nil {
+			return err
+		}
+	}
+
 	model := rl.qListView.Model()
 	qModelIndex := core.NewQModelIndex()
 
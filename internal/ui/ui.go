@@ -33,6 +33,7 @@ import (
 	"strings"
 
 	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/internal/i18n"
 	"github.com/therecipe/qt/core"
 	"github.com/therecipe/qt/gui"
 	"github.com/therecipe/qt/widgets"
@@ -446,6 +447,15 @@ func (mw *MainWindow) NewRecordWindow(rType codeplug.RecordType, writable bool)
 		case codeplug.RemoveRecordsChange:
 			updateRecordList = true
 
+		case codeplug.RenameRecordsChange:
+			updateRecordList = true
+			for _, r := range change.Records() {
+				if rl.Current() == r.Index() {
+					w.recordFunc()
+					break
+				}
+			}
+
 		case codeplug.MoveFieldsChange,
 			codeplug.InsertFieldsChange,
 			codeplug.RemoveFieldsChange,
@@ -515,7 +525,7 @@ func (vBox *VBox) SetContentsMargins(left int, right int, top int, bottom int) {
 }
 
 func (parent *VBox) AddGroupbox(label string) *HBox {
-	qgb := widgets.NewQGroupBox2(label, nil)
+	qgb := widgets.NewQGroupBox2(i18n.T(label), nil)
 	layout := widgets.NewQHBoxLayout2(qgb)
 	layout.SetContentsMargins(0, 0, 0, 0)
 
@@ -534,7 +544,7 @@ func (vBox *VBox) Window() *Window {
 }
 
 func (parent *HBox) AddGroupbox(label string) *HBox {
-	qgb := widgets.NewQGroupBox2(label, nil)
+	qgb := widgets.NewQGroupBox2(i18n.T(label), nil)
 	layout := widgets.NewQHBoxLayout2(qgb)
 	layout.SetContentsMargins(0, 0, 0, 0)
 
@@ -698,12 +708,12 @@ func (vBox *VBox) AddSeparator() {
 }
 
 func (parent *HBox) AddLabel(str string) {
-	qLabel := widgets.NewQLabel2(str, nil, 0)
+	qLabel := widgets.NewQLabel2(i18n.T(str), nil, 0)
 	parent.layout.AddWidget(qLabel, 0, 0)
 }
 
 func (parent *VBox) AddLabel(str string) {
-	qLabel := widgets.NewQLabel2(str, nil, 0)
+	qLabel := widgets.NewQLabel2(i18n.T(str), nil, 0)
 	parent.layout.AddWidget(qLabel, 0, 0)
 }
 
@@ -804,7 +814,7 @@ func (parent *Form) addFieldRow(r *codeplug.Record, fType codeplug.FieldType) {
 		logFatalf("No %s entry in newFieldWidget slice", f.ValueType())
 	}
 	w := newFieldWidgetFunc(f)
-	w.label = widgets.NewQLabel2(f.TypeName(), nil, 0)
+	w.label = widgets.NewQLabel2(i18n.T(f.TypeName()), nil, 0)
 	parent.layout.AddRow(w.label, w.qWidget)
 
 	widgets := parent.window.widgets
@@ -845,6 +855,11 @@ func (parent *Form) addFieldRow(r *codeplug.Record, fType codeplug.FieldType) {
 		parent.subscribe(enablingFieldType, w.field.Type())
 	}
 
+	if f.CheckUnlocked() != nil {
+		w.qWidget.QWidget_PTR().SetEnabled(false)
+		w.label.SetEnabled(false)
+	}
+
 }
 
 func (parent *Form) AddReadOnlyFieldRows(r *codeplug.Record, fTypes ...codeplug.FieldType) {
@@ -862,13 +877,13 @@ func (parent *Form) addReadOnlyFieldRow(r *codeplug.Record, fType codeplug.Field
 	}
 
 	w := newFieldLineEdit(f)
-	w.label = widgets.NewQLabel2(f.TypeName(), nil, 0)
+	w.label = widgets.NewQLabel2(i18n.T(f.TypeName()), nil, 0)
 	w.SetReadOnly(true)
 	parent.layout.AddRow(w.label, w.qWidget)
 }
 
 func setEnabled(w *Widget, f *codeplug.Field) {
-	enabled := f.IsEnabled()
+	enabled := f.IsEnabled() && f.CheckUnlocked() == nil
 	qWidget := w.qWidget.QWidget_PTR()
 	if qWidget.IsEnabled() == enabled {
 		return
@@ -899,7 +914,7 @@ func (form *Form) subscribe(sender codeplug.FieldType, receiver codeplug.FieldTy
 }
 
 func (w *Widget) SetLabel(label string) {
-	w.label = widgets.NewQLabel2(label, nil, 0)
+	w.label = widgets.NewQLabel2(i18n.T(label), nil, 0)
 }
 
 func (w *Widget) update() {
@@ -1111,6 +1126,64 @@ func NewSpinboxWidget(value, min, max int, changedFunc func(int)) *Widget {
 	return widget
 }
 
+func NewLineEditWidget(text string, changedFunc func(string)) *Widget {
+	qw := widgets.NewQLineEdit2(text, nil)
+	widget := new(Widget)
+	widget.qWidget = qw
+
+	qw.ConnectTextChanged(changedFunc)
+
+	return widget
+}
+
+// NewPasswordWidget returns a line edit widget that hides the text
+// typed into it.
+func NewPasswordWidget(changedFunc func(string)) *Widget {
+	qw := widgets.NewQLineEdit(nil)
+	widget := new(Widget)
+	widget.qWidget = qw
+	qw.SetEchoMode(widgets.QLineEdit__Password)
+
+	qw.ConnectTextChanged(changedFunc)
+
+	return widget
+}
+
+// NewTextViewWidget returns a read-only widget showing text in a
+// fixed-width font, such as a hex dump.
+func NewTextViewWidget(text string) *Widget {
+	qw := widgets.NewQPlainTextEdit2(text, nil)
+	widget := new(Widget)
+	widget.qWidget = qw
+	qw.SetReadOnly(true)
+	qw.SetLineWrapMode(widgets.QPlainTextEdit__NoWrap)
+	qw.SetFont(gui.QFontDatabase_SystemFont(gui.QFontDatabase__FixedFont))
+	qw.SetMinimumSize2(gui.NewQFontMetrics(qw.Font()).AverageCharWidth()*90, 400)
+
+	return widget
+}
+
+// UpdateTextViewWidget replaces the text shown by a text view widget.
+func UpdateTextViewWidget(widget *Widget, text string) {
+	widget.qWidget.(*widgets.QPlainTextEdit).SetPlainText(text)
+}
+
+// NewTextEditWidget returns a widget for editing several lines of
+// text, such as text pasted from a web page.
+func NewTextEditWidget(text string, changedFunc func(string)) *Widget {
+	qw := widgets.NewQPlainTextEdit2(text, nil)
+	widget := new(Widget)
+	widget.qWidget = qw
+	qw.SetLineWrapMode(widgets.QPlainTextEdit__NoWrap)
+	qw.SetMinimumSize2(gui.NewQFontMetrics(qw.Font()).AverageCharWidth()*90, 200)
+
+	qw.ConnectTextChanged(func() {
+		changedFunc(qw.ToPlainText())
+	})
+
+	return widget
+}
+
 func NewCheckboxWidget(checked bool, clickedFunc func(bool)) *Widget {
 	qw := widgets.NewQCheckBox(nil)
 	widget := new(Widget)
@@ -1149,7 +1222,7 @@ func UpdateComboboxWidget(widget *Widget, opt string, opts []string) {
 
 func NewButtonWidget(text string, clicked func()) *Widget {
 	w := new(Widget)
-	b := widgets.NewQPushButton2(text, nil)
+	b := widgets.NewQPushButton2(i18n.T(text), nil)
 	b.SetSizePolicy2(widgets.QSizePolicy__Fixed,
 		widgets.QSizePolicy__Preferred)
 	b.ConnectClicked(func(checked bool) {
@@ -1244,7 +1317,7 @@ type Menu struct {
 
 func (mb *MenuBar) AddMenu(name string) *Menu {
 	menu := new(Menu)
-	menu.qMenu = mb.qMenuBar.AddMenu2(name)
+	menu.qMenu = mb.qMenuBar.AddMenu2(i18n.T(name))
 
 	return menu
 }
@@ -1259,7 +1332,7 @@ type Action struct {
 
 func (menu *Menu) AddAction(name string, fn func()) *Action {
 	action := new(Action)
-	action.qAction = menu.qMenu.AddAction(name)
+	action.qAction = menu.qMenu.AddAction(i18n.T(name))
 
 	action.qAction.ConnectTriggered(func(checked bool) {
 		fn()
@@ -1270,7 +1343,7 @@ func (menu *Menu) AddAction(name string, fn func()) *Action {
 
 func (menu *Menu) AddMenu(name string) *Menu {
 	subMenu := new(Menu)
-	subMenu.qMenu = menu.qMenu.AddMenu2(name)
+	subMenu.qMenu = menu.qMenu.AddMenu2(i18n.T(name))
 
 	return subMenu
 }
@@ -1292,7 +1365,7 @@ func (menu *Menu) SetEnabled(enable bool) {
 }
 
 func (a *Action) SetText(s string) {
-	a.qAction.SetText(s)
+	a.qAction.SetText(i18n.T(s))
 }
 
 func (a *Action) SetEnabled(enable bool) {
@@ -1335,7 +1408,7 @@ type Button struct {
 
 func NewButton(text string) *Button {
 	b := new(Button)
-	b.qWidget = *widgets.NewQPushButton2(text, nil)
+	b.qWidget = *widgets.NewQPushButton2(i18n.T(text), nil)
 	b.qWidget.SetSizePolicy2(widgets.QSizePolicy__Fixed,
 		widgets.QSizePolicy__Preferred)
 
@@ -1349,7 +1422,7 @@ func (b *Button) ConnectClicked(fn func()) {
 }
 
 func (b *Button) SetText(str string) {
-	b.qWidget.SetText(str)
+	b.qWidget.SetText(i18n.T(str))
 }
 
 func (b *Button) SetEnabled(enable bool) {
@@ -1367,7 +1440,7 @@ func (w *Window) RecordFunc() func() {
 func InfoPopup(title string, msg string) {
 	button := widgets.QMessageBox__Ok
 	defaultButton := widgets.QMessageBox__Ok
-	widgets.QMessageBox_Information(nil, title, msg, button, defaultButton)
+	widgets.QMessageBox_Information(nil, i18n.T(title), i18n.T(msg), button, defaultButton)
 }
 
 func WarningPopup(title string, msg string) PopupValue {
@@ -1381,7 +1454,7 @@ func WarningPopup(title string, msg string) PopupValue {
 	}
 	buttons := widgets.QMessageBox__Cancel | widgets.QMessageBox__Ignore
 	defButton := widgets.QMessageBox__Cancel
-	rv := widgets.QMessageBox_Warning(nil, title, msg, buttons, defButton)
+	rv := widgets.QMessageBox_Warning(nil, i18n.T(title), i18n.T(msg), buttons, defButton)
 	switch rv {
 	case widgets.QMessageBox__Cancel:
 		return PopupCancel
@@ -1400,7 +1473,7 @@ func ErrorPopup(title string, msg string) {
 	}
 	button := widgets.QMessageBox__Ok
 	defaultButton := widgets.QMessageBox__Ok
-	widgets.QMessageBox_Critical(nil, title, msg, button, defaultButton)
+	widgets.QMessageBox_Critical(nil, i18n.T(title), i18n.T(msg), button, defaultButton)
 }
 
 type PopupValue int
@@ -1418,7 +1491,7 @@ func SavePopup(title string, msg string) PopupValue {
 	buttons := widgets.QMessageBox__Save |
 		widgets.QMessageBox__Discard | widgets.QMessageBox__Cancel
 
-	rv := widgets.QMessageBox_Warning(nil, title, msg, buttons, 0)
+	rv := widgets.QMessageBox_Warning(nil, i18n.T(title), i18n.T(msg), buttons, 0)
 	switch rv {
 	case widgets.QMessageBox__Save:
 		break
@@ -1436,7 +1509,7 @@ func SavePopup(title string, msg string) PopupValue {
 func YesNoPopup(title string, msg string) PopupValue {
 	buttons := widgets.QMessageBox__Yes | widgets.QMessageBox__No
 
-	rv := widgets.QMessageBox_Warning(nil, title, msg, buttons, 0)
+	rv := widgets.QMessageBox_Warning(nil, i18n.T(title), i18n.T(msg), buttons, 0)
 	switch rv {
 	case widgets.QMessageBox__Yes:
 		return PopupYes
@@ -1498,7 +1571,7 @@ func NewDialog(title string) *Dialog {
 	dialog.layout = widgets.NewQVBoxLayout2(dialog.qDialog)
 	dialog.layout.AddWidget(&dialog.VBox.qWidget, 0, 0)
 
-	dialog.qDialog.SetWindowTitle(title)
+	dialog.qDialog.SetWindowTitle(i18n.T(title))
 	dialog.qDialog.SetWindowModality(core.Qt__ApplicationModal)
 
 	return dialog
@@ -1538,6 +1611,42 @@ func OpenXLSXFilename(title string, dir string) string {
 	return widgets.QFileDialog_GetOpenFileName(nil, title, dir, filter, selF, 0)
 }
 
+func OpenContactsFilename(title string, dir string) string {
+	selF := "(*.vcf *.csv)"
+	filter := "Contact files " + selF + ";;All files (*)"
+	return widgets.QFileDialog_GetOpenFileName(nil, title, dir, filter, selF, 0)
+}
+
+func OpenOverlayFilename(title string, dir string) string {
+	selF := "(*.txt *.json)"
+	filter := "Overlay files " + selF + ";;All files (*)"
+	return widgets.QFileDialog_GetOpenFileName(nil, title, dir, filter, selF, 0)
+}
+
+func OpenEncryptedFilename(title string, dir string) string {
+	selF := "(*.enc)"
+	filter := "Encrypted codeplug files " + selF + ";;All files (*)"
+	return widgets.QFileDialog_GetOpenFileName(nil, title, dir, filter, selF, 0)
+}
+
+func OpenCalibrationFilename(title string, dir string) string {
+	selF := "(*.cal)"
+	filter := "Calibration files " + selF + ";;All files (*)"
+	return widgets.QFileDialog_GetOpenFileName(nil, title, dir, filter, selF, 0)
+}
+
+func OpenFlashDumpFilename(title string, dir string) string {
+	selF := "(*.bin *.img)"
+	filter := "Flash dump files " + selF + ";;All files (*)"
+	return widgets.QFileDialog_GetOpenFileName(nil, title, dir, filter, selF, 0)
+}
+
+func OpenCPSArchiveFilename(title string, dir string) string {
+	selF := "(*.exe *.zip)"
+	filter := "CPS installer files " + selF + ";;All files (*)"
+	return widgets.QFileDialog_GetOpenFileName(nil, title, dir, filter, selF, 0)
+}
+
 func OpenCPFilenames(title string, dir string, exts []string) []string {
 	for i, ext := range exts {
 		exts[i] = "*." + ext
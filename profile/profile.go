@@ -0,0 +1,240 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Profile.
+//
+// Profile is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Profile is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Profile.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package profile stores named sets of preferred options, such as user
+// database filters, output formats, name templates and backup
+// locations, so that someone managing several radios can select the
+// preferences for each by name in dmrRadio and editcp.
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// A Profile holds option values for dmrRadio's subcommands, keyed by
+// subcommand and then by option name, without the leading "-".  The
+// values are those that would be given on the command line.  editcp
+// uses the options of the subcommands corresponding to its actions.
+type Profile struct {
+	Name    string                       `json:"-"`
+	Options map[string]map[string]string `json:"options"`
+}
+
+// Dir returns the directory holding the user's profiles.
+func Dir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "codeplug", "profiles"), nil
+}
+
+// checkName returns an error if name cannot be used as a profile name.
+func checkName(name string) error {
+	if name == "" || strings.ContainsAny(name, `/\:`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid profile name: %q", name)
+	}
+
+	return nil
+}
+
+// filename returns the name of the file holding the named profile.
+func filename(name string) (string, error) {
+	err := checkName(name)
+	if err != nil {
+		return "", err
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, name+".json"), nil
+}
+
+// Names returns the names of the user's profiles, sorted.
+func Names() ([]string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(filenames))
+	for i, filename := range filenames {
+		names[i] = strings.TrimSuffix(filepath.Base(filename), ".json")
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// New returns an empty profile with the given name.
+func New(name string) (*Profile, error) {
+	err := checkName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		Name:    name,
+		Options: make(map[string]map[string]string),
+	}, nil
+}
+
+// Load reads the named profile.
+func Load(name string) (*Profile, error) {
+	filename, err := filename(name)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("profile %s does not exist", name)
+		}
+		return nil, err
+	}
+
+	p, err := New(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(bytes, p)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err.Error())
+	}
+	if p.Options == nil {
+		p.Options = make(map[string]map[string]string)
+	}
+
+	return p, nil
+}
+
+// Save writes the profile, replacing any previous profile of the same
+// name.
+func (p *Profile) Save() (err error) {
+	filename, err := filename(p.Name)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := json.MarshalIndent(p, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filename), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := vfs.OS.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fErr := file.Close()
+		if err == nil {
+			err = fErr
+		}
+	}()
+
+	_, err = file.Write(append(bytes, '\n'))
+
+	return err
+}
+
+// Delete removes the named profile.
+func Delete(name string) error {
+	filename, err := filename(name)
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(filename)
+}
+
+// commandKey returns the key of p.Options matching the named
+// subcommand without regard to case, or command if there is none.
+func (p *Profile) commandKey(command string) string {
+	for key := range p.Options {
+		if strings.EqualFold(key, command) {
+			return key
+		}
+	}
+
+	return command
+}
+
+// CommandOptions returns the options of the named subcommand, which is
+// matched without regard to case.
+func (p *Profile) CommandOptions(command string) map[string]string {
+	return p.Options[p.commandKey(command)]
+}
+
+// Option returns the value of an option of the named subcommand, and
+// whether the profile sets it.
+func (p *Profile) Option(command, option string) (string, bool) {
+	value, ok := p.CommandOptions(command)[option]
+
+	return value, ok
+}
+
+// SetOption sets the value of an option of the named subcommand.  An
+// empty value removes the option from the profile.
+func (p *Profile) SetOption(command, option, value string) {
+	command = p.commandKey(command)
+	option = strings.TrimPrefix(option, "-")
+
+	opts := p.Options[command]
+	if value == "" {
+		delete(opts, option)
+		if len(opts) == 0 {
+			delete(p.Options, command)
+		}
+		return
+	}
+
+	if opts == nil {
+		opts = make(map[string]string)
+		p.Options[command] = opts
+	}
+	opts[option] = value
+}
@@ -0,0 +1,398 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Service.
+//
+// Service is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Service is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Service.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package service exposes codeplug operations over HTTP, so that a web
+// front end, or a program written in another language, can parse,
+// validate, convert and compare codeplugs and build user databases
+// without running dmrRadio.
+//
+// Requests carry the file in the body and a JSON object or the
+// converted file in the response:
+//
+//	POST /v1/parse                       model, frequency range and record counts
+//	POST /v1/validate                    warnings found while loading
+//	POST /v1/convert?to=<format>         the codeplug in another format
+//	POST /v1/diff                        differences between form files a and b
+//	POST /v1/userdb?format=<format>      a users database for the radio
+//
+// The type of an uploaded codeplug is given by the type query parameter,
+// one of rdt, bin, txt, json or xlsx, or is sniffed from its contents.
+// Errors are returned as a JSON object with an "error" member.
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/userdb"
+	"github.com/dalefarnsworth/codeplug/vfs"
+)
+
+// MaxUploadSize is the largest request body accepted.
+const MaxUploadSize = 32 << 20
+
+// badRequest marks errors caused by the request rather than the server.
+type badRequest struct {
+	error
+}
+
+// NewHandler returns an http.Handler serving the codeplug operations.
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/parse", post(parse))
+	mux.HandleFunc("/v1/validate", post(validate))
+	mux.HandleFunc("/v1/convert", post(convert))
+	mux.HandleFunc("/v1/diff", post(diff))
+	mux.HandleFunc("/v1/userdb", post(buildUsers))
+
+	return mux
+}
+
+// post wraps a handler, accepting only POST requests, limiting the
+// size of the request body and reporting the handler's error.
+func post(handler func(w http.ResponseWriter, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, MaxUploadSize)
+
+		err := handler(w, r)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if _, ok := err.(badRequest); ok {
+				status = http.StatusBadRequest
+			}
+			writeError(w, status, err)
+		}
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// fileTypes maps the type query parameter to codeplug file types.
+var fileTypes = map[string]codeplug.FileType{
+	"rdt":  codeplug.FileTypeNone,
+	"bin":  codeplug.FileTypeNone,
+	"txt":  codeplug.FileTypeText,
+	"json": codeplug.FileTypeJSON,
+	"xlsx": codeplug.FileTypeXLSX,
+}
+
+// sniffType returns the type query parameter value describing data.
+func sniffType(data []byte) string {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return "json"
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")):
+		return "xlsx"
+	case utf8.Valid(data) && bytes.IndexByte(data, 0) < 0:
+		return "txt"
+	}
+
+	return "rdt"
+}
+
+// loadCodeplug writes data to a file in fsys and loads it as a
+// codeplug of the given type, sniffing the type if it is empty.  If
+// ignoreWarnings is false, warnings found while loading are returned
+// as a codeplug.Warning.  The caller must free the codeplug.
+func loadCodeplug(fsys *vfs.MemFS, name string, data []byte, typ string, ignoreWarnings bool) (*codeplug.Codeplug, error) {
+	if typ == "" {
+		typ = sniffType(data)
+	}
+	fType, ok := fileTypes[typ]
+	if !ok {
+		return nil, badRequest{fmt.Errorf("unknown codeplug type: %s", typ)}
+	}
+
+	filename := name + "." + typ
+	err := fsys.WriteFile(filename, data)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := codeplug.NewCodeplugFS(fsys, fType, filename)
+	if err != nil {
+		return nil, badRequest{err}
+	}
+
+	models, freqs := cp.ModelsFrequencyRanges()
+	if len(models) == 0 {
+		return nil, badRequest{errors.New("unknown model in codeplug")}
+	}
+	model := models[0]
+	if len(freqs[model]) == 0 {
+		return nil, badRequest{errors.New("unknown frequency range in codeplug")}
+	}
+
+	err = cp.Load(model, freqs[model][0], ignoreWarnings)
+	if _, warning := err.(codeplug.Warning); warning {
+		return nil, err
+	}
+	if err != nil {
+		return nil, badRequest{err}
+	}
+
+	return cp, nil
+}
+
+// withCodeplug reads the request body and loads it as a codeplug,
+// calling fn with the loaded codeplug.  If ignoreWarnings is false and
+// loading finds warnings, fn is instead called with a nil codeplug and
+// the warnings.
+func withCodeplug(r *http.Request, ignoreWarnings bool, fn func(cp *codeplug.Codeplug, warning error) error) error {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return badRequest{err}
+	}
+
+	cp, err := loadCodeplug(vfs.NewMemFS(), "codeplug", data, r.FormValue("type"), ignoreWarnings)
+	if _, warning := err.(codeplug.Warning); warning {
+		return fn(nil, err)
+	}
+	if err != nil {
+		return err
+	}
+	defer cp.Free()
+
+	return fn(cp, nil)
+}
+
+// parseResult is the response to /v1/parse.
+type parseResult struct {
+	Model          string         `json:"model"`
+	FrequencyRange string         `json:"frequencyRange"`
+	Records        map[string]int `json:"records"`
+}
+
+func parse(w http.ResponseWriter, r *http.Request) error {
+	return withCodeplug(r, true, func(cp *codeplug.Codeplug, warning error) error {
+		result := parseResult{
+			Model:          cp.Model(),
+			FrequencyRange: cp.FrequencyRange(),
+			Records:        make(map[string]int),
+		}
+		for _, rType := range cp.RecordTypes() {
+			records := cp.Records(rType)
+			if len(records) != 0 {
+				result.Records[records[0].TypeName()] = len(records)
+			}
+		}
+
+		writeJSON(w, http.StatusOK, result)
+		return nil
+	})
+}
+
+// validateResult is the response to /v1/validate.
+type validateResult struct {
+	Valid    bool     `json:"valid"`
+	Warnings []string `json:"warnings"`
+}
+
+func validate(w http.ResponseWriter, r *http.Request) error {
+	return withCodeplug(r, false, func(cp *codeplug.Codeplug, warning error) error {
+		result := validateResult{
+			Valid:    warning == nil,
+			Warnings: []string{},
+		}
+		if warning != nil {
+			for _, line := range strings.Split(warning.Error(), "\n") {
+				if strings.TrimSpace(line) != "" {
+					result.Warnings = append(result.Warnings, line)
+				}
+			}
+		}
+
+		writeJSON(w, http.StatusOK, result)
+		return nil
+	})
+}
+
+// contentTypes maps the to query parameter of /v1/convert to the
+// content type of the response.
+var contentTypes = map[string]string{
+	"rdt":  "application/octet-stream",
+	"txt":  "text/plain; charset=utf-8",
+	"json": "application/json",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"html": "text/html; charset=utf-8",
+	"md":   "text/markdown; charset=utf-8",
+}
+
+func convert(w http.ResponseWriter, r *http.Request) error {
+	to := r.FormValue("to")
+	contentType, ok := contentTypes[to]
+	if !ok {
+		return badRequest{fmt.Errorf("unknown conversion: %q", to)}
+	}
+
+	return withCodeplug(r, true, func(cp *codeplug.Codeplug, warning error) error {
+		var buf bytes.Buffer
+		switch to {
+		case "html":
+			if err := cp.WriteHTML(&buf); err != nil {
+				return err
+			}
+		case "md":
+			if err := cp.WriteMarkdown(&buf); err != nil {
+				return err
+			}
+		default:
+			var err error
+			filename := "converted." + to
+			switch to {
+			case "txt":
+				err = cp.ExportText(filename)
+			case "json":
+				err = cp.ExportJSON(filename)
+			case "xlsx":
+				err = cp.ExportXLSX(filename)
+			case "rdt":
+				ignoreWarnings := true
+				err = cp.SaveToFile(filename, ignoreWarnings)
+			}
+			if err != nil {
+				return err
+			}
+
+			data, err := fs.ReadFile(cp.FileSystem(), filename)
+			if err != nil {
+				return err
+			}
+			buf.Write(data)
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		_, err := io.Copy(w, &buf)
+		return err
+	})
+}
+
+// diffResult is one element of the response to /v1/diff.
+type diffResult struct {
+	Record string `json:"record"`
+	Field  string `json:"field,omitempty"`
+	A      string `json:"a"`
+	B      string `json:"b"`
+}
+
+// formFile returns the contents of the named file in a multipart form.
+func formFile(r *http.Request, name string) ([]byte, error) {
+	file, _, err := r.FormFile(name)
+	if err != nil {
+		return nil, badRequest{fmt.Errorf("%s: %s", name, err.Error())}
+	}
+	defer file.Close()
+
+	return ioutil.ReadAll(file)
+}
+
+func diff(w http.ResponseWriter, r *http.Request) error {
+	aData, err := formFile(r, "a")
+	if err != nil {
+		return err
+	}
+	bData, err := formFile(r, "b")
+	if err != nil {
+		return err
+	}
+
+	fsys := vfs.NewMemFS()
+	a, err := loadCodeplug(fsys, "a", aData, r.FormValue("aType"), true)
+	if err != nil {
+		return err
+	}
+	defer a.Free()
+
+	b, err := loadCodeplug(fsys, "b", bData, r.FormValue("bType"), true)
+	if err != nil {
+		return err
+	}
+	defer b.Free()
+
+	results := []diffResult{}
+	for _, d := range codeplug.Diff(a, b) {
+		results = append(results, diffResult{d.Record, d.Field, d.A, d.B})
+	}
+
+	writeJSON(w, http.StatusOK, results)
+	return nil
+}
+
+// userFormats maps the format query parameter of /v1/userdb to the
+// function writing the users database.
+var userFormats = map[string]func(db *userdb.UsersDB, w io.Writer) (int64, error){
+	"md380tools": func(db *userdb.UsersDB, w io.Writer) (int64, error) {
+		return db.WriteMD380ToolsTo(w, nil)
+	},
+	"md2017": func(db *userdb.UsersDB, w io.Writer) (int64, error) {
+		return db.WriteMD2017To(w, nil)
+	},
+}
+
+func buildUsers(w http.ResponseWriter, r *http.Request) error {
+	format := r.FormValue("format")
+	if format == "" {
+		format = "md380tools"
+	}
+	write, ok := userFormats[format]
+	if !ok {
+		return badRequest{fmt.Errorf("unknown users format: %q", format)}
+	}
+
+	// The database is gathered before responding, so that an error
+	// can still be reported with its status.
+	var data bytes.Buffer
+	_, err := write(userdb.New(), &data)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	_, err = data.WriteTo(w)
+	return err
+}
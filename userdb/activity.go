@@ -0,0 +1,146 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// minActivityFilteredID is the smallest ID dropped by the activity
+// filter.  Smaller IDs belong to repeaters, reflectors and other
+// services rather than to individual users.
+const minActivityFilteredID codeplug.DmrID = 1000000
+
+// SetActivityFilter drops the users not heard on the network since the
+// given time.  Last-heard times are read from source, a filename or an
+// http(s) URL, as CSV lines of the form "id,time".  The time is in
+// Unix seconds, RFC 3339 or "2006-01-02 15:04:05" form, in UTC unless
+// a zone is given.  Such a log may be exported from the Brandmeister
+// last-heard data, or collected locally, as from a hotspot.  IDs below
+// 1000000, used by repeaters and reflectors, are never dropped.  A zero
+// since disables the filter.
+func (db *UsersDB) SetActivityFilter(source string, since time.Time) {
+	db.lastHeardSource = source
+	db.activeSince = since
+}
+
+// getLastHeard returns the last-heard times of the source.
+func (db *UsersDB) getLastHeard() (map[codeplug.DmrID]time.Time, error) {
+	var bytes []byte
+	var err error
+	source := db.lastHeardSource
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		bytes, err = db.getBytes(source)
+	} else {
+		bytes, err = ioutil.ReadFile(source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting last heard times: %s: %s", source, err.Error())
+	}
+
+	lastHeard := make(map[codeplug.DmrID]time.Time)
+	for i, line := range strings.Split(string(bytes), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, ",", 3)
+		for j := range fields {
+			fields[j] = strings.Trim(strings.TrimSpace(fields[j]), `"`)
+		}
+		id, err := codeplug.ParseDmrID(fields[0])
+		if err != nil && i == 0 {
+			continue // header
+		}
+		if err == nil && len(fields) < 2 {
+			err = errors.New("no time")
+		}
+		var t time.Time
+		if err == nil {
+			t, err = parseLastHeardTime(fields[1])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bad last heard entry: %s: line %d: %s", source, i+1, err.Error())
+		}
+
+		if t.After(lastHeard[id]) {
+			lastHeard[id] = t
+		}
+	}
+
+	return lastHeard, nil
+}
+
+func parseLastHeardTime(s string) (time.Time, error) {
+	secs, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return time.Unix(secs, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("bad time: %s", s)
+}
+
+// filterInactive returns the users heard since db.activeSince.
+func (db *UsersDB) filterInactive(users []*User) ([]*User, error) {
+	if db.activeSince.IsZero() {
+		return users, nil
+	}
+
+	lastHeard, err := db.getLastHeard()
+	if err != nil {
+		return nil, err
+	}
+
+	active := users[:0]
+	for _, u := range users {
+		if db.active(u, lastHeard) {
+			active = append(active, u)
+		}
+	}
+
+	return active, nil
+}
+
+func (db *UsersDB) active(u *User, lastHeard map[codeplug.DmrID]time.Time) bool {
+	if db.activeSince.IsZero() || u.ID < minActivityFilteredID || db.customIDs[u.ID] {
+		return true
+	}
+
+	return !lastHeard[u.ID].Before(db.activeSince)
+}
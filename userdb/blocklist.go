@@ -0,0 +1,101 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// SetBlocklist sets the DMR IDs and callsigns of users to exclude from
+// the database, such as abusive stations or withdrawn IDs.  Entries
+// that parse as DMR IDs are IDs; others are callsigns, compared without
+// regard to case.
+func (db *UsersDB) SetBlocklist(entries []string) {
+	db.blockedIDs = make(map[codeplug.DmrID]bool)
+	db.blockedCallsigns = make(map[string]bool)
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, err := codeplug.ParseDmrID(entry)
+		if err == nil {
+			db.blockedIDs[id] = true
+			continue
+		}
+		db.blockedCallsigns[strings.ToUpper(entry)] = true
+	}
+}
+
+// ReadBlocklist reads blocklist entries, one per line.  Blank lines
+// and text following a "#" are ignored.
+func ReadBlocklist(r io.Reader) ([]string, error) {
+	var entries []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line != "" {
+			entries = append(entries, line)
+		}
+	}
+
+	return entries, scanner.Err()
+}
+
+// Blocked returns the number of users removed by the blocklist when
+// the database was last built.
+func (db *UsersDB) Blocked() int {
+	return db.blocked
+}
+
+// filterBlocked returns the users not in the blocklist.
+func (db *UsersDB) filterBlocked(users []*User) []*User {
+	db.blocked = 0
+	if len(db.blockedIDs) == 0 && len(db.blockedCallsigns) == 0 {
+		return users
+	}
+
+	kept := users[:0]
+	for _, u := range users {
+		if db.isBlocked(u) {
+			db.blocked++
+			continue
+		}
+		kept = append(kept, u)
+	}
+
+	return kept
+}
+
+func (db *UsersDB) isBlocked(u *User) bool {
+	return db.blockedIDs[u.ID] || db.blockedCallsigns[strings.ToUpper(strings.TrimSpace(u.Callsign))]
+}
@@ -0,0 +1,170 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// A CallsignCheck selects what is done with users whose callsigns are
+// not valid amateur callsigns.
+type CallsignCheck int
+
+const (
+	// CallsignsUnchecked leaves callsigns unchecked.
+	CallsignsUnchecked CallsignCheck = iota
+
+	// CallsignsWarn reports users with invalid callsigns as warnings,
+	// but keeps them.
+	CallsignsWarn
+
+	// CallsignsDrop reports users with invalid callsigns as warnings
+	// and drops them.
+	CallsignsDrop
+)
+
+// ParseCallsignCheck returns the CallsignCheck named by s: "warn",
+// "drop", or "" for CallsignsUnchecked.
+func ParseCallsignCheck(s string) (CallsignCheck, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return CallsignsUnchecked, nil
+	case "warn":
+		return CallsignsWarn, nil
+	case "drop":
+		return CallsignsDrop, nil
+	}
+
+	return CallsignsUnchecked, fmt.Errorf("bad callsign check: %s", s)
+}
+
+// SetCallsignCheck sets what is done with users whose callsigns are
+// rejected by ValidCallsign.  Only the callsigns of individual users
+// are checked; IDs below 1000000, used by repeaters, reflectors and
+// other services, and custom users are exempt.  By default, callsigns
+// are unchecked.
+func (db *UsersDB) SetCallsignCheck(check CallsignCheck) {
+	db.callsignCheck = check
+}
+
+// callsignPattern matches the ITU form of a callsign: a prefix of one
+// or two letters, a digit and one or two letters, or a letter and a
+// digit, followed by a digit and a suffix of up to four characters
+// ending in a letter.
+var callsignPattern = regexp.MustCompile(`^([A-Z]{1,2}|[0-9][A-Z]{1,2}|[A-Z][0-9])[0-9][A-Z0-9]{0,3}[A-Z]$`)
+
+// placeholderCallsigns are stand-ins found in place of real callsigns.
+var placeholderCallsigns = map[string]bool{
+	"NOCALL":  true,
+	"N0CALL":  true,
+	"NONE":    true,
+	"UNKNOWN": true,
+	"TEST":    true,
+}
+
+// ValidCallsign returns an error if s is not a valid amateur callsign.
+// A callsign may carry portable designators, as in "DL/W1AW/P".  Its
+// prefix may not begin with Q, 0 or 1, which the ITU has not
+// allocated to any country.
+func ValidCallsign(s string) error {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	switch {
+	case s == "":
+		return errors.New("empty callsign")
+	case placeholderCallsigns[s]:
+		return errors.New("placeholder callsign")
+	case strings.Trim(s, "0123456789") == "":
+		return errors.New("numeric callsign")
+	case strings.Trim(s, "*#?-_.X") == "":
+		return errors.New("placeholder callsign")
+	}
+
+	// The base callsign is the longest part; the others are
+	// designators such as a country prefix, "P" or "MM".
+	parts := strings.Split(s, "/")
+	base := ""
+	for _, part := range parts {
+		if len(part) > len(base) {
+			base = part
+		}
+	}
+	for _, part := range parts {
+		if part == base {
+			continue
+		}
+		if part == "" || len(part) > 4 || strings.Trim(part, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789") != "" {
+			return errors.New("bad callsign designator")
+		}
+	}
+
+	if !callsignPattern.MatchString(base) {
+		return errors.New("bad callsign format")
+	}
+	if strings.IndexByte("Q01", base[0]) >= 0 {
+		return errors.New("unallocated callsign prefix")
+	}
+
+	return nil
+}
+
+// checkCallsign reports whether u is kept by the callsign check,
+// warning of an invalid callsign.
+func (db *UsersDB) checkCallsign(u *User) bool {
+	if db.callsignCheck == CallsignsUnchecked || u.ID < minActivityFilteredID || db.customIDs[u.ID] {
+		return true
+	}
+
+	err := ValidCallsign(u.Callsign)
+	if err == nil {
+		return true
+	}
+
+	source := "user " + u.ID.String()
+	if db.callsignCheck == CallsignsDrop {
+		db.warn(source, 0, "%s, dropped: %q", err.Error(), u.Callsign)
+		return false
+	}
+	db.warn(source, 0, "%s: %q", err.Error(), u.Callsign)
+
+	return true
+}
+
+// filterCallsigns returns the users kept by the callsign check.
+func (db *UsersDB) filterCallsigns(users []*User) []*User {
+	if db.callsignCheck == CallsignsUnchecked {
+		return users
+	}
+
+	kept := users[:0]
+	for _, u := range users {
+		if db.checkCallsign(u) {
+			kept = append(kept, u)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,155 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SetTitleCase sets whether names and cities given entirely in capital
+// letters, as some sources do, are rewritten in title case, as in
+// "John MacDonald" for "JOHN MACDONALD".
+func (db *UsersDB) SetTitleCase(titleCase bool) {
+	db.titleCase = titleCase
+}
+
+// Surnames whose capitalization isn't that of title case.
+var capitalizationExceptions = []string{
+	"MacArthur",
+	"MacDonald",
+	"MacDougall",
+	"MacGregor",
+	"MacInnes",
+	"MacIntosh",
+	"MacIntyre",
+	"MacKay",
+	"MacKenzie",
+	"MacKinnon",
+	"MacLean",
+	"MacLeod",
+	"MacMillan",
+	"MacNeil",
+	"MacPherson",
+	"DeSantis",
+	"DiMaggio",
+	"LaFleur",
+	"LeBlanc",
+}
+
+var capitalizationTable = newCapitalizationTable()
+
+func newCapitalizationTable() map[string]string {
+	table := make(map[string]string)
+	for _, name := range capitalizationExceptions {
+		table[strings.ToUpper(name)] = name
+	}
+
+	// Generational suffixes.
+	for _, s := range []string{"II", "III", "IV", "VI", "VII", "VIII"} {
+		table[s] = s
+	}
+	table["JR"] = "Jr"
+	table["SR"] = "Sr"
+
+	return table
+}
+
+// Words that are not capitalized, unless they begin the name.
+var lowerCaseWords = map[string]bool{
+	"AND": true,
+	"DA":  true,
+	"DAS": true,
+	"DE":  true,
+	"DEL": true,
+	"DER": true,
+	"DI":  true,
+	"DOS": true,
+	"DU":  true,
+	"OF":  true,
+	"VAN": true,
+	"VON": true,
+}
+
+// titleCase returns s in title case if it is entirely in capital
+// letters.  Words containing digits, such as callsigns, and words
+// following a "/", such as callsign suffixes, are left alone.
+func titleCase(s string) string {
+	hasUpper := false
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return s
+		}
+		if unicode.IsUpper(r) {
+			hasUpper = true
+		}
+	}
+	if !hasUpper {
+		return s
+	}
+
+	words := strings.Split(s, " ")
+	for i, word := range words {
+		if i > 0 && lowerCaseWords[word] {
+			words[i] = strings.ToLower(word)
+			continue
+		}
+		words[i] = titleCaseWord(word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// titleCaseWord returns word in title case.  Each part of a word
+// joined by hyphens is capitalized, as in "Smith-Jones", as is the
+// letter following a one-letter prefix, as in "O'Brien".
+func titleCaseWord(word string) string {
+	if word == "" || strings.ContainsAny(word, "0123456789") {
+		return word
+	}
+	if s, ok := capitalizationTable[word]; ok {
+		return s
+	}
+
+	runes := []rune(word)
+	capitalize := true
+	for i, r := range runes {
+		if r == '/' {
+			break
+		}
+		if capitalize {
+			runes[i] = unicode.ToUpper(r)
+		} else {
+			runes[i] = unicode.ToLower(r)
+		}
+		capitalize = r == '-' || r == '.' || (r == '\'' && i == 1)
+	}
+	word = string(runes)
+
+	if strings.HasPrefix(word, "Mc") && len(word) > 2 {
+		word = "Mc" + strings.ToUpper(word[2:3]) + word[3:]
+	}
+
+	return word
+}
@@ -0,0 +1,500 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A CountryStyle selects how the Country values of users are written.
+type CountryStyle int
+
+const (
+	// CountryUnchanged leaves Country values as the sources give
+	// them.
+	CountryUnchanged CountryStyle = iota
+
+	// CountryNames writes the canonical country names, as in
+	// "United States" for "USA" or "U.S.A.".
+	CountryNames
+
+	// CountryCodes writes ISO 3166 alpha-2 codes, as in "US".
+	CountryCodes
+)
+
+// ParseCountryStyle returns the CountryStyle named by s: "name",
+// "iso", or "" for CountryUnchanged.
+func ParseCountryStyle(s string) (CountryStyle, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return CountryUnchanged, nil
+	case "name", "names":
+		return CountryNames, nil
+	case "iso", "code", "codes":
+		return CountryCodes, nil
+	}
+
+	return CountryUnchanged, fmt.Errorf("bad country style: %s", s)
+}
+
+// SetCountryStyle sets how the Country values of users are
+// normalized.  By default, they are left unchanged.  Countries that
+// aren't recognized are always left unchanged.
+func (db *UsersDB) SetCountryStyle(style CountryStyle) {
+	db.countryStyle = style
+}
+
+type country struct {
+	code string
+	name string
+}
+
+// countries holds the ISO 3166 alpha-2 codes and the canonical names
+// of the countries.
+var countries = []country{
+	{"AD", "Andorra"},
+	{"AE", "United Arab Emirates"},
+	{"AF", "Afghanistan"},
+	{"AG", "Antigua and Barbuda"},
+	{"AI", "Anguilla"},
+	{"AL", "Albania"},
+	{"AM", "Armenia"},
+	{"AO", "Angola"},
+	{"AQ", "Antarctica"},
+	{"AR", "Argentina"},
+	{"AS", "American Samoa"},
+	{"AT", "Austria"},
+	{"AU", "Australia"},
+	{"AW", "Aruba"},
+	{"AX", "Aland Islands"},
+	{"AZ", "Azerbaijan"},
+	{"BA", "Bosnia and Herzegovina"},
+	{"BB", "Barbados"},
+	{"BD", "Bangladesh"},
+	{"BE", "Belgium"},
+	{"BF", "Burkina Faso"},
+	{"BG", "Bulgaria"},
+	{"BH", "Bahrain"},
+	{"BI", "Burundi"},
+	{"BJ", "Benin"},
+	{"BL", "Saint Barthelemy"},
+	{"BM", "Bermuda"},
+	{"BN", "Brunei"},
+	{"BO", "Bolivia"},
+	{"BQ", "Caribbean Netherlands"},
+	{"BR", "Brazil"},
+	{"BS", "Bahamas"},
+	{"BT", "Bhutan"},
+	{"BV", "Bouvet Island"},
+	{"BW", "Botswana"},
+	{"BY", "Belarus"},
+	{"BZ", "Belize"},
+	{"CA", "Canada"},
+	{"CC", "Cocos Islands"},
+	{"CD", "Democratic Republic of the Congo"},
+	{"CF", "Central African Republic"},
+	{"CG", "Republic of the Congo"},
+	{"CH", "Switzerland"},
+	{"CI", "Ivory Coast"},
+	{"CK", "Cook Islands"},
+	{"CL", "Chile"},
+	{"CM", "Cameroon"},
+	{"CN", "China"},
+	{"CO", "Colombia"},
+	{"CR", "Costa Rica"},
+	{"CU", "Cuba"},
+	{"CV", "Cape Verde"},
+	{"CW", "Curacao"},
+	{"CX", "Christmas Island"},
+	{"CY", "Cyprus"},
+	{"CZ", "Czech Republic"},
+	{"DE", "Germany"},
+	{"DJ", "Djibouti"},
+	{"DK", "Denmark"},
+	{"DM", "Dominica"},
+	{"DO", "Dominican Republic"},
+	{"DZ", "Algeria"},
+	{"EC", "Ecuador"},
+	{"EE", "Estonia"},
+	{"EG", "Egypt"},
+	{"EH", "Western Sahara"},
+	{"ER", "Eritrea"},
+	{"ES", "Spain"},
+	{"ET", "Ethiopia"},
+	{"FI", "Finland"},
+	{"FJ", "Fiji"},
+	{"FK", "Falkland Islands"},
+	{"FM", "Micronesia"},
+	{"FO", "Faroe Islands"},
+	{"FR", "France"},
+	{"GA", "Gabon"},
+	{"GB", "United Kingdom"},
+	{"GD", "Grenada"},
+	{"GE", "Georgia"},
+	{"GF", "French Guiana"},
+	{"GG", "Guernsey"},
+	{"GH", "Ghana"},
+	{"GI", "Gibraltar"},
+	{"GL", "Greenland"},
+	{"GM", "Gambia"},
+	{"GN", "Guinea"},
+	{"GP", "Guadeloupe"},
+	{"GQ", "Equatorial Guinea"},
+	{"GR", "Greece"},
+	{"GS", "South Georgia and the South Sandwich Islands"},
+	{"GT", "Guatemala"},
+	{"GU", "Guam"},
+	{"GW", "Guinea-Bissau"},
+	{"GY", "Guyana"},
+	{"HK", "Hong Kong"},
+	{"HM", "Heard Island and McDonald Islands"},
+	{"HN", "Honduras"},
+	{"HR", "Croatia"},
+	{"HT", "Haiti"},
+	{"HU", "Hungary"},
+	{"ID", "Indonesia"},
+	{"IE", "Ireland"},
+	{"IL", "Israel"},
+	{"IM", "Isle of Man"},
+	{"IN", "India"},
+	{"IO", "British Indian Ocean Territory"},
+	{"IQ", "Iraq"},
+	{"IR", "Iran"},
+	{"IS", "Iceland"},
+	{"IT", "Italy"},
+	{"JE", "Jersey"},
+	{"JM", "Jamaica"},
+	{"JO", "Jordan"},
+	{"JP", "Japan"},
+	{"KE", "Kenya"},
+	{"KG", "Kyrgyzstan"},
+	{"KH", "Cambodia"},
+	{"KI", "Kiribati"},
+	{"KM", "Comoros"},
+	{"KN", "Saint Kitts and Nevis"},
+	{"KP", "North Korea"},
+	{"KR", "South Korea"},
+	{"KW", "Kuwait"},
+	{"KY", "Cayman Islands"},
+	{"KZ", "Kazakhstan"},
+	{"LA", "Laos"},
+	{"LB", "Lebanon"},
+	{"LC", "Saint Lucia"},
+	{"LI", "Liechtenstein"},
+	{"LK", "Sri Lanka"},
+	{"LR", "Liberia"},
+	{"LS", "Lesotho"},
+	{"LT", "Lithuania"},
+	{"LU", "Luxembourg"},
+	{"LV", "Latvia"},
+	{"LY", "Libya"},
+	{"MA", "Morocco"},
+	{"MC", "Monaco"},
+	{"MD", "Moldova"},
+	{"ME", "Montenegro"},
+	{"MF", "Saint Martin"},
+	{"MG", "Madagascar"},
+	{"MH", "Marshall Islands"},
+	{"MK", "North Macedonia"},
+	{"ML", "Mali"},
+	{"MM", "Myanmar"},
+	{"MN", "Mongolia"},
+	{"MO", "Macau"},
+	{"MP", "Northern Mariana Islands"},
+	{"MQ", "Martinique"},
+	{"MR", "Mauritania"},
+	{"MS", "Montserrat"},
+	{"MT", "Malta"},
+	{"MU", "Mauritius"},
+	{"MV", "Maldives"},
+	{"MW", "Malawi"},
+	{"MX", "Mexico"},
+	{"MY", "Malaysia"},
+	{"MZ", "Mozambique"},
+	{"NA", "Namibia"},
+	{"NC", "New Caledonia"},
+	{"NE", "Niger"},
+	{"NF", "Norfolk Island"},
+	{"NG", "Nigeria"},
+	{"NI", "Nicaragua"},
+	{"NL", "Netherlands"},
+	{"NO", "Norway"},
+	{"NP", "Nepal"},
+	{"NR", "Nauru"},
+	{"NU", "Niue"},
+	{"NZ", "New Zealand"},
+	{"OM", "Oman"},
+	{"PA", "Panama"},
+	{"PE", "Peru"},
+	{"PF", "French Polynesia"},
+	{"PG", "Papua New Guinea"},
+	{"PH", "Philippines"},
+	{"PK", "Pakistan"},
+	{"PL", "Poland"},
+	{"PM", "Saint Pierre and Miquelon"},
+	{"PN", "Pitcairn"},
+	{"PR", "Puerto Rico"},
+	{"PS", "Palestine"},
+	{"PT", "Portugal"},
+	{"PW", "Palau"},
+	{"PY", "Paraguay"},
+	{"QA", "Qatar"},
+	{"RE", "Reunion"},
+	{"RO", "Romania"},
+	{"RS", "Serbia"},
+	{"RU", "Russia"},
+	{"RW", "Rwanda"},
+	{"SA", "Saudi Arabia"},
+	{"SB", "Solomon Islands"},
+	{"SC", "Seychelles"},
+	{"SD", "Sudan"},
+	{"SE", "Sweden"},
+	{"SG", "Singapore"},
+	{"SH", "Saint Helena"},
+	{"SI", "Slovenia"},
+	{"SJ", "Svalbard and Jan Mayen"},
+	{"SK", "Slovakia"},
+	{"SL", "Sierra Leone"},
+	{"SM", "San Marino"},
+	{"SN", "Senegal"},
+	{"SO", "Somalia"},
+	{"SR", "Suriname"},
+	{"SS", "South Sudan"},
+	{"ST", "Sao Tome and Principe"},
+	{"SV", "El Salvador"},
+	{"SX", "Sint Maarten"},
+	{"SY", "Syria"},
+	{"SZ", "Eswatini"},
+	{"TC", "Turks and Caicos Islands"},
+	{"TD", "Chad"},
+	{"TF", "French Southern Territories"},
+	{"TG", "Togo"},
+	{"TH", "Thailand"},
+	{"TJ", "Tajikistan"},
+	{"TK", "Tokelau"},
+	{"TL", "Timor-Leste"},
+	{"TM", "Turkmenistan"},
+	{"TN", "Tunisia"},
+	{"TO", "Tonga"},
+	{"TR", "Turkey"},
+	{"TT", "Trinidad and Tobago"},
+	{"TV", "Tuvalu"},
+	{"TW", "Taiwan"},
+	{"TZ", "Tanzania"},
+	{"UA", "Ukraine"},
+	{"UG", "Uganda"},
+	{"UM", "US Minor Outlying Islands"},
+	{"US", "United States"},
+	{"UY", "Uruguay"},
+	{"UZ", "Uzbekistan"},
+	{"VA", "Vatican City"},
+	{"VC", "Saint Vincent and the Grenadines"},
+	{"VE", "Venezuela"},
+	{"VG", "British Virgin Islands"},
+	{"VI", "US Virgin Islands"},
+	{"VN", "Vietnam"},
+	{"VU", "Vanuatu"},
+	{"WF", "Wallis and Futuna"},
+	{"WS", "Samoa"},
+	{"YE", "Yemen"},
+	{"YT", "Mayotte"},
+	{"ZA", "South Africa"},
+	{"ZM", "Zambia"},
+	{"ZW", "Zimbabwe"},
+}
+
+// Other names found in the sources, by country code.
+var countryAliases = map[string]string{
+	"USA":                      "US",
+	"U S A":                    "US",
+	"U S":                      "US",
+	"UNITED STATES OF AMERICA": "US",
+	"AMERICA":                  "US",
+	"UK":                       "GB",
+	"U K":                      "GB",
+	"GREAT BRITAIN":            "GB",
+	"BRITAIN":                  "GB",
+	"ENGLAND":                  "GB",
+	"SCOTLAND":                 "GB",
+	"WALES":                    "GB",
+	"NORTHERN IRELAND":         "GB",
+	"UNITED KINGDOM OF GREAT BRITAIN AND NORTHERN IRELAND": "GB",
+	"DEUTSCHLAND":                          "DE",
+	"FEDERAL REPUBLIC OF GERMANY":          "DE",
+	"RUSSIAN FEDERATION":                   "RU",
+	"KOREA REPUBLIC OF":                    "KR",
+	"REPUBLIC OF KOREA":                    "KR",
+	"KOREA":                                "KR",
+	"KOREA DEMOCRATIC PEOPLES REPUBLIC OF": "KP",
+	"CZECHIA":                              "CZ",
+	"CZECH":                                "CZ",
+	"HOLLAND":                              "NL",
+	"THE NETHERLANDS":                      "NL",
+	"NEDERLAND":                            "NL",
+	"ESPANA":                               "ES",
+	"ITALIA":                               "IT",
+	"SCHWEIZ":                              "CH",
+	"SUISSE":                               "CH",
+	"OSTERREICH":                           "AT",
+	"OESTERREICH":                          "AT",
+	"BELGIE":                               "BE",
+	"BELGIQUE":                             "BE",
+	"POLSKA":                               "PL",
+	"SVERIGE":                              "SE",
+	"NORGE":                                "NO",
+	"DANMARK":                              "DK",
+	"SUOMI":                                "FI",
+	"PEOPLES REPUBLIC OF CHINA":            "CN",
+	"P R CHINA":                            "CN",
+	"PRC":                                  "CN",
+	"REPUBLIC OF CHINA":                    "TW",
+	"TAIWAN REPUBLIC OF CHINA":             "TW",
+	"TAIWAN ROC":                           "TW",
+	"HONG KONG SAR":                        "HK",
+	"MACAO":                                "MO",
+	"BOSNIA AND HERCEGOVINA":               "BA",
+	"BOSNIA":                               "BA",
+	"MACEDONIA":                            "MK",
+	"FYROM":                                "MK",
+	"REPUBLIC OF NORTH MACEDONIA":          "MK",
+	"SWAZILAND":                            "SZ",
+	"BURMA":                                "MM",
+	"EAST TIMOR":                           "TL",
+	"COTE D IVOIRE":                        "CI",
+	"CAPE VERDE":                           "CV",
+	"CABO VERDE":                           "CV",
+	"HOLY SEE":                             "VA",
+	"VATICAN":                              "VA",
+	"SLOVAK REPUBLIC":                      "SK",
+	"MOLDOVA REPUBLIC OF":                  "MD",
+	"REPUBLIC OF MOLDOVA":                  "MD",
+	"IRAN ISLAMIC REPUBLIC OF":             "IR",
+	"SYRIAN ARAB REPUBLIC":                 "SY",
+	"LAO PEOPLES DEMOCRATIC REPUBLIC":      "LA",
+	"VIET NAM":                             "VN",
+	"BRUNEI DARUSSALAM":                    "BN",
+	"TANZANIA UNITED REPUBLIC OF":          "TZ",
+	"VENEZUELA BOLIVARIAN REPUBLIC OF":     "VE",
+	"BOLIVIA PLURINATIONAL STATE OF":       "BO",
+	"UAE":                                  "AE",
+	"U A E":                                "AE",
+	"ST KITTS AND NEVIS":                   "KN",
+	"ST LUCIA":                             "LC",
+	"ST VINCENT AND THE GRENADINES":        "VC",
+	"ST VINCENT":                           "VC",
+	"ST HELENA":                            "SH",
+	"ST PIERRE AND MIQUELON":               "PM",
+	"ST BARTHELEMY":                        "BL",
+	"ST MARTIN":                            "MF",
+	"ST MAARTEN":                           "SX",
+	"CONGO":                                "CG",
+	"CONGO DEMOCRATIC REPUBLIC OF THE":     "CD",
+	"DR CONGO":                             "CD",
+	"DRC":                                  "CD",
+	"FALKLAND ISLANDS MALVINAS":            "FK",
+	"FAROE":                                "FO",
+	"FAEROE ISLANDS":                       "FO",
+	"VIRGIN ISLANDS BRITISH":               "VG",
+	"VIRGIN ISLANDS U S":                   "VI",
+	"VIRGIN ISLANDS US":                    "VI",
+	"U S VIRGIN ISLANDS":                   "VI",
+	"PUERTO RICO USA":                      "PR",
+	"GUAM USA":                             "GU",
+	"TRINIDAD":                             "TT",
+	"ANTIGUA":                              "AG",
+	"REUNION ISLAND":                       "RE",
+	"CURACAO":                              "CW",
+	"TURKIYE":                              "TR",
+	"PALESTINE STATE OF":                   "PS",
+	"MICRONESIA FEDERATED STATES OF":       "FM",
+}
+
+// countryTable maps the normalized forms of the countries' names,
+// codes and aliases to the countries.
+var countryTable = newCountryTable()
+
+func newCountryTable() map[string]country {
+	table := make(map[string]country)
+	byCode := make(map[string]country)
+	for _, c := range countries {
+		table[nameKey(c.name)] = c
+		byCode[c.code] = c
+	}
+	for alias, code := range countryAliases {
+		table[alias] = byCode[code]
+	}
+
+	return table
+}
+
+// lookupCountry returns the country named by s, which may be a name,
+// alias or ISO 3166 alpha-2 code.
+func lookupCountry(s string) (country, bool) {
+	key := nameKey(s)
+	c, ok := countryTable[key]
+	if !ok && len(key) == 2 {
+		for _, c := range countries {
+			if c.code == key {
+				return c, true
+			}
+		}
+	}
+
+	return c, ok
+}
+
+// CanonicalCountry returns the canonical name of the country named by
+// s, which may be a name, alias or ISO 3166 alpha-2 code.  It returns
+// s if the country isn't recognized.
+func CanonicalCountry(s string) string {
+	c, ok := lookupCountry(s)
+	if !ok {
+		return s
+	}
+	return c.name
+}
+
+// CountryCode returns the ISO 3166 alpha-2 code of the country named
+// by s, or "" if the country isn't recognized.
+func CountryCode(s string) string {
+	c, _ := lookupCountry(s)
+	return c.code
+}
+
+// normalizeCountry rewrites the user's Country in the given style, if
+// the country is recognized.
+func (u *User) normalizeCountry(style CountryStyle) {
+	c, ok := lookupCountry(u.Country)
+	if !ok {
+		return
+	}
+
+	switch style {
+	case CountryNames:
+		u.Country = c.name
+	case CountryCodes:
+		u.Country = c.code
+	}
+}
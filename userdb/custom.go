@@ -0,0 +1,94 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// AddCustomUsers adds local users, such as club members awaiting their
+// IDs or temporary event IDs, to those downloaded.  Their non-empty
+// fields replace those of downloaded users with the same ID.  Custom
+// users are never dropped by the region, country or activity filters.
+func (db *UsersDB) AddCustomUsers(users []*User) {
+	if db.customIDs == nil {
+		db.customIDs = make(map[codeplug.DmrID]bool)
+	}
+	for _, u := range users {
+		user := *u
+		db.customUsers = append(db.customUsers, &user)
+		db.customIDs[u.ID] = true
+	}
+}
+
+// ReadUsersCSV reads users from CSV lines of the form
+// "id,callsign,name,city,state,country".  Trailing fields may be
+// omitted.  A first line not beginning with an ID is taken as a
+// header and skipped.
+func ReadUsersCSV(r io.Reader) ([]*User, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var users []*User
+	for line := 1; ; line++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 1 && strings.TrimSpace(fields[0]) == "" {
+			continue
+		}
+
+		id, err := codeplug.ParseDmrID(fields[0])
+		if err != nil {
+			if line == 1 {
+				continue // header
+			}
+			return nil, fmt.Errorf("line %d: invalid DMR ID %q: %s", line, fields[0], err.Error())
+		}
+
+		for len(fields) < 6 {
+			fields = append(fields, "")
+		}
+		users = append(users, &User{
+			ID:       id,
+			Callsign: fields[1],
+			Name:     fields[2],
+			City:     fields[3],
+			State:    fields[4],
+			Country:  fields[5],
+		})
+	}
+
+	return users, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// SetDialOptions sets the timeout of each connection attempt to a
+// source and whether IPv4 addresses are tried before IPv6 ones.  With
+// preferIPv4, a host with a broken IPv6 address is still reached
+// quickly over IPv4.  A timeout of 0 leaves the system's timeout in
+// place.
+func (db *UsersDB) SetDialOptions(timeout time.Duration, preferIPv4 bool) {
+	db.dialTimeout = timeout
+	db.preferIPv4 = preferIPv4
+	db.client = nil
+}
+
+// dialContext returns the function used to connect to the sources,
+// or nil for the default one.
+func (db *UsersDB) dialContext() func(ctx context.Context, network, address string) (net.Conn, error) {
+	if db.resolver == nil && db.dialTimeout == 0 && !db.preferIPv4 {
+		return nil
+	}
+
+	dialer := &net.Dialer{
+		Resolver: db.resolver,
+		Timeout:  db.dialTimeout,
+	}
+	if !db.preferIPv4 {
+		return dialer.DialContext
+	}
+
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		if network != "tcp" {
+			return dialer.DialContext(ctx, network, address)
+		}
+
+		conn, err := dialer.DialContext(ctx, "tcp4", address)
+		if err == nil {
+			return conn, nil
+		}
+		conn, err6 := dialer.DialContext(ctx, "tcp6", address)
+		if err6 == nil {
+			return conn, nil
+		}
+
+		return nil, err
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// Contact is a codeplug contact to be added to the users database.
+type Contact struct {
+	ID    codeplug.DmrID
+	Name  string
+	Group bool
+}
+
+// ContactUsers returns the md380tools database extras for contacts:
+// an entry naming each talkgroup, and an alias for each private
+// contact.  A private contact whose name begins with a valid callsign
+// sets that callsign and, with the rest of its name, the user's name;
+// otherwise its whole name is the user's name.  Given to
+// AddCustomUsers, the entries override only those fields of the
+// downloaded users.  Only the first contact with each ID is used.
+func ContactUsers(contacts []Contact) []*User {
+	var users []*User
+	seen := make(map[codeplug.DmrID]bool)
+	for _, c := range contacts {
+		name := strings.TrimSpace(c.Name)
+		if c.ID == 0 || c.ID > codeplug.MaxDmrID || name == "" || seen[c.ID] {
+			continue
+		}
+		seen[c.ID] = true
+
+		user := &User{ID: c.ID}
+		fields := strings.Fields(name)
+		switch {
+		case c.Group:
+			user.Callsign = "TG " + c.ID.String()
+			user.Name = name
+		case ValidCallsign(fields[0]) == nil:
+			user.Callsign = strings.ToUpper(fields[0])
+			user.Name = strings.Join(fields[1:], " ")
+		default:
+			user.Name = name
+		}
+		users = append(users, user)
+	}
+
+	return users
+}
+
+// WriteUsersCSV writes users as CSV lines of the form
+// "id,callsign,name,city,state,country", preceded by a header, as
+// read by ReadUsersCSV.
+func WriteUsersCSV(w io.Writer, users []*User) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"id", "callsign", "name", "city", "state", "country"})
+	for _, u := range users {
+		writer.Write([]string{
+			u.ID.String(),
+			u.Callsign,
+			u.Name,
+			u.City,
+			u.State,
+			u.Country,
+		})
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
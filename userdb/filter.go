@@ -0,0 +1,142 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// A region is a filter preset, selecting the users whose IDs begin
+// with the Mobile Country Codes (MCCs) of a part of the world.
+type region struct {
+	name     string
+	mccRange [][2]int
+}
+
+var regions = []region{
+	{"europe", [][2]int{{200, 299}}},
+	{"north-america", [][2]int{{300, 399}}},
+	{"asia", [][2]int{{400, 499}}},
+	{"oceania", [][2]int{{500, 599}}},
+	{"asia-pacific", [][2]int{{400, 599}}},
+	{"africa", [][2]int{{600, 699}}},
+	{"south-america", [][2]int{{700, 799}}},
+}
+
+// Regions returns the names of the filter presets accepted by
+// SetRegionFilter.
+func Regions() []string {
+	names := make([]string, len(regions))
+	for i, r := range regions {
+		names[i] = r.name
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SetRegionFilter keeps only the users in the named regions, as
+// returned by Regions.  A user's region is that of the Mobile Country
+// Code (MCC) beginning its ID.  IDs below 100000, used by reflectors
+// and other services, are always kept.
+func (db *UsersDB) SetRegionFilter(names ...string) error {
+	var mccRanges [][2]int
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		found := false
+		for _, r := range regions {
+			if r.name == name {
+				mccRanges = append(mccRanges, r.mccRange...)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown region: %s", name)
+		}
+	}
+	db.mccRanges = mccRanges
+
+	return nil
+}
+
+// SetCountryFilter keeps only the users in the named countries.
+// Countries are compared by their canonical names, so "USA" selects
+// the users of "United States".
+func (db *UsersDB) SetCountryFilter(countries ...string) {
+	db.countries = make(map[string]bool)
+	for _, c := range countries {
+		db.countries[CanonicalCountry(strings.TrimSpace(c))] = true
+	}
+}
+
+// idMCC returns the Mobile Country Code beginning id, or -1 for
+// IDs too short to have one.
+func idMCC(id codeplug.DmrID) int {
+	switch {
+	case id >= 1000000:
+		return int(id / 10000 % 1000)
+	case id >= 100000:
+		return int(id / 1000)
+	}
+	return -1
+}
+
+// filterRegions returns the users selected by the region and country
+// filters.  A user selected by either filter is kept.
+func (db *UsersDB) filterRegions(users []*User) []*User {
+	if len(db.mccRanges) == 0 && len(db.countries) == 0 {
+		return users
+	}
+
+	selected := users[:0]
+	for _, u := range users {
+		if db.selected(u) {
+			selected = append(selected, u)
+		}
+	}
+
+	return selected
+}
+
+func (db *UsersDB) selected(u *User) bool {
+	if len(db.mccRanges) == 0 && len(db.countries) == 0 {
+		return true
+	}
+
+	mcc := idMCC(u.ID)
+	if mcc < 0 || db.customIDs[u.ID] {
+		return true
+	}
+	for _, r := range db.mccRanges {
+		if mcc >= r[0] && mcc <= r[1] {
+			return true
+		}
+	}
+
+	return db.countries[CanonicalCountry(u.Country)]
+}
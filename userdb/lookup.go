@@ -0,0 +1,103 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// radioidLookup is the response of the radioid.net user lookup API.
+type radioidLookup struct {
+	Count   int
+	Results []struct {
+		ID       json.Number `json:"id"`
+		Callsign string      `json:"callsign"`
+		Fname    string      `json:"fname"`
+		Surname  string      `json:"surname"`
+		City     string      `json:"city"`
+		State    string      `json:"state"`
+		Country  string      `json:"country"`
+	}
+}
+
+// LookupUser returns the radioid.net registration of a single DMR ID,
+// such as the operator's own, without downloading the whole database.
+func (db *UsersDB) LookupUser(id codeplug.DmrID) (*User, error) {
+	if id == 0 || id > codeplug.MaxDmrID {
+		return nil, fmt.Errorf("invalid DMR ID: %d", id)
+	}
+
+	u, err := url.Parse(db.radioidLookupURL)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	query.Set("id", id.String())
+	u.RawQuery = query.Encode()
+
+	bytes, err := db.getBytes(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", u.Host, err.Error())
+	}
+
+	var lookup radioidLookup
+	err = json.Unmarshal(bytes, &lookup)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", u.Host, err.Error())
+	}
+
+	for _, result := range lookup.Results {
+		if result.ID.String() != id.String() {
+			continue
+		}
+		user := &User{
+			ID:       id,
+			Callsign: result.Callsign,
+			Name:     strings.TrimSpace(result.Fname + " " + result.Surname),
+			City:     result.City,
+			State:    result.State,
+			Country:  result.Country,
+		}
+		user.normalize()
+
+		return user, nil
+	}
+
+	return nil, fmt.Errorf("DMR ID %s is not registered at radioid.net", id)
+}
+
+// FirstName returns the first word of the user's name.
+func (u *User) FirstName() string {
+	fields := strings.Fields(u.Name)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[0]
+}
@@ -0,0 +1,182 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// SetMarcFallbackURLs sets the URLs of mirrors publishing the users
+// database in the legacy DMR-MARC users.csv format.  If the radioid.net
+// users file cannot be downloaded, the mirrors are tried in order and
+// the users of the first that succeeds are used in its place.
+func (db *UsersDB) SetMarcFallbackURLs(urls ...string) {
+	db.marcFallbackURLs = urls
+}
+
+// getMarcFallbackUsers passes the users of the first DMR-MARC mirror
+// that can be read to sink, or returns radioidErr if none can.
+func (db *UsersDB) getMarcFallbackUsers(sink *userSink, radioidErr error) error {
+	for _, url := range db.marcFallbackURLs {
+		err := db.getMarcUsers(url, sink)
+		if err != nil {
+			db.warn(url, 0, "%s", err.Error())
+			resetErr := sink.reset()
+			if resetErr != nil {
+				return resetErr
+			}
+			continue
+		}
+		db.warn(url, 0, "used in place of radioid users: %s", radioidErr.Error())
+		return nil
+	}
+
+	return radioidErr
+}
+
+// getMarcUsers passes the users of the DMR-MARC mirror at url to sink.
+// Its errors are reported as warnings about url, so they omit it.
+func (db *UsersDB) getMarcUsers(url string, sink *userSink) error {
+	body, err := db.getBody(url)
+	if err != nil {
+		return fmt.Errorf("error getting DMR-MARC users database: %s", err.Error())
+	}
+	defer body.Close()
+
+	n, err := db.parseMarcUsers(url, body, sink)
+	if err != nil {
+		return err
+	}
+
+	if n < 50000 {
+		return fmt.Errorf("too few DMR-MARC users database entries: %d", n)
+	}
+
+	return nil
+}
+
+// marcColumns maps the DMR-MARC header names, in lower case and
+// without spaces, to the User fields.
+var marcColumns = map[string]string{
+	"radioid":  "id",
+	"radio_id": "id",
+	"id":       "id",
+	"dmrid":    "id",
+	"callsign": "callsign",
+	"name":     "name",
+	"city":     "city",
+	"state":    "state",
+	"country":  "country",
+}
+
+// parseMarcUsers parses a DMR-MARC users.csv file.  Its header line,
+// such as "Radio ID,Callsign,Name,City,State,Country,Remarks", names
+// the columns.  Without a header, the columns are taken to be
+// id,callsign,name,city,state,country.  The users are passed to sink as
+// they are read, and their number is returned.
+func (db *UsersDB) parseMarcUsers(source string, r io.Reader, sink *userSink) (int, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	columns := map[string]int{
+		"id":       0,
+		"callsign": 1,
+		"name":     2,
+		"city":     3,
+		"state":    4,
+		"country":  5,
+	}
+
+	n := 0
+	for line := 1; ; line++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+		if len(fields) == 1 && strings.TrimSpace(fields[0]) == "" {
+			continue
+		}
+
+		if line == 1 {
+			if _, err := codeplug.ParseDmrID(fields[0]); err != nil {
+				header := make(map[string]int)
+				for i, f := range fields {
+					key := strings.ToLower(strings.Replace(strings.TrimSpace(f), " ", "", -1))
+					if name, ok := marcColumns[key]; ok {
+						header[name] = i
+					}
+				}
+				if _, ok := header["id"]; !ok {
+					return n, errors.New("no radio ID column in header")
+				}
+				columns = header
+				continue
+			}
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return fields[i]
+		}
+
+		id, err := parseUserID(field("id"))
+		if err != nil {
+			db.warn(source, line, "%s", err.Error())
+			continue
+		}
+		if id == 0 {
+			db.warn(source, line, "no ID")
+			continue
+		}
+		err = sink.add(&User{
+			ID:       id,
+			Callsign: field("callsign"),
+			Name:     field("name"),
+			City:     field("city"),
+			State:    field("state"),
+			Country:  field("country"),
+		})
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	return n, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+)
+
+// SetRecording sets a directory in which the raw HTTP responses from
+// the sources are recorded.  If replay is true, the responses are
+// instead read from the directory, as recorded earlier, and nothing
+// is downloaded.  Replaying a recording reproduces the database built
+// from the sources as they were when it was made.  If dir is "", as by
+// default, responses are neither recorded nor replayed.
+func (db *UsersDB) SetRecording(dir string, replay bool) {
+	db.recordDir = dir
+	db.replay = replay
+}
+
+// recordedURLHeader is added to each recorded response to identify
+// the URL from which it came.
+const recordedURLHeader = "X-Userdb-Url"
+
+func (db *UsersDB) recordingFilename(url string) string {
+	name := fmt.Sprintf("%x.http", sha1.Sum([]byte(url)))
+	return filepath.Join(db.recordDir, name)
+}
+
+// get gets url, recording or replaying the response if requested.
+func (db *UsersDB) get(url string) (*http.Response, error) {
+	if db.recordDir == "" {
+		return db.httpClient().Get(url)
+	}
+
+	filename := db.recordingFilename(url)
+	if db.replay {
+		dump, err := ioutil.ReadFile(filename)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("no recorded response in %s", db.recordDir)
+			}
+			return nil, err
+		}
+
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		return http.ReadResponse(bufio.NewReader(bytes.NewReader(dump)), req)
+	}
+
+	resp, err := db.httpClient().Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Header.Set(recordedURLHeader, url)
+	dump, err := httputil.DumpResponse(resp, true)
+	if err == nil {
+		err = os.MkdirAll(db.recordDir, 0755)
+	}
+	if err == nil {
+		tmpFilename := filename + ".tmp"
+		err = ioutil.WriteFile(tmpFilename, dump, 0644)
+		if err == nil {
+			err = os.Rename(tmpFilename, filename)
+		}
+	}
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
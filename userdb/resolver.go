@@ -0,0 +1,177 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SetResolver sets the DNS server used to look up the hosts of the
+// sources, for use where the system's resolver returns bad addresses.
+// The server is either the "host[:port]" of a DNS server, port 53 by
+// default, or the URL of a DNS-over-HTTPS server, such as
+// "https://1.1.1.1/dns-query".  If server is "", the system's resolver
+// is used.
+func (db *UsersDB) SetResolver(server string) error {
+	db.client = nil
+	db.resolver = nil
+
+	server = strings.TrimSpace(server)
+	switch {
+	case server == "":
+		return nil
+
+	case strings.HasPrefix(server, "https://"):
+		db.resolver = newDoHResolver(server)
+
+	case strings.Contains(server, "://"):
+		return fmt.Errorf("bad resolver: %s", server)
+
+	default:
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			server = net.JoinHostPort(strings.Trim(server, "[]"), "53")
+		}
+		db.resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, network, server)
+			},
+		}
+	}
+
+	return nil
+}
+
+const dnsMessageType = "application/dns-message"
+
+// newDoHResolver returns a resolver that sends its queries to the
+// DNS-over-HTTPS server at url.  The server's own host is looked up
+// by the system's resolver.
+func newDoHResolver(url string) *net.Resolver {
+	client := &http.Client{Timeout: defaultTransportTimeout}
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return &dohConn{url: url, client: client, ctx: ctx}, nil
+		},
+	}
+}
+
+// A dohConn is a net.Conn that passes the DNS queries written to it
+// to a DNS-over-HTTPS server.  Like a TCP connection to a DNS server,
+// each message is preceded by its 2-byte length.
+type dohConn struct {
+	url      string
+	client   *http.Client
+	ctx      context.Context
+	request  bytes.Buffer
+	response bytes.Buffer
+}
+
+func (c *dohConn) Write(b []byte) (int, error) {
+	c.request.Write(b)
+
+	for c.request.Len() >= 2 {
+		buf := c.request.Bytes()
+		length := int(binary.BigEndian.Uint16(buf))
+		if len(buf) < 2+length {
+			break
+		}
+		query := append([]byte{}, buf[2:2+length]...)
+		c.request.Next(2 + length)
+
+		answer, err := c.exchange(query)
+		if err != nil {
+			return 0, err
+		}
+
+		var prefix [2]byte
+		binary.BigEndian.PutUint16(prefix[:], uint16(len(answer)))
+		c.response.Write(prefix[:])
+		c.response.Write(answer)
+	}
+
+	return len(b), nil
+}
+
+func (c *dohConn) exchange(query []byte) ([]byte, error) {
+	req, err := http.NewRequest("POST", c.url, bytes.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dnsMessageType)
+	req.Header.Set("Accept", dnsMessageType)
+
+	resp, err := c.client.Do(req.WithContext(c.ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("%s: %s", c.url, resp.Status)
+	}
+
+	answer, err := ioutil.ReadAll(io.LimitReader(resp.Body, 0xffff+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(answer) > 0xffff {
+		return nil, errors.New(c.url + ": DNS answer too long")
+	}
+
+	return answer, nil
+}
+
+func (c *dohConn) Read(b []byte) (int, error) {
+	if c.response.Len() == 0 {
+		return 0, io.EOF
+	}
+
+	return c.response.Read(b)
+}
+
+func (c *dohConn) Close() error                       { return nil }
+func (c *dohConn) LocalAddr() net.Addr                { return dohAddr(c.url) }
+func (c *dohConn) RemoteAddr() net.Addr               { return dohAddr(c.url) }
+func (c *dohConn) SetDeadline(t time.Time) error      { return nil }
+func (c *dohConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *dohConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type dohAddr string
+
+func (a dohAddr) Network() string { return "https" }
+func (a dohAddr) String() string  { return string(a) }
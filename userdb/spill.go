@@ -0,0 +1,467 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// SetSpillDir enables bounded memory mode, for small systems such as a
+// Raspberry Pi hotspot.  The sources are downloaded one at a time and
+// their users are sorted, in runs of at most spillRunSize users, and
+// written to temporary files in dir as they are read.  The files are
+// then merged while the database is written, so that at most one run
+// is held in memory while reading and the merge holds only one user of
+// each file, rather than a map of all users.  If dir is "", as by default, all sources
+// are held in memory.
+func (db *UsersDB) SetSpillDir(dir string) {
+	db.spillDir = dir
+}
+
+// eachSpilledUser calls fn for each user of the database, in order of
+// ID, spilling the users of each source to a temporary file.
+func (db *UsersDB) eachSpilledUser(fn func(*User) error) (err error) {
+	db.resetWarnings()
+
+	sources, err := db.userSources()
+	if err != nil {
+		return err
+	}
+
+	dir, err := ioutil.TempDir(db.spillDir, "userdb")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	db.setMaxProgressCount(len(sources))
+
+	// Each source is read as it arrives, straight into the runs,
+	// so that no more than a run of its users is held at once.
+	runs := &runWriter{dir: dir}
+	sink := &userSink{
+		add:   runs.addUser,
+		reset: runs.reset,
+	}
+	for _, source := range sources {
+		err = runs.begin()
+		if err != nil {
+			return err
+		}
+
+		err = source(sink)
+		if err != nil {
+			return err
+		}
+
+		err = db.progressFunc()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = runs.add(db.customUsers)
+	if err != nil {
+		return err
+	}
+	err = runs.flush()
+	if err != nil {
+		return err
+	}
+
+	var lastHeard map[codeplug.DmrID]time.Time
+	if !db.activeSince.IsZero() {
+		lastHeard, err = db.getLastHeard()
+		if err != nil {
+			return err
+		}
+	}
+
+	var readers spillHeap
+	for i, filename := range runs.filenames {
+		r, err := newSpillReader(filename)
+		if err != nil {
+			return err
+		}
+		defer r.close()
+
+		r.index = i
+		if r.user != nil {
+			readers = append(readers, r)
+		}
+	}
+	heap.Init(&readers)
+
+	db.blocked = 0
+	for len(readers) != 0 {
+		// Runs are in order of increasing precedence, as are the
+		// users of each run with the same ID, and the heap yields
+		// the users of an ID in that order.
+		id := readers[0].user.ID
+		var merged *User
+		for len(readers) != 0 && readers[0].user.ID == id {
+			r := readers[0]
+			if merged == nil {
+				merged = r.user
+			} else {
+				mergeUser(merged, r.user)
+			}
+			err = r.next()
+			if err != nil {
+				return err
+			}
+			if r.user == nil {
+				heap.Pop(&readers)
+			} else {
+				heap.Fix(&readers, 0)
+			}
+		}
+
+		if db.isBlocked(merged) {
+			db.blocked++
+			continue
+		}
+		if !db.checkCallsign(merged) || !db.selected(merged) || !db.active(merged, lastHeard) {
+			continue
+		}
+
+		db.finishUser(merged)
+		err = fn(merged)
+		if err != nil {
+			return err
+		}
+	}
+
+	db.clearStaging()
+	db.finalProgress()
+
+	return nil
+}
+
+// spillRunSize is the largest number of users sorted and written to a
+// spill file at once.
+const spillRunSize = 50000
+
+// A runWriter gathers users, in order of increasing precedence, and
+// writes them as sorted runs of at most spillRunSize users, each to its
+// own file in dir.  Each source begins a new run, so that the runs of a
+// source may be discarded.
+type runWriter struct {
+	dir       string
+	users     []*User
+	filenames []string
+	first     int
+}
+
+// begin begins the runs of a source, writing the current run.
+func (w *runWriter) begin() error {
+	err := w.flush()
+	w.first = len(w.filenames)
+
+	return err
+}
+
+// reset discards the users added since begin, and their runs.
+func (w *runWriter) reset() error {
+	for _, filename := range w.filenames[w.first:] {
+		err := os.Remove(filename)
+		if err != nil {
+			return err
+		}
+	}
+	w.filenames = w.filenames[:w.first]
+	w.clear()
+
+	return nil
+}
+
+// add adds users to the current run, writing each run as it fills.
+func (w *runWriter) add(users []*User) error {
+	for _, u := range users {
+		err := w.addUser(u)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addUser adds u to the current run, writing the run if it fills.
+func (w *runWriter) addUser(u *User) error {
+	if u == nil || u.ID == 0 {
+		return nil
+	}
+
+	w.users = append(w.users, u)
+	if len(w.users) == spillRunSize {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// flush writes the current run, if it has any users.
+func (w *runWriter) flush() error {
+	if len(w.users) == 0 {
+		return nil
+	}
+
+	filename := filepath.Join(w.dir, fmt.Sprintf("run%d.csv", len(w.filenames)))
+	err := spillUsers(filename, w.users)
+	if err != nil {
+		return err
+	}
+	w.filenames = append(w.filenames, filename)
+	w.clear()
+
+	return nil
+}
+
+// clear empties the current run, letting its users be collected.
+func (w *runWriter) clear() {
+	for i := range w.users {
+		w.users[i] = nil
+	}
+	w.users = w.users[:0]
+}
+
+// spillUsers writes users, sorted by ID, to a new CSV file.
+func spillUsers(filename string, users []*User) (err error) {
+	var sorted []*User
+	for _, u := range users {
+		if u != nil && u.ID != 0 {
+			sorted = append(sorted, u)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	w, err := createSpillFile(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cErr := w.close()
+		if err == nil {
+			err = cErr
+		}
+	}()
+
+	for _, u := range sorted {
+		err = w.write(u)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A spillWriter writes users to a spill file, in the order given.
+type spillWriter struct {
+	file   *os.File
+	writer *csv.Writer
+}
+
+func createSpillFile(filename string) (*spillWriter, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &spillWriter{
+		file:   file,
+		writer: csv.NewWriter(file),
+	}
+
+	return w, nil
+}
+
+func (w *spillWriter) write(u *User) error {
+	return w.writer.Write([]string{
+		strconv.FormatUint(uint64(u.ID), 10),
+		u.Callsign,
+		u.Name,
+		u.City,
+		u.State,
+		u.Country,
+	})
+}
+
+// reset discards the users written to the file.
+func (w *spillWriter) reset() error {
+	err := w.file.Truncate(0)
+	if err != nil {
+		return err
+	}
+	_, err = w.file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	w.writer = csv.NewWriter(w.file)
+
+	return nil
+}
+
+func (w *spillWriter) close() error {
+	w.writer.Flush()
+	err := w.writer.Error()
+	cErr := w.file.Close()
+	if err == nil {
+		err = cErr
+	}
+
+	return err
+}
+
+// A spillReader reads the users of a spill file in order.  Its user
+// is the next user of the file, or nil at the end of the file.
+type spillReader struct {
+	file   *os.File
+	reader *csv.Reader
+	user   *User
+	index  int
+}
+
+func newSpillReader(filename string) (*spillReader, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &spillReader{
+		file:   file,
+		reader: csv.NewReader(bufio.NewReader(file)),
+	}
+	r.reader.FieldsPerRecord = 6
+	r.reader.ReuseRecord = true
+
+	err = r.next()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *spillReader) next() error {
+	r.user = nil
+
+	fields, err := r.reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", r.file.Name(), err.Error())
+	}
+
+	id, err := codeplug.ParseDmrID(fields[0])
+	if err != nil {
+		return fmt.Errorf("%s: invalid DMR ID %q: %s", r.file.Name(), fields[0], err.Error())
+	}
+
+	r.user = &User{
+		ID:       id,
+		Callsign: fields[1],
+		Name:     fields[2],
+		City:     fields[3],
+		State:    fields[4],
+		Country:  fields[5],
+	}
+
+	return nil
+}
+
+func (r *spillReader) close() {
+	r.file.Close()
+}
+
+// A spillHeap holds the spillReaders still having users, ordered by the
+// ID of their next user, then by their index.
+type spillHeap []*spillReader
+
+func (h spillHeap) Len() int {
+	return len(h)
+}
+
+func (h spillHeap) Less(i, j int) bool {
+	if h[i].user.ID != h[j].user.ID {
+		return h[i].user.ID < h[j].user.ID
+	}
+
+	return h[i].index < h[j].index
+}
+
+func (h spillHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *spillHeap) Push(x interface{}) {
+	*h = append(*h, x.(*spillReader))
+}
+
+func (h *spillHeap) Pop() interface{} {
+	old := *h
+	r := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return r
+}
+
+// readSpillFile calls fn for each user of a spill file, in order.
+func readSpillFile(filename string, fn func(*User) error) error {
+	r, err := newSpillReader(filename)
+	if err != nil {
+		return err
+	}
+	defer r.close()
+
+	for r.user != nil {
+		err = fn(r.user)
+		if err != nil {
+			return err
+		}
+		err = r.next()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
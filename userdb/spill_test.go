@@ -0,0 +1,102 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"io/ioutil"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// spillBenchmarkUsers is the number of users generated for the spill
+// benchmark, twice the size of the radioid.net database.
+const spillBenchmarkUsers = 500000
+
+// peakHeap samples the heap in use until stop is closed, and sends the
+// largest sample on the returned channel.
+func peakHeap(stop chan struct{}) chan uint64 {
+	peak := make(chan uint64)
+	go func() {
+		var max uint64
+		var stats runtime.MemStats
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			runtime.ReadMemStats(&stats)
+			if stats.HeapInuse > max {
+				max = stats.HeapInuse
+			}
+			select {
+			case <-stop:
+				peak <- max
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return peak
+}
+
+// BenchmarkSpill writes a database of spillBenchmarkUsers users, with
+// and without a spill directory, and reports the peak heap in use.
+// With a spill directory, the peak is bounded by spillRunSize rather
+// than growing with the number of users.
+func BenchmarkSpill(b *testing.B) {
+	server := newTestServer(spillBenchmarkUsers)
+	defer server.Close()
+
+	for _, spill := range []bool{false, true} {
+		name := "Memory"
+		if spill {
+			name = "Spill"
+		}
+		spill := spill
+		b.Run(name, func(b *testing.B) {
+			var peak uint64
+			for i := 0; i < b.N; i++ {
+				db := newTestDB(server)
+				if spill {
+					db.SetSpillDir(b.TempDir())
+				}
+
+				runtime.GC()
+				stop := make(chan struct{})
+				peakChan := peakHeap(stop)
+				_, err := db.WriteMD380ToolsTo(ioutil.Discard, nil)
+				close(stop)
+				p := <-peakChan
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				if p > peak {
+					peak = p
+				}
+			}
+			b.ReportMetric(float64(peak)/(1024*1024), "peak-heap-MiB")
+		})
+	}
+}
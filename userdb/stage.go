@@ -0,0 +1,117 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SetStagingDir sets a directory in which the users of each source are
+// saved as its download completes.  If building the database is
+// interrupted, the next build reuses the saved sources and downloads
+// only the missing ones.  The saved sources are removed once the
+// database has been built.  If dir is "", as by default, nothing is
+// saved.
+func (db *UsersDB) SetStagingDir(dir string) {
+	db.stagingDir = dir
+}
+
+const stagedPattern = "userdb-*.csv"
+
+func (db *UsersDB) stagedFilename(url string) string {
+	name := fmt.Sprintf("userdb-%x.csv", sha1.Sum([]byte(url)))
+	return filepath.Join(db.stagingDir, name)
+}
+
+// staged returns a source that passes the users of the source at url
+// from the staging directory, reading source and saving its users there
+// as they are read if they have not been saved.
+func (db *UsersDB) staged(url string, source userSource) userSource {
+	if db.stagingDir == "" {
+		return source
+	}
+
+	return func(sink *userSink) error {
+		filename := db.stagedFilename(url)
+		err := readSpillFile(filename, sink.add)
+		if err == nil {
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+
+		err = os.MkdirAll(db.stagingDir, 0755)
+		if err != nil {
+			return err
+		}
+		tmpFilename := filename + ".tmp"
+		w, err := createSpillFile(tmpFilename)
+		if err != nil {
+			return err
+		}
+
+		err = source(&userSink{
+			add: func(u *User) error {
+				err := w.write(u)
+				if err != nil {
+					return err
+				}
+				return sink.add(u)
+			},
+			reset: func() error {
+				err := w.reset()
+				if err != nil {
+					return err
+				}
+				return sink.reset()
+			},
+		})
+		cErr := w.close()
+		if err == nil {
+			err = cErr
+		}
+		if err != nil {
+			os.Remove(tmpFilename)
+			return err
+		}
+
+		return os.Rename(tmpFilename, filename)
+	}
+}
+
+// clearStaging removes the saved sources from the staging directory.
+func (db *UsersDB) clearStaging() {
+	if db.stagingDir == "" {
+		return
+	}
+
+	filenames, _ := filepath.Glob(filepath.Join(db.stagingDir, stagedPattern))
+	for _, filename := range filenames {
+		os.Remove(filename)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A StateStyle selects how the State values of US and Canadian users
+// are written.
+type StateStyle int
+
+const (
+	// StateUnchanged leaves State values as the sources give them.
+	StateUnchanged StateStyle = iota
+
+	// StateAbbreviations writes the postal abbreviations, as in "TX".
+	StateAbbreviations
+
+	// StateNames writes the full names, as in "Texas".
+	StateNames
+)
+
+// ParseStateStyle returns the StateStyle named by s: "abbrev",
+// "name", or "" for StateUnchanged.
+func ParseStateStyle(s string) (StateStyle, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return StateUnchanged, nil
+	case "abbrev", "abbreviation", "abbreviations":
+		return StateAbbreviations, nil
+	case "name", "names":
+		return StateNames, nil
+	}
+
+	return StateUnchanged, fmt.Errorf("bad state style: %s", s)
+}
+
+// SetStateStyle sets how the State values of US and Canadian users
+// are normalized.  By default, they are left unchanged.
+func (db *UsersDB) SetStateStyle(style StateStyle) {
+	db.stateStyle = style
+}
+
+type state struct {
+	abbrev string
+	name   string
+}
+
+var usStates = []state{
+	{"AL", "Alabama"},
+	{"AK", "Alaska"},
+	{"AZ", "Arizona"},
+	{"AR", "Arkansas"},
+	{"CA", "California"},
+	{"CO", "Colorado"},
+	{"CT", "Connecticut"},
+	{"DE", "Delaware"},
+	{"DC", "District of Columbia"},
+	{"FL", "Florida"},
+	{"GA", "Georgia"},
+	{"HI", "Hawaii"},
+	{"ID", "Idaho"},
+	{"IL", "Illinois"},
+	{"IN", "Indiana"},
+	{"IA", "Iowa"},
+	{"KS", "Kansas"},
+	{"KY", "Kentucky"},
+	{"LA", "Louisiana"},
+	{"ME", "Maine"},
+	{"MD", "Maryland"},
+	{"MA", "Massachusetts"},
+	{"MI", "Michigan"},
+	{"MN", "Minnesota"},
+	{"MS", "Mississippi"},
+	{"MO", "Missouri"},
+	{"MT", "Montana"},
+	{"NE", "Nebraska"},
+	{"NV", "Nevada"},
+	{"NH", "New Hampshire"},
+	{"NJ", "New Jersey"},
+	{"NM", "New Mexico"},
+	{"NY", "New York"},
+	{"NC", "North Carolina"},
+	{"ND", "North Dakota"},
+	{"OH", "Ohio"},
+	{"OK", "Oklahoma"},
+	{"OR", "Oregon"},
+	{"PA", "Pennsylvania"},
+	{"RI", "Rhode Island"},
+	{"SC", "South Carolina"},
+	{"SD", "South Dakota"},
+	{"TN", "Tennessee"},
+	{"TX", "Texas"},
+	{"UT", "Utah"},
+	{"VT", "Vermont"},
+	{"VA", "Virginia"},
+	{"WA", "Washington"},
+	{"WV", "West Virginia"},
+	{"WI", "Wisconsin"},
+	{"WY", "Wyoming"},
+	{"AS", "American Samoa"},
+	{"GU", "Guam"},
+	{"MP", "Northern Mariana Islands"},
+	{"PR", "Puerto Rico"},
+	{"VI", "US Virgin Islands"},
+}
+
+var canadianProvinces = []state{
+	{"AB", "Alberta"},
+	{"BC", "British Columbia"},
+	{"MB", "Manitoba"},
+	{"NB", "New Brunswick"},
+	{"NL", "Newfoundland and Labrador"},
+	{"NS", "Nova Scotia"},
+	{"NT", "Northwest Territories"},
+	{"NU", "Nunavut"},
+	{"ON", "Ontario"},
+	{"PE", "Prince Edward Island"},
+	{"QC", "Quebec"},
+	{"SK", "Saskatchewan"},
+	{"YT", "Yukon"},
+}
+
+// Other names found in the sources.
+var stateAliases = map[string]string{
+	"WASHINGTON DC":       "DC",
+	"WASHINGTON D C":      "DC",
+	"D C":                 "DC",
+	"VIRGIN ISLANDS":      "VI",
+	"U S VIRGIN ISLANDS":  "VI",
+	"NEWFOUNDLAND":        "NL",
+	"NF":                  "NL",
+	"LABRADOR":            "NL",
+	"PQ":                  "QC",
+	"QUE":                 "QC",
+	"PEI":                 "PE",
+	"YUKON TERRITORY":     "YT",
+	"NWT":                 "NT",
+	"NORTHWEST TERRITORY": "NT",
+	"N W T":               "NT",
+	"SASK":                "SK",
+	"MAN":                 "MB",
+	"ALTA":                "AB",
+	"ONT":                 "ON",
+	"CALIF":               "CA",
+	"TEX":                 "TX",
+	"FLA":                 "FL",
+	"MASS":                "MA",
+	"PENN":                "PA",
+	"PENNA":               "PA",
+	"WASH":                "WA",
+}
+
+// stateTable maps a country to the normalized forms of its states'
+// abbreviations, names and aliases, and the states they refer to.
+type stateTable map[string]state
+
+var stateTables = map[string]stateTable{
+	"United States": newStateTable(usStates),
+	"Canada":        newStateTable(canadianProvinces),
+}
+
+func newStateTable(states []state) stateTable {
+	table := make(stateTable)
+	byAbbrev := make(map[string]state)
+	for _, s := range states {
+		table[nameKey(s.abbrev)] = s
+		table[nameKey(s.name)] = s
+		byAbbrev[s.abbrev] = s
+	}
+	for alias, abbrev := range stateAliases {
+		s, ok := byAbbrev[abbrev]
+		if ok {
+			table[alias] = s
+		}
+	}
+
+	return table
+}
+
+// nameKey returns s in upper case, with punctuation replaced by
+// single spaces, so that "Wash." and "WASH" match, as do "D.C." and
+// "D C".
+func nameKey(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		}
+		return ' '
+	}, s)
+
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// normalizeState rewrites the user's State in the given style, if the
+// user is in the US or Canada and the state is recognized.
+func (u *User) normalizeState(style StateStyle) {
+	if style == StateUnchanged {
+		return
+	}
+
+	table := stateTables[CanonicalCountry(u.Country)]
+	if table == nil {
+		return
+	}
+
+	s, ok := table[nameKey(u.State)]
+	if !ok {
+		return
+	}
+
+	switch style {
+	case StateAbbreviations:
+		u.State = s.abbrev
+	case StateNames:
+		u.State = s.name
+	}
+}
@@ -21,42 +21,62 @@
 // You should have received a copy of the GNU Lesser General Public License
 // along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
 
+// Package userdb builds DMR user databases, merging the users from
+// several internet sources, and writes them in the formats used by
+// md380tools and by the MD-2017 CPS.
 package userdb
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+	"github.com/dalefarnsworth/codeplug/vfs"
 )
 
-var specialUsersURL = "http://registry.dstar.su/api/node.php"
-var fixedUsersURL = "https://raw.githubusercontent.com/travisgoodspeed/md380tools/master/db/fixed.csv"
-var radioidUsersURL = "https://www.radioid.net/static/users_quoted.csv"
-var hamdigitalUsersURL = "https://ham-digital.org/status/users_quoted.csv"
-var reflectorUsersURL = "http://registry.dstar.su/reflector.db"
+const (
+	defaultSpecialUsersURL    = "http://registry.dstar.su/api/node.php"
+	defaultFixedUsersURL      = "https://raw.githubusercontent.com/travisgoodspeed/md380tools/master/db/fixed.csv"
+	defaultRadioidUsersURL    = "https://www.radioid.net/static/users_quoted.csv"
+	defaultRadioidLookupURL   = "https://radioid.net/api/dmr/user/"
+	defaultHamdigitalUsersURL = "https://ham-digital.org/status/users_quoted.csv"
+	defaultReflectorUsersURL  = "http://registry.dstar.su/reflector.db"
+
+	defaultTransportTimeout = 20 * time.Second
+	defaultClientTimeout    = 300 * time.Second
+)
 
-var transportTimeout = 20
-var clientTimeout = 300
+// parseUserID parses the ID field of a user entry.  Entries without
+// an ID are given the ID 0, and are discarded by mergeAndSort.
+func parseUserID(s string) (codeplug.DmrID, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
 
-var tr = &http.Transport{
-	TLSHandshakeTimeout:   time.Duration(transportTimeout) * time.Second,
-	ResponseHeaderTimeout: time.Duration(transportTimeout) * time.Second,
-}
+	id, err := codeplug.ParseDmrID(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid DMR ID %q: %s", s, err.Error())
+	}
 
-var client = &http.Client{
-	Transport: tr,
-	Timeout:   time.Duration(clientTimeout) * time.Second,
+	return id, nil
 }
 
 type User struct {
-	ID       string
+	ID       codeplug.DmrID
 	Callsign string
 	Name     string
 	City     string
@@ -64,23 +84,159 @@ type User struct {
 	Country  string
 }
 
+// IDString returns the user's ID as a decimal string.  It eases the
+// transition of code written when User.ID was a string.
+func (u *User) IDString() string {
+	return u.ID.String()
+}
+
+// SetIDString sets the user's ID from a decimal string.  It eases the
+// transition of code written when User.ID was a string.
+func (u *User) SetIDString(s string) error {
+	id, err := parseUserID(s)
+	if err != nil {
+		return err
+	}
+	u.ID = id
+
+	return nil
+}
+
 type UsersDB struct {
-	filename          string
-	userFunc          func(*User) string
-	progressCallback  func(progressCounter int) bool
-	progressFunc      func() error
-	progressIncrement int
-	progressCounter   int
+	appendUser         func([]byte, *User) []byte
+	progressCallback   func(progressCounter int) bool
+	progressFunc       func() error
+	progressIncrement  int
+	progressCounter    int
+	specialUsersURL    string
+	fixedUsersURL      string
+	radioidUsersURL    string
+	radioidLookupURL   string
+	hamdigitalUsersURL string
+	reflectorUsersURL  string
+	transportTimeout   time.Duration
+	clientTimeout      time.Duration
+	client             *http.Client
+	resolver           *net.Resolver
+	dialTimeout        time.Duration
+	preferIPv4         bool
+	callsignCheck      CallsignCheck
+	marcFallbackURLs   []string
+	recordDir          string
+	replay             bool
+	stateStyle         StateStyle
+	countryStyle       CountryStyle
+	titleCase          bool
+	elideRepeated      bool
+	lastHeardSource    string
+	activeSince        time.Time
+	mccRanges          [][2]int
+	countries          map[string]bool
+	customUsers        []*User
+	customIDs          map[codeplug.DmrID]bool
+	blockedIDs         map[codeplug.DmrID]bool
+	blockedCallsigns   map[string]bool
+	blocked            int
+	warningFunc        func(Warning)
+	warningMutex       sync.Mutex
+	warnings           []Warning
+	spillDir           string
+	stagingDir         string
+	fsys               vfs.FS
 }
 
 func newUserDB() *UsersDB {
 	db := &UsersDB{
-		progressFunc: func() error { return nil },
+		progressFunc:       func() error { return nil },
+		specialUsersURL:    defaultSpecialUsersURL,
+		fixedUsersURL:      defaultFixedUsersURL,
+		radioidUsersURL:    defaultRadioidUsersURL,
+		radioidLookupURL:   defaultRadioidLookupURL,
+		hamdigitalUsersURL: defaultHamdigitalUsersURL,
+		reflectorUsersURL:  defaultReflectorUsersURL,
+		transportTimeout:   defaultTransportTimeout,
+		clientTimeout:      defaultClientTimeout,
+		fsys:               vfs.OS,
 	}
 
 	return db
 }
 
+// New returns a UsersDB using the default source URLs and timeouts.
+// Each UsersDB has its own HTTP client, so differently configured
+// databases may be built concurrently.
+func New() *UsersDB {
+	return newUserDB()
+}
+
+// SetSpecialUsersURL sets the URL of the list of special ID servers.
+func (db *UsersDB) SetSpecialUsersURL(url string) {
+	db.specialUsersURL = url
+}
+
+// SetFixedUsersURL sets the URL of the md380tools fixed users file.
+func (db *UsersDB) SetFixedUsersURL(url string) {
+	db.fixedUsersURL = url
+}
+
+// SetRadioidUsersURL sets the URL of the radioid.net users file.
+func (db *UsersDB) SetRadioidUsersURL(url string) {
+	db.radioidUsersURL = url
+}
+
+// SetRadioidLookupURL sets the URL of the radioid.net user lookup API.
+func (db *UsersDB) SetRadioidLookupURL(url string) {
+	db.radioidLookupURL = url
+}
+
+// SetHamdigitalUsersURL sets the URL of the ham-digital.org users file.
+func (db *UsersDB) SetHamdigitalUsersURL(url string) {
+	db.hamdigitalUsersURL = url
+}
+
+// SetReflectorUsersURL sets the URL of the reflector users file.
+func (db *UsersDB) SetReflectorUsersURL(url string) {
+	db.reflectorUsersURL = url
+}
+
+// SetFileSystem sets the file system to which users files are
+// written.
+func (db *UsersDB) SetFileSystem(fsys vfs.FS) {
+	db.fsys = fsys
+}
+
+// SetTimeouts sets the TLS handshake/response header timeout and the
+// overall request timeout used when downloading.
+func (db *UsersDB) SetTimeouts(transportTimeout, clientTimeout time.Duration) {
+	db.transportTimeout = transportTimeout
+	db.clientTimeout = clientTimeout
+	db.client = nil
+}
+
+// SetHTTPClient sets the HTTP client used when downloading.  It
+// overrides any timeouts set by SetTimeouts and any resolver and dial
+// options set by SetResolver and SetDialOptions.
+func (db *UsersDB) SetHTTPClient(client *http.Client) {
+	db.client = client
+}
+
+func (db *UsersDB) httpClient() *http.Client {
+	if db.client == nil {
+		tr := &http.Transport{
+			DialContext:           db.dialContext(),
+			TLSHandshakeTimeout:   db.transportTimeout,
+			ResponseHeaderTimeout: db.transportTimeout,
+		}
+
+		db.client = &http.Client{
+			Transport: tr,
+			Timeout:   db.clientTimeout,
+		}
+	}
+
+	return db.client
+}
+
 func (db *UsersDB) setMaxProgressCount(max int) {
 	db.progressFunc = func() error { return nil }
 	if db.progressCallback != nil {
@@ -123,132 +279,250 @@ func (u *User) normalize() {
 	u.Country = normalizeString(u.Country)
 }
 
+// normalizeString transliterates s to ASCII, trims it, replaces its
+// commas with semicolons and collapses its runs of spaces.  Strings
+// needing none of this, as most do, are returned without copying.
 func normalizeString(s string) string {
 	s = asciify(s)
 	s = strings.TrimSpace(s)
-	s = strings.Replace(s, ",", ";", -1)
 
-	for strings.Index(s, "  ") >= 0 {
-		s = strings.Replace(s, "  ", " ", -1)
+	if !strings.Contains(s, ",") && !strings.Contains(s, "  ") {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ',':
+			c = ';'
+		case c == ' ' && i > 0 && s[i-1] == ' ':
+			continue
+		}
+		b.WriteByte(c)
 	}
 
-	return s
+	return b.String()
 }
 
+// asciify returns s with each rune replaced by its transliteration.
 func asciify(s string) string {
-	runes := []rune(s)
-	strs := make([]string, len(runes))
-	for i, r := range runes {
-		strs[i] = transliterations[r]
+	if isPlainASCII(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		b.WriteString(transliterations[r])
+	}
+
+	return b.String()
+}
+
+// isPlainASCII returns whether each rune of s is its own
+// transliteration.
+func isPlainASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= utf8.RuneSelf || c == '[' {
+			return false
+		}
 	}
 
-	return strings.Join(strs, "")
+	return true
 }
 
-func getBytes(url string) ([]byte, error) {
-	resp, err := client.Get(url)
+// getBody returns the body of the response from url, which the caller
+// must close.
+func (db *UsersDB) getBody(url string) (io.ReadCloser, error) {
+	resp, err := db.get(url)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
+		resp.Body.Close()
 		return nil, errors.New(resp.Status)
 	}
 
-	return ioutil.ReadAll(resp.Body)
+	return resp.Body, nil
 }
 
-func getLines(url string) ([]string, error) {
-	bytes, err := getBytes(url)
+func (db *UsersDB) getBytes(url string) ([]byte, error) {
+	body, err := db.getBody(url)
 	if err != nil {
 		return nil, err
 	}
+	defer body.Close()
 
-	lines := strings.Split(string(bytes), "\n")
-
-	return lines[:len(lines)-1], nil
+	return ioutil.ReadAll(body)
 }
 
-func getRadioidUsers() ([]*User, error) {
-	lines, err := getLines(radioidUsersURL)
+// maxLineSize is the length of the longest line read from a source.
+const maxLineSize = 1024 * 1024
+
+// eachLine calls fn for each line of the response from url, with its
+// line number, reading the response as it arrives rather than holding
+// all of it.  It returns the number of lines read.
+func (db *UsersDB) eachLine(url string, fn func(line string, lineNumber int) error) (int, error) {
+	body, err := db.getBody(url)
 	if err != nil {
-		errFmt := "error getting radioid users database: %s: %s"
-		err = fmt.Errorf(errFmt, radioidUsersURL, err.Error())
-		return nil, err
+		return 0, err
 	}
+	defer body.Close()
 
-	if len(lines) < 50000 {
-		errFmt := "too few radioid users database entries: %s: %d"
-		err = fmt.Errorf(errFmt, radioidUsersURL, len(lines))
-		return nil, err
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(nil, maxLineSize)
+	n := 0
+	for scanner.Scan() {
+		n++
+		err := fn(scanner.Text(), n)
+		if err != nil {
+			return n, err
+		}
 	}
 
-	users := make([]*User, len(lines))
-	for i, line := range lines {
-		line = strings.Trim(line, `"`)
-		fields := strings.Split(line, `","`)
+	return n, scanner.Err()
+}
 
-		users[i] = &User{
-			ID:       fields[0],
-			Callsign: fields[1],
-			Name:     fields[2],
-			City:     fields[3],
-			State:    fields[4],
-			Country:  fields[5],
-		}
-	}
-	return users, nil
+// A userSink receives the users of a source as they are read, so that
+// a large source need not be held in memory.  Its reset discards the
+// users received, as when a source fails after some of its users were
+// read, and the users of another are used in its place.
+type userSink struct {
+	add   func(*User) error
+	reset func() error
 }
 
-func getHamdigitalUsers() ([]*User, error) {
-	lines, err := getLines(hamdigitalUsersURL)
+// A userSource passes the users of a source to a userSink, in order of
+// increasing precedence.
+type userSource func(sink *userSink) error
+
+// collectUsers returns the users of source.
+func collectUsers(source userSource) ([]*User, error) {
+	var users []*User
+	sink := &userSink{
+		add: func(u *User) error {
+			users = append(users, u)
+			return nil
+		},
+		reset: func() error {
+			users = nil
+			return nil
+		},
+	}
+
+	err := source(sink)
 	if err != nil {
-		errFmt := "error getting hamdigital users database: %s: %s"
-		err = fmt.Errorf(errFmt, hamdigitalUsersURL, err.Error())
 		return nil, err
 	}
 
-	if len(lines) < 50000 {
-		errFmt := "too few hamdigital users database entries: %s: %d"
-		err = fmt.Errorf(errFmt, hamdigitalUsersURL, len(lines))
-		return nil, err
+	return users, nil
+}
+
+func (db *UsersDB) getRadioidUsers(sink *userSink) error {
+	err := db.getRadioidSourceUsers(sink)
+	if err == nil || len(db.marcFallbackURLs) == 0 {
+		return err
 	}
 
-	users := make([]*User, len(lines))
-	for i, line := range lines {
-		line = strings.Trim(line, `"`)
-		fields := strings.Split(line, `","`)
+	resetErr := sink.reset()
+	if resetErr != nil {
+		return resetErr
+	}
 
-		users[i] = &User{
-			ID:       fields[0],
-			Callsign: fields[1],
-			Name:     fields[2],
-			City:     fields[3],
-			State:    fields[4],
-			Country:  fields[5],
+	return db.getMarcFallbackUsers(sink, err)
+}
+
+func (db *UsersDB) getRadioidSourceUsers(sink *userSink) error {
+	return db.getQuotedUsers("radioid", db.radioidUsersURL, sink)
+}
+
+func (db *UsersDB) getHamdigitalUsers(sink *userSink) error {
+	return db.getQuotedUsers("hamdigital", db.hamdigitalUsersURL, sink)
+}
+
+// getQuotedUsers passes the users of the users database file at url,
+// whose fields are quoted, as in "id","callsign","name","city",
+// "state","country", to sink.  Malformed lines are skipped with a
+// warning.
+func (db *UsersDB) getQuotedUsers(name, url string, sink *userSink) error {
+	n, err := db.eachLine(url, func(line string, lineNumber int) error {
+		u := db.parseQuotedUser(url, line, lineNumber)
+		if u == nil {
+			return nil
 		}
+		return sink.add(u)
+	})
+	if err != nil {
+		errFmt := "error getting %s users database: %s: %s"
+		return fmt.Errorf(errFmt, name, url, err.Error())
 	}
-	return users, nil
+
+	if n < 50000 {
+		errFmt := "too few %s users database entries: %s: %d"
+		return fmt.Errorf(errFmt, name, url, n)
+	}
+
+	return nil
 }
 
-func getFixedUsers() ([]*User, error) {
-	lines, err := getLines(fixedUsersURL)
+// parseQuotedUser parses a line of a users database file whose fields
+// are quoted.  It returns nil, with a warning, if the line is
+// malformed.
+func (db *UsersDB) parseQuotedUser(url string, line string, lineNumber int) *User {
+	line = strings.Trim(strings.TrimSpace(line), `"`)
+	fields := strings.Split(line, `","`)
+	if len(fields) < 6 {
+		db.warn(url, lineNumber, "too few fields: %d", len(fields))
+		return nil
+	}
+
+	id, err := parseUserID(fields[0])
 	if err != nil {
-		errFmt := "error getting fixed users: %s: %s"
-		err = fmt.Errorf(errFmt, fixedUsersURL, err.Error())
-		return nil, err
+		db.warn(url, lineNumber, "%s", err.Error())
+		return nil
+	}
+	if id == 0 {
+		db.warn(url, lineNumber, "no ID")
+		return nil
+	}
+
+	return &User{
+		ID:       id,
+		Callsign: fields[1],
+		Name:     fields[2],
+		City:     fields[3],
+		State:    fields[4],
+		Country:  fields[5],
 	}
+}
 
-	users := make([]*User, len(lines))
-	for i, line := range lines {
+func (db *UsersDB) getFixedUsers(sink *userSink) error {
+	_, err := db.eachLine(db.fixedUsersURL, func(line string, lineNumber int) error {
 		fields := strings.Split(line, ",")
-		users[i] = &User{
-			ID:       fields[0],
-			Callsign: fields[1],
+		if len(fields) < 2 {
+			db.warn(db.fixedUsersURL, lineNumber, "too few fields: %d", len(fields))
+			return nil
+		}
+		id, err := parseUserID(fields[0])
+		if err != nil {
+			db.warn(db.fixedUsersURL, lineNumber, "%s", err.Error())
+			return nil
 		}
+		return sink.add(&User{
+			ID:       id,
+			Callsign: fields[1],
+		})
+	})
+	if err != nil {
+		errFmt := "error getting fixed users: %s: %s"
+		return fmt.Errorf(errFmt, db.fixedUsersURL, err.Error())
 	}
-	return users, nil
+
+	return nil
 }
 
 type special struct {
@@ -257,8 +531,8 @@ type special struct {
 	Address string
 }
 
-func getSpecialURLs() ([]string, error) {
-	bytes, err := getBytes(specialUsersURL)
+func (db *UsersDB) getSpecialURLs() ([]string, error) {
+	bytes, err := db.getBytes(db.specialUsersURL)
 	if err != nil {
 		return nil, err
 	}
@@ -275,91 +549,86 @@ func getSpecialURLs() ([]string, error) {
 	return urls, nil
 }
 
-func getSpecialUsers(url string) ([]*User, error) {
-	lines, err := getLines(url)
-	if err != nil {
-		errFmt := "error getting special users: %s: %s"
-		err = fmt.Errorf(errFmt, url, err.Error())
-		return nil, nil // Ignore erros on special users
-	}
-
-	users := make([]*User, len(lines))
-	for i, line := range lines {
+func (db *UsersDB) getSpecialUsers(url string, sink *userSink) error {
+	_, err := db.eachLine(url, func(line string, lineNumber int) error {
 		fields := strings.Split(line, ",")
 		if len(fields) < 7 {
-			continue
+			db.warn(url, lineNumber, "too few fields: %d", len(fields))
+			return nil
+		}
+		id, err := parseUserID(fields[0])
+		if err != nil {
+			db.warn(url, lineNumber, "%s", err.Error())
+			return nil
 		}
-		users[i] = &User{
-			ID:       fields[0],
+		return sink.add(&User{
+			ID:       id,
 			Callsign: fields[1],
 			Name:     fields[2],
 			Country:  fields[6],
-		}
+		})
+	})
+	if err != nil {
+		// Ignore errors on special users, along with any
+		// users read before the error.
+		db.warn(url, 0, "error getting special users: %s", err.Error())
+		return sink.reset()
 	}
-	return users, nil
+
+	return nil
 }
 
-func getReflectorUsers() ([]*User, error) {
-	lines, err := getLines(reflectorUsersURL)
+func (db *UsersDB) getReflectorUsers(sink *userSink) error {
+	_, err := db.eachLine(db.reflectorUsersURL, func(line string, lineNumber int) error {
+		// The first line is a header.
+		if lineNumber == 1 {
+			return nil
+		}
+		line = strings.Replace(line, "@", ",", 2)
+		fields := strings.Split(line, ",")
+		if len(fields) < 2 {
+			db.warn(db.reflectorUsersURL, lineNumber, "too few fields: %d", len(fields))
+			return nil
+		}
+		id, err := parseUserID(fields[0])
+		if err != nil {
+			db.warn(db.reflectorUsersURL, lineNumber, "%s", err.Error())
+			return nil
+		}
+		return sink.add(&User{
+			ID:       id,
+			Callsign: fields[1],
+		})
+	})
 	if err != nil {
 		errFmt := "error getting reflector users: %s: %s"
-		err = fmt.Errorf(errFmt, reflectorUsersURL, err.Error())
-		return nil, err
+		return fmt.Errorf(errFmt, db.reflectorUsersURL, err.Error())
 	}
 
-	users := make([]*User, len(lines))
-	for i, line := range lines[1:] {
-		line := strings.Replace(line, "@", ",", 2)
-		fields := strings.Split(line, ",")
-		users[i] = &User{
-			ID:       fields[0],
-			Callsign: fields[1],
-		}
-	}
-	return users, nil
+	return nil
 }
 
 func mergeAndSort(users []*User) ([]*User, error) {
-	idMap := make(map[int]*User)
+	idMap := make(map[codeplug.DmrID]*User)
 	for _, u := range users {
-		if u == nil || u.ID == "" {
+		if u == nil || u.ID == 0 {
 			continue
 		}
-		u.ID = strings.TrimPrefix(u.ID, "#")
-		id, err := strconv.ParseUint(u.ID, 10, 24)
-		if err != nil {
-			return nil, err
-		}
-		existing := idMap[int(id)]
+		existing := idMap[u.ID]
 		if existing == nil {
-			idMap[int(id)] = u
+			idMap[u.ID] = u
 			continue
 		}
-		// non-empty fields in later entries replace fields in earlier
-		if u.Callsign != "" {
-			existing.Callsign = u.Callsign
-		}
-		if u.Name != "" {
-			existing.Name = u.Name
-		}
-		if u.City != "" {
-			existing.City = u.City
-		}
-		if u.State != "" {
-			existing.State = u.State
-		}
-		if u.Country != "" {
-			existing.Country = u.Country
-		}
+		mergeUser(existing, u)
 	}
 
-	ids := make([]int, 0, len(idMap))
+	ids := make([]codeplug.DmrID, 0, len(idMap))
 	for id := range idMap {
 		ids = append(ids, id)
 	}
 
 	users = make([]*User, len(ids))
-	sort.Ints(ids)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
 	for i, id := range ids {
 		users[i] = idMap[id]
 	}
@@ -367,46 +636,66 @@ func mergeAndSort(users []*User) ([]*User, error) {
 	return users, nil
 }
 
+// mergeUser merges u into existing, an earlier entry with the same ID.
+// Non-empty fields in later entries replace fields in earlier ones.
+func mergeUser(existing, u *User) {
+	if u.Callsign != "" {
+		existing.Callsign = u.Callsign
+	}
+	if u.Name != "" {
+		existing.Name = u.Name
+	}
+	if u.City != "" {
+		existing.City = u.City
+	}
+	if u.State != "" {
+		existing.State = u.State
+	}
+	if u.Country != "" {
+		existing.Country = u.Country
+	}
+}
+
 type result struct {
 	index int
 	users []*User
 	err   error
 }
 
-func do(index int, f func() ([]*User, error), resultChan chan result) {
+func do(index int, source userSource, resultChan chan result) {
 	var r result
 
 	r.index = index
-	r.users, r.err = f()
+	r.users, r.err = collectUsers(source)
 	resultChan <- r
 }
 
 func (db *UsersDB) Users() ([]*User, error) {
-	getUsersFuncs := []func() ([]*User, error){
-		getFixedUsers,
-		getHamdigitalUsers,
-		getRadioidUsers,
-		getReflectorUsers,
+	if db.spillDir != "" {
+		var users []*User
+		err := db.eachSpilledUser(func(u *User) error {
+			users = append(users, u)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return users, nil
 	}
 
-	specialURLs, err := getSpecialURLs()
+	db.resetWarnings()
+
+	sources, err := db.userSources()
 	if err != nil {
 		return nil, err
 	}
-	for i := range specialURLs {
-		url := specialURLs[i]
-		f := func() ([]*User, error) {
-			return getSpecialUsers(url)
-		}
-		getUsersFuncs = append(getUsersFuncs, f)
-	}
 
 	var users []*User
-	resultCount := len(getUsersFuncs)
+	resultCount := len(sources)
 	resultChan := make(chan result, resultCount)
 
-	for i, f := range getUsersFuncs {
-		go do(i, f, resultChan)
+	for i, source := range sources {
+		go do(i, source, resultChan)
 	}
 
 	db.setMaxProgressCount(resultCount)
@@ -430,59 +719,94 @@ func (db *UsersDB) Users() ([]*User, error) {
 	for _, r := range results {
 		users = append(users, r.users...)
 	}
+	for _, u := range db.customUsers {
+		user := *u
+		users = append(users, &user)
+	}
 
 	users, err = mergeAndSort(users)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := range users {
-		users[i].normalize()
+	users = db.filterBlocked(users)
+	users = db.filterCallsigns(users)
+	users = db.filterRegions(users)
+
+	users, err = db.filterInactive(users)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		db.finishUser(u)
 	}
 
+	db.clearStaging()
 	db.finalProgress()
 
 	return users, nil
 }
 
-func (db *UsersDB) writeSizedUsersFile() (err error) {
-	file, err := os.Create(db.filename)
-	if err != nil {
-		return err
+// userSources returns the sources of the users, in order of
+// increasing precedence.
+func (db *UsersDB) userSources() ([]userSource, error) {
+	sources := []userSource{
+		db.staged(db.fixedUsersURL, db.getFixedUsers),
+		db.staged(db.hamdigitalUsersURL, db.getHamdigitalUsers),
+		db.staged(db.radioidUsersURL, db.getRadioidUsers),
+		db.staged(db.reflectorUsersURL, db.getReflectorUsers),
 	}
-	defer func() {
-		fErr := file.Close()
-		if err == nil {
-			err = fErr
-		}
-		return
-	}()
 
-	users, err := db.Users()
+	specialURLs, err := db.getSpecialURLs()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	for i := range specialURLs {
+		url := specialURLs[i]
+		source := func(sink *userSink) error {
+			return db.getSpecialUsers(url, sink)
+		}
+		sources = append(sources, db.staged(url, source))
 	}
 
-	strs := make([]string, len(users))
-	for i, u := range users {
-		strs[i] = db.userFunc(u)
+	return sources, nil
+}
+
+// finishUser normalizes the fields of a merged user.
+func (db *UsersDB) finishUser(u *User) {
+	u.normalize()
+	u.normalizeState(db.stateStyle)
+	u.normalizeCountry(db.countryStyle)
+	if db.titleCase {
+		u.Name = titleCase(u.Name)
+		u.City = titleCase(u.City)
 	}
+}
 
-	length := 0
-	for _, s := range strs {
-		length += len(s)
+// eachUser calls fn for each user of the database, in order of ID.
+func (db *UsersDB) eachUser(fn func(*User) error) error {
+	if db.spillDir != "" {
+		return db.eachSpilledUser(fn)
 	}
-	fmt.Fprintf(file, "%d\n", length)
 
-	for _, s := range strs {
-		fmt.Fprint(file, s)
+	users, err := db.Users()
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		err := fn(u)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (db *UsersDB) writeUsersFile() (err error) {
-	file, err := os.Create(db.filename)
+// writeFile creates filename and calls write to write its contents.
+func (db *UsersDB) writeFile(filename string, write func(io.Writer) (int64, error)) (err error) {
+	file, err := db.fsys.Create(filename)
 	if err != nil {
 		return err
 	}
@@ -491,43 +815,182 @@ func (db *UsersDB) writeUsersFile() (err error) {
 		if err == nil {
 			err = fErr
 		}
-		return
 	}()
 
-	fmt.Sprintln("Radio ID,CallSign,Name,NickName,City,State,Country")
+	_, err = write(file)
 
-	users, err := db.Users()
-	if err != nil {
+	return err
+}
+
+// A countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+
+	return n, err
+}
+
+// writeSizedUsers writes the lines of the users to w, preceded by their
+// length, and returns the number of bytes written.
+func (db *UsersDB) writeSizedUsers(w io.Writer) (n int64, err error) {
+	// The file begins with the length of the user lines, so they
+	// are gathered first, on disk when spilling.
+	var body io.ReadWriter = new(bytes.Buffer)
+	if db.spillDir != "" {
+		tmp, err := ioutil.TempFile(db.spillDir, "userdb")
+		if err != nil {
+			return 0, err
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+		body = tmp
+	}
+
+	// Each line is appended to the same buffer, so that writing a
+	// user allocates nothing.
+	bodyWriter := bufio.NewWriter(body)
+	var line []byte
+	length := 0
+	err = db.eachUser(func(u *User) error {
+		line = db.appendUser(line[:0], u)
+		length += len(line)
+		_, err := bodyWriter.Write(line)
 		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = bodyWriter.Flush()
+	if err != nil {
+		return 0, err
 	}
 
-	for _, u := range users {
-		fmt.Fprint(file, db.userFunc(u))
+	if tmp, ok := body.(*os.File); ok {
+		_, err = tmp.Seek(0, io.SeekStart)
+		if err != nil {
+			return 0, err
+		}
 	}
 
-	return nil
+	cw := &countingWriter{w: w}
+	_, err = fmt.Fprintf(cw, "%d\n", length)
+	if err != nil {
+		return cw.n, err
+	}
+	_, err = io.Copy(cw, body)
+
+	return cw.n, err
 }
 
-func WriteMD380ToolsFile(filename string, progress func(cur int) bool) error {
-	db := newUserDB()
-	db.filename = filename
+// writeUsers writes the lines of the users to w and returns the number
+// of bytes written.
+func (db *UsersDB) writeUsers(w io.Writer) (n int64, err error) {
+	cw := &countingWriter{w: w}
+	writer := bufio.NewWriter(cw)
+	var line []byte
+	err = db.eachUser(func(u *User) error {
+		line = db.appendUser(line[:0], u)
+		_, err := writer.Write(line)
+		return err
+	})
+	if err != nil {
+		return cw.n, err
+	}
+	err = writer.Flush()
+
+	return cw.n, err
+}
+
+// SetElideRepeatedFields sets whether WriteMD380ToolsFile leaves the
+// City, State and Country of a user empty when they are the same as
+// those of the previous user.  This shrinks the file by about 30%, but
+// must only be used with md380tools firmware that reads the compressed
+// database, which repeats the previous user's values for empty fields.
+func (db *UsersDB) SetElideRepeatedFields(elide bool) {
+	db.elideRepeated = elide
+}
+
+// WriteMD380ToolsFile downloads the users database and writes it to
+// filename in the format expected by the md380tools firmware.
+func (db *UsersDB) WriteMD380ToolsFile(filename string, progress func(cur int) bool) error {
+	return db.writeFile(filename, func(w io.Writer) (int64, error) {
+		return db.WriteMD380ToolsTo(w, progress)
+	})
+}
+
+// WriteMD380ToolsTo downloads the users database and writes it to w in
+// the format expected by the md380tools firmware.  It returns the
+// number of bytes written.
+func (db *UsersDB) WriteMD380ToolsTo(w io.Writer, progress func(cur int) bool) (int64, error) {
 	db.progressCallback = progress
-	db.userFunc = func(u *User) string {
-		return fmt.Sprintf("%s,%s,%s,%s,%s,,%s\n",
-			u.ID, u.Callsign, u.Name, u.City, u.State, u.Country)
+
+	var previous User
+	db.appendUser = func(b []byte, u *User) []byte {
+		city, state, country := u.City, u.State, u.Country
+		if db.elideRepeated {
+			if city == previous.City {
+				city = ""
+			}
+			if state == previous.State {
+				state = ""
+			}
+			if country == previous.Country {
+				country = ""
+			}
+			previous = *u
+		}
+
+		b = strconv.AppendUint(b, uint64(u.ID), 10)
+		b = appendFields(b, u.Callsign, u.Name, city, state, "", country)
+
+		return append(b, '\n')
 	}
 
-	return db.writeSizedUsersFile()
+	return db.writeSizedUsers(w)
 }
 
-func WriteMD2017File(filename string, progress func(cur int) bool) error {
-	db := newUserDB()
-	db.filename = filename
+// WriteMD2017File downloads the users database and writes it to
+// filename in the format expected by the MD-2017 CPS.
+func (db *UsersDB) WriteMD2017File(filename string, progress func(cur int) bool) error {
+	return db.writeFile(filename, func(w io.Writer) (int64, error) {
+		return db.WriteMD2017To(w, progress)
+	})
+}
+
+// WriteMD2017To downloads the users database and writes it to w in the
+// format expected by the MD-2017 CPS.  It returns the number of bytes
+// written.
+func (db *UsersDB) WriteMD2017To(w io.Writer, progress func(cur int) bool) (int64, error) {
 	db.progressCallback = progress
-	db.userFunc = func(u *User) string {
-		return fmt.Sprintf("%s,%s,%s,,%s,%s,%s\n",
-			u.ID, u.Callsign, u.Name, u.City, u.State, u.Country)
+	db.appendUser = func(b []byte, u *User) []byte {
+		b = strconv.AppendUint(b, uint64(u.ID), 10)
+		b = appendFields(b, u.Callsign, u.Name, "", u.City, u.State, u.Country)
+
+		return append(b, '\n')
 	}
 
-	return db.writeUsersFile()
+	return db.writeUsers(w)
+}
+
+// appendFields appends each of fields to b, preceded by a comma.
+func appendFields(b []byte, fields ...string) []byte {
+	for _, field := range fields {
+		b = append(b, ',')
+		b = append(b, field...)
+	}
+
+	return b
+}
+
+func WriteMD380ToolsFile(filename string, progress func(cur int) bool) error {
+	return newUserDB().WriteMD380ToolsFile(filename, progress)
+}
+
+func WriteMD2017File(filename string, progress func(cur int) bool) error {
+	return newUserDB().WriteMD2017File(filename, progress)
 }
@@ -0,0 +1,226 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// benchmarkUsers is the number of users generated for the benchmarks,
+// about the size of the radioid.net database.
+const benchmarkUsers = 250000
+
+var (
+	testNames = []string{
+		"John Smith",
+		"José  Pérez",
+		"Jürgen Müller",
+		"O'Brien, Pat",
+		"WANG WEI",
+		"Søren Ødegård",
+	}
+	testCities = []string{
+		"Mesa",
+		"München",
+		"São Paulo",
+		"st. john's",
+		"Kraków",
+	}
+	testStates = []string{
+		"Arizona",
+		"Bayern",
+		"Sao Paulo",
+		"Newfoundland and Labrador",
+		"",
+	}
+	testCountries = []string{
+		"United States",
+		"Germany",
+		"Brazil",
+		"Canada",
+		"Poland",
+	}
+)
+
+// testUser returns the i'th generated user.  The fields cycle through
+// values with and without characters needing normalization.
+func testUser(i int) *User {
+	return &User{
+		ID:       codeplug.DmrID(1000000 + i),
+		Callsign: fmt.Sprintf("K%c%dX%c%c", 'A'+i%26, i%10, 'A'+i/26%26, 'A'+i/676%26),
+		Name:     testNames[i%len(testNames)],
+		City:     testCities[i%len(testCities)],
+		State:    testStates[i%len(testStates)],
+		Country:  testCountries[i%len(testCountries)],
+	}
+}
+
+// testUsers returns n generated users, in order of ID.
+func testUsers(n int) []*User {
+	users := make([]*User, n)
+	for i := range users {
+		users[i] = testUser(i)
+	}
+
+	return users
+}
+
+// writeQuotedUsers writes the users i, for i in [0, n) with i%step
+// equal to 0, to w in the quoted format of radioid.net.
+func writeQuotedUsers(w io.Writer, n, step int) error {
+	bw := bufio.NewWriter(w)
+	for i := 0; i < n; i += step {
+		u := testUser(i)
+		_, err := fmt.Fprintf(bw, "\"%d\",\"%s\",\"%s\",\"%s\",\"%s\",\"%s\"\n",
+			u.ID, u.Callsign, u.Name, u.City, u.State, u.Country)
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// newTestServer returns a server for the sources of a database of n
+// generated users.  The hamdigital source has every other user, so
+// that users are merged.  The users are generated as they are sent.
+func newTestServer(n int) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/radioid", func(w http.ResponseWriter, r *http.Request) {
+		writeQuotedUsers(w, n, 1)
+	})
+	mux.HandleFunc("/hamdigital", func(w http.ResponseWriter, r *http.Request) {
+		writeQuotedUsers(w, n, 2)
+	})
+	mux.HandleFunc("/fixed", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%d,FIXED\n", 1000000+n)
+	})
+	mux.HandleFunc("/reflector", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "id@callsign\n%d@REFLECTOR\n", 1000001+n)
+	})
+	mux.HandleFunc("/special", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "[]")
+	})
+
+	return httptest.NewServer(mux)
+}
+
+// newTestDB returns a database whose sources are those of server.
+func newTestDB(server *httptest.Server) *UsersDB {
+	db := New()
+	db.SetHTTPClient(server.Client())
+	db.SetRadioidUsersURL(server.URL + "/radioid")
+	db.SetHamdigitalUsersURL(server.URL + "/hamdigital")
+	db.SetFixedUsersURL(server.URL + "/fixed")
+	db.SetReflectorUsersURL(server.URL + "/reflector")
+	db.SetSpecialUsersURL(server.URL + "/special")
+
+	return db
+}
+
+func BenchmarkMergeAndSort(b *testing.B) {
+	// Every other user is repeated, as by a second source, and the
+	// users are in reverse order, so that they must be sorted.
+	users := testUsers(benchmarkUsers)
+	for i := 0; i < benchmarkUsers; i += 2 {
+		users = append(users, testUser(i))
+	}
+	for i, j := 0, len(users)-1; i < j; i, j = i+1, j-1 {
+		users[i], users[j] = users[j], users[i]
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := mergeAndSort(users)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNormalize(b *testing.B) {
+	users := testUsers(benchmarkUsers)
+	originals := testUsers(benchmarkUsers)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Normalizing a user changes it, so each pass starts
+		// from the generated users.
+		b.StopTimer()
+		for j, u := range originals {
+			*users[j] = *u
+		}
+		b.StartTimer()
+
+		for _, u := range users {
+			u.normalize()
+		}
+	}
+}
+
+func BenchmarkWriteUsers(b *testing.B) {
+	server := newTestServer(benchmarkUsers)
+	defer server.Close()
+
+	writers := []struct {
+		name  string
+		write func(db *UsersDB, w io.Writer) (int64, error)
+	}{
+		{"MD380Tools", func(db *UsersDB, w io.Writer) (int64, error) {
+			return db.WriteMD380ToolsTo(w, nil)
+		}},
+		{"MD380ToolsElided", func(db *UsersDB, w io.Writer) (int64, error) {
+			db.SetElideRepeatedFields(true)
+			return db.WriteMD380ToolsTo(w, nil)
+		}},
+		{"MD2017", func(db *UsersDB, w io.Writer) (int64, error) {
+			return db.WriteMD2017To(w, nil)
+		}},
+	}
+
+	for _, writer := range writers {
+		writer := writer
+		b.Run(writer.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				db := newTestDB(server)
+				n, err := writer.write(db, ioutil.Discard)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.SetBytes(n)
+			}
+		})
+	}
+}
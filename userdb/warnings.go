@@ -0,0 +1,82 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of UserDB.
+//
+// UserDB is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// UserDB is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with UserDB.  If not, see <http://www.gnu.org/licenses/>.
+
+package userdb
+
+import (
+	"fmt"
+)
+
+// A Warning describes a source record that was skipped because it is
+// malformed, or a source that was skipped because it is unavailable.
+type Warning struct {
+	Source string // URL or filename
+	Line   int    // line number, starting at 1, or 0 for the source
+	Reason string
+}
+
+func (w Warning) String() string {
+	if w.Line == 0 {
+		return fmt.Sprintf("%s: %s", w.Source, w.Reason)
+	}
+	return fmt.Sprintf("%s: line %d: %s", w.Source, w.Line, w.Reason)
+}
+
+// SetWarningFunc sets a function to be called with each warning as
+// the database is built.  It may be called from several goroutines,
+// but not concurrently.
+func (db *UsersDB) SetWarningFunc(fn func(Warning)) {
+	db.warningFunc = fn
+}
+
+// Warnings returns the warnings of the last build of the database.
+func (db *UsersDB) Warnings() []Warning {
+	db.warningMutex.Lock()
+	defer db.warningMutex.Unlock()
+
+	return append([]Warning{}, db.warnings...)
+}
+
+// warn records a warning about a skipped source record.
+func (db *UsersDB) warn(source string, line int, format string, v ...interface{}) {
+	w := Warning{
+		Source: source,
+		Line:   line,
+		Reason: fmt.Sprintf(format, v...),
+	}
+
+	db.warningMutex.Lock()
+	defer db.warningMutex.Unlock()
+
+	db.warnings = append(db.warnings, w)
+	if db.warningFunc != nil {
+		db.warningFunc(w)
+	}
+}
+
+func (db *UsersDB) resetWarnings() {
+	db.warningMutex.Lock()
+	defer db.warningMutex.Unlock()
+
+	db.warnings = nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Vfs.
+//
+// Vfs is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Vfs is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Vfs.  If not, see <http://www.gnu.org/licenses/>.
+
+package vfs
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemFS is a file system held in memory.  It is safe for concurrent
+// use.  It holds only files; their directories can't be opened, but
+// can be listed with fs.ReadDir.
+type MemFS struct {
+	mutex sync.Mutex
+	files map[string]*memData
+}
+
+type memData struct {
+	data    []byte
+	modTime time.Time
+}
+
+// NewMemFS returns an empty MemFS.
+func NewMemFS() *MemFS {
+	return &MemFS{files: make(map[string]*memData)}
+}
+
+// memName returns the MemFS name of the named file, accepting names
+// beginning with "/" or "./".
+func memName(op string, name string) (string, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		name = "."
+	}
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	return name, nil
+}
+
+// WriteFile sets the contents of the named file.
+func (m *MemFS) WriteFile(name string, data []byte) error {
+	name, err := memName("write", name)
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.files[name] = &memData{
+		data:    append([]byte(nil), data...),
+		modTime: time.Now(),
+	}
+
+	return nil
+}
+
+// Names returns the names of the files in m, in sorted order.
+func (m *MemFS) Names() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	names := make([]string, 0, len(m.files))
+	for name := range m.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (m *MemFS) Create(name string) (io.WriteCloser, error) {
+	name, err := memName("create", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &memWriter{fsys: m, name: name}, nil
+}
+
+// Remove removes the named file.
+func (m *MemFS) Remove(name string) error {
+	name, err := memName("remove", name)
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.files[name] == nil {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+	delete(m.files, name)
+
+	return nil
+}
+
+// ReadDir returns the files in the named directory, in sorted order.
+// A directory without files does not exist.
+func (m *MemFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	name, err := memName("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var entries []fs.DirEntry
+	for filename, d := range m.files {
+		if path.Dir(filename) != name {
+			continue
+		}
+		info := memInfo{path.Base(filename), int64(len(d.data)), d.modTime}
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	if entries == nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	return entries, nil
+}
+
+func (m *MemFS) Open(name string) (fs.File, error) {
+	name, err := memName("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	d := m.files[name]
+	if d == nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	info := memInfo{path.Base(name), int64(len(d.data)), d.modTime}
+	return &memFile{Reader: bytes.NewReader(d.data), info: info}, nil
+}
+
+// memWriter stores the data written to it as the named file of fsys
+// when closed.
+type memWriter struct {
+	bytes.Buffer
+	fsys *MemFS
+	name string
+}
+
+func (w *memWriter) Close() error {
+	return w.fsys.WriteFile(w.name, w.Bytes())
+}
+
+type memFile struct {
+	*bytes.Reader
+	info memInfo
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *memFile) Close() error {
+	return nil
+}
+
+type memInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (i memInfo) Name() string       { return i.name }
+func (i memInfo) Size() int64        { return i.size }
+func (i memInfo) ModTime() time.Time { return i.modTime }
+func (i memInfo) Mode() fs.FileMode  { return 0644 }
+func (i memInfo) IsDir() bool        { return false }
+func (i memInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,200 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Vfs.
+//
+// Vfs is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Vfs is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Vfs.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package vfs defines the file system through which the codeplug and
+// userdb packages read and write files, so that programs embedding
+// them can direct their output to memory, cloud storage or an archive
+// instead of the operating system's files.
+package vfs
+
+import (
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FS is a file system that can create files as well as open them.
+// Names are slash-separated paths, but those of OS may also be any
+// path accepted by the operating system.
+type FS interface {
+	fs.FS
+
+	// Create creates or truncates the named file.  The file's
+	// contents need not be visible to Open until the returned
+	// writer is closed.
+	Create(name string) (io.WriteCloser, error)
+}
+
+// PermFS is a file system that can create files with given
+// permissions.
+type PermFS interface {
+	FS
+
+	// CreatePerm is Create for a file with permissions perm.
+	CreatePerm(name string, perm fs.FileMode) (io.WriteCloser, error)
+}
+
+// CreatePerm creates the named file in fsys with permissions perm, if
+// fsys is a PermFS, or as by fsys.Create otherwise.
+func CreatePerm(fsys FS, name string, perm fs.FileMode) (io.WriteCloser, error) {
+	if fsys, ok := fsys.(PermFS); ok {
+		return fsys.CreatePerm(name, perm)
+	}
+
+	return fsys.Create(name)
+}
+
+// WriteFile writes data to the named file in fsys, creating it with
+// permissions perm, as by CreatePerm, if fsys is a PermFS.
+func WriteFile(fsys FS, name string, data []byte, perm fs.FileMode) error {
+	file, err := CreatePerm(fsys, name, perm)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// DirFS is a file system with directories, which must be made before
+// files are created in them.
+type DirFS interface {
+	FS
+
+	// MkdirAll makes the named directory, along with any missing
+	// parents.
+	MkdirAll(name string, perm fs.FileMode) error
+}
+
+// MkdirAll makes the named directory in fsys, and any missing parents,
+// if fsys is a DirFS.  Other file systems need no directories made.
+func MkdirAll(fsys FS, name string, perm fs.FileMode) error {
+	if fsys, ok := fsys.(DirFS); ok {
+		return fsys.MkdirAll(name, perm)
+	}
+
+	return nil
+}
+
+// RemoveFS is a file system from which files can be removed.
+type RemoveFS interface {
+	FS
+
+	// Remove removes the named file.
+	Remove(name string) error
+}
+
+// Remove removes the named file from fsys, which must be a RemoveFS.
+func Remove(fsys FS, name string) error {
+	if fsys, ok := fsys.(RemoveFS); ok {
+		return fsys.Remove(name)
+	}
+
+	return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrInvalid}
+}
+
+// OS is the operating system's file system.  Files it creates are
+// written to a temporary file in the same directory, which replaces
+// the named file when closed, so that a failed write leaves any
+// earlier file intact.
+var OS PermFS = osFS{}
+
+type osFS struct{}
+
+func (osFS) Open(name string) (fs.File, error) {
+	return os.Open(name)
+}
+
+func (osFS) Stat(name string) (fs.FileInfo, error) {
+	return os.Stat(name)
+}
+
+func (osFS) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(name)
+}
+
+func (osFS) MkdirAll(name string, perm fs.FileMode) error {
+	return os.MkdirAll(name, perm)
+}
+
+func (osFS) Remove(name string) error {
+	return os.Remove(name)
+}
+
+func (fsys osFS) Create(name string) (io.WriteCloser, error) {
+	return fsys.CreatePerm(name, 0644)
+}
+
+func (osFS) CreatePerm(name string, perm fs.FileMode) (io.WriteCloser, error) {
+	dir, base := filepath.Split(name)
+	file, err := ioutil.TempFile(dir, base)
+	if err != nil {
+		return nil, err
+	}
+
+	return &osFile{File: file, name: name, perm: perm}, nil
+}
+
+// osFile is a temporary file that is renamed to name when closed.
+type osFile struct {
+	*os.File
+	name   string
+	perm   fs.FileMode
+	failed bool
+}
+
+func (f *osFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	if err != nil {
+		f.failed = true
+	}
+	return n, err
+}
+
+func (f *osFile) Close() error {
+	tmpName := f.File.Name()
+
+	err := f.File.Close()
+	if err == nil && f.failed {
+		err = &fs.PathError{Op: "write", Path: f.name, Err: fs.ErrInvalid}
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+
+	err = os.Chmod(tmpName, f.perm)
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+
+	return os.Rename(tmpName, f.name)
+}
@@ -0,0 +1,179 @@
+// Copyright 2017-2018 Dale Farnsworth. All rights reserved.
+
+// Dale Farnsworth
+// 1007 W Mendoza Ave
+// Mesa, AZ  85210
+// USA
+//
+// dale@farnsworth.org
+
+// This file is part of Wasm.
+//
+// Wasm is free software: you can redistribute it and/or modify
+// it under the terms of version 3 of the GNU Lesser General Public
+// License as published by the Free Software Foundation.
+//
+// Wasm is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Wasm.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build js && wasm
+// +build js,wasm
+
+// Command wasm exposes the codeplug parser to JavaScript, so that a
+// browser-based viewer can open codeplug files client-side using this
+// implementation.  Build it with
+//
+//	GOOS=js GOARCH=wasm go build -o codeplug.wasm ./wasm
+//
+// and load it with the wasm_exec.js shipped with Go.  It defines a
+// global "codeplug" object whose functions each take the file's
+// contents as a Uint8Array and its name, and return an object holding
+// either a "result" string or an "error" string:
+//
+//	codeplug.info(data, name)	JSON with the model, frequency range and record counts
+//	codeplug.toText(data, name)	the codeplug in text form
+//	codeplug.toJSON(data, name)	the codeplug in JSON form
+//	codeplug.toHTML(data, name)	the codeplug's HTML cheat sheet
+//	codeplug.toMarkdown(data, name)	the codeplug's zones and contacts as Markdown
+//	codeplug.toRdt(data, name)	an .rdt image, as a Uint8Array "result"
+//
+// The type of the file is chosen by the extension of name: .txt, .json
+// or .xlsx, otherwise an .rdt or .bin image.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"syscall/js"
+
+	"github.com/dalefarnsworth/codeplug/codeplug"
+)
+
+// load loads the codeplug file held in data.
+func load(data []byte, name string) (*codeplug.Codeplug, error) {
+	fType, err := codeplug.DetectFileType(name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := codeplug.NewCodeplugFromBytes(fType, name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	models, freqs := cp.ModelsFrequencyRanges()
+	if len(models) == 0 {
+		return nil, errors.New("unknown model in codeplug")
+	}
+	model := models[0]
+	if len(freqs[model]) == 0 {
+		return nil, errors.New("unknown frequency range in codeplug")
+	}
+
+	ignoreWarnings := true
+	err = cp.Load(model, freqs[model][0], ignoreWarnings)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+func info(cp *codeplug.Codeplug) (interface{}, error) {
+	records := make(map[string]int)
+	for _, rType := range cp.RecordTypes() {
+		rs := cp.Records(rType)
+		if len(rs) != 0 {
+			records[rs[0].TypeName()] = len(rs)
+		}
+	}
+
+	data, err := json.Marshal(struct {
+		Model          string         `json:"model"`
+		FrequencyRange string         `json:"frequencyRange"`
+		Records        map[string]int `json:"records"`
+	}{cp.Model(), cp.FrequencyRange(), records})
+
+	return string(data), err
+}
+
+// writer returns an operation returning the output of write as a string.
+func writer(write func(cp *codeplug.Codeplug, buf *bytes.Buffer) error) func(cp *codeplug.Codeplug) (interface{}, error) {
+	return func(cp *codeplug.Codeplug) (interface{}, error) {
+		var buf bytes.Buffer
+		err := write(cp, &buf)
+		return buf.String(), err
+	}
+}
+
+func toRdt(cp *codeplug.Codeplug) (interface{}, error) {
+	ignoreWarnings := true
+	data, err := cp.Bytes(ignoreWarnings)
+	if err != nil {
+		return nil, err
+	}
+
+	array := js.Global().Get("Uint8Array").New(len(data))
+	js.CopyBytesToJS(array, data)
+
+	return array, nil
+}
+
+// function wraps op as a JavaScript function taking a Uint8Array and
+// a name.
+func function(op func(cp *codeplug.Codeplug) (interface{}, error)) js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		result := make(map[string]interface{})
+
+		if len(args) != 2 {
+			result["error"] = "expected (data, name)"
+			return result
+		}
+		data := make([]byte, args[0].Get("length").Int())
+		js.CopyBytesToGo(data, args[0])
+
+		cp, err := load(data, args[1].String())
+		if err != nil {
+			result["error"] = err.Error()
+			return result
+		}
+		defer cp.Free()
+
+		value, err := op(cp)
+		if err != nil {
+			result["error"] = err.Error()
+			return result
+		}
+		result["result"] = value
+
+		return result
+	})
+}
+
+func main() {
+	js.Global().Set("codeplug", map[string]interface{}{
+		"info": function(info),
+		"toText": function(writer(func(cp *codeplug.Codeplug, buf *bytes.Buffer) error {
+			return cp.WriteText(buf)
+		})),
+		"toJSON": function(writer(func(cp *codeplug.Codeplug, buf *bytes.Buffer) error {
+			return cp.WriteJSON(buf)
+		})),
+		"toHTML": function(writer(func(cp *codeplug.Codeplug, buf *bytes.Buffer) error {
+			return cp.WriteHTML(buf)
+		})),
+		"toMarkdown": function(writer(func(cp *codeplug.Codeplug, buf *bytes.Buffer) error {
+			return cp.WriteMarkdown(buf)
+		})),
+		"toRdt": function(toRdt),
+	})
+
+	// Keep the functions available to JavaScript.
+	select {}
+}